@@ -0,0 +1,422 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// BuildSettingCondition is a condition of a build setting assignment, like `[sdk=iphoneos*]`.
+// The value is a pattern, which may contain `*` wildcards.
+type BuildSettingCondition struct {
+	Key   string
+	Value string
+}
+
+// Build setting condition keys
+const (
+	BuildSettingConditionSDK    = "sdk"
+	BuildSettingConditionArch   = "arch"
+	BuildSettingConditionConfig = "config"
+)
+
+// buildSettingAssignment is a, possibly conditional, build setting assignment,
+// like `CODE_SIGN_IDENTITY[sdk=iphoneos*] = iPhone Distribution`.
+type buildSettingAssignment struct {
+	Name       string
+	Conditions []BuildSettingCondition
+	Value      string
+}
+
+// parseBuildSettingKey splits a build setting key, like `OTHER_LDFLAGS[sdk=iphonesimulator*][arch=x86_64]`,
+// into the setting name and its conditions.
+func parseBuildSettingKey(key string) (string, []BuildSettingCondition, error) {
+	conditions := []BuildSettingCondition{}
+
+	start := strings.Index(key, "[")
+	if start == -1 {
+		return key, conditions, nil
+	}
+
+	name := key[:start]
+	if name == "" {
+		return "", nil, fmt.Errorf("invalid build setting (%s): missing name", key)
+	}
+
+	rest := key[start:]
+	for rest != "" {
+		end := strings.Index(rest, "]")
+		if !strings.HasPrefix(rest, "[") || end == -1 {
+			return "", nil, fmt.Errorf("invalid build setting (%s): malformed condition", key)
+		}
+
+		split := strings.SplitN(rest[1:end], "=", 2)
+		if len(split) != 2 || split[0] == "" {
+			return "", nil, fmt.Errorf("invalid build setting (%s): malformed condition", key)
+		}
+		conditions = append(conditions, BuildSettingCondition{Key: split[0], Value: split[1]})
+
+		rest = rest[end+1:]
+	}
+
+	return name, conditions, nil
+}
+
+// buildSettingValueString returns the string value of a project.pbxproj build setting,
+// list values are joined by spaces, quoting the items, which contain a space.
+func buildSettingValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []string:
+		items := []string{}
+		for _, item := range v {
+			if strings.Contains(item, " ") {
+				item = `"` + item + `"`
+			}
+			items = append(items, item)
+		}
+		return strings.Join(items, " ")
+	}
+	return ""
+}
+
+func buildSettingsAssignments(settings BuildSettings) ([]buildSettingAssignment, error) {
+	keys := []string{}
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	assignments := []buildSettingAssignment{}
+	for _, key := range keys {
+		name, conditions, err := parseBuildSettingKey(key)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, buildSettingAssignment{
+			Name:       name,
+			Conditions: conditions,
+			Value:      buildSettingValueString(settings[key]),
+		})
+	}
+	return assignments, nil
+}
+
+// assignmentSeparatorIndex returns the index of the `=`, which separates the key and the value of an assignment,
+// skipping the ones within the conditions of the key.
+func assignmentSeparatorIndex(line string) int {
+	depth := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '=':
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// BuildSettingsOptions ...
+type BuildSettingsOptions struct {
+	// SDK is matched against the `[sdk=...]` conditions, defaults to the value of SDKROOT.
+	SDK string
+	// Arch is matched against the `[arch=...]` conditions, arch conditions do not match if not set.
+	Arch string
+}
+
+// ResolvedBuildSettings are the effective build settings of a target (or the project) for a build configuration.
+// The settings are layered (from lowest to highest precedence):
+// built-in settings, project xcconfig, project build settings, target xcconfig, target build settings.
+type ResolvedBuildSettings struct {
+	layers     [][]buildSettingAssignment
+	conditions map[string]string
+}
+
+// ResolveBuildSettings resolves the build settings of the target for the given build configuration.
+// If target is nil, the project level build settings are resolved.
+func (p *Project) ResolveBuildSettings(target Target, configuration string, options BuildSettingsOptions) (*ResolvedBuildSettings, error) {
+	builtins := map[string]string{
+		"CONFIGURATION":     configuration,
+		"PROJECT_NAME":      p.Name(),
+		"PROJECT_FILE_PATH": p.Path,
+		"PROJECT_DIR":       p.SourceRoot(),
+		"SRCROOT":           p.SourceRoot(),
+		"SOURCE_ROOT":       p.SourceRoot(),
+	}
+	if target != nil {
+		builtins["TARGET_NAME"] = target.AbstractTarget().Name
+		builtins["TARGETNAME"] = target.AbstractTarget().Name
+		if nativeTarget, ok := target.(*PBXNativeTarget); ok {
+			builtins["PRODUCT_TYPE"] = string(nativeTarget.ProductType)
+		}
+	}
+
+	builtinNames := []string{}
+	for name := range builtins {
+		builtinNames = append(builtinNames, name)
+	}
+	sort.Strings(builtinNames)
+
+	builtinLayer := []buildSettingAssignment{}
+	for _, name := range builtinNames {
+		builtinLayer = append(builtinLayer, buildSettingAssignment{Name: name, Value: builtins[name]})
+	}
+
+	settings := &ResolvedBuildSettings{
+		layers: [][]buildSettingAssignment{builtinLayer},
+		conditions: map[string]string{
+			BuildSettingConditionConfig: configuration,
+			BuildSettingConditionArch:   options.Arch,
+		},
+	}
+
+	if p.RootObject != nil && p.RootObject.BuildConfigurationList != nil {
+		if buildConfiguration, found := p.RootObject.BuildConfigurationList.BuildConfiguration(configuration); found {
+			if err := p.addBuildConfigurationLayers(settings, buildConfiguration); err != nil {
+				return nil, err
+			}
+		} else if target == nil {
+			return nil, fmt.Errorf("build configuration (%s) not found for project %s", configuration, p.Name())
+		}
+	}
+
+	if target != nil {
+		name := target.AbstractTarget().Name
+
+		var buildConfiguration *XCBuildConfiguration
+		found := false
+		if configurationList := target.AbstractTarget().BuildConfigurationList; configurationList != nil {
+			buildConfiguration, found = configurationList.BuildConfiguration(configuration)
+		}
+		if !found {
+			return nil, fmt.Errorf("build configuration (%s) not found for target %s", configuration, name)
+		}
+
+		if err := p.addBuildConfigurationLayers(settings, buildConfiguration); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk := options.SDK
+	if sdk == "" {
+		sdk, _ = settings.Value("SDKROOT")
+	}
+	settings.conditions[BuildSettingConditionSDK] = sdk
+
+	return settings, nil
+}
+
+// addBuildConfigurationLayers adds the layer of the build configuration's xcconfig (if any) and the layer of its build settings.
+func (p *Project) addBuildConfigurationLayers(settings *ResolvedBuildSettings, buildConfiguration *XCBuildConfiguration) error {
+	if buildConfiguration.BaseConfigurationReference != nil {
+		xcconfigPth, err := p.FileElementPath(buildConfiguration.BaseConfigurationReference)
+		if err != nil {
+			return err
+		}
+
+		xcconfig, err := OpenXCConfig(xcconfigPth)
+		if err != nil {
+			return err
+		}
+		settings.layers = append(settings.layers, xcconfig.buildSettingAssignments())
+	}
+
+	assignments, err := buildSettingsAssignments(buildConfiguration.BuildSettings)
+	if err != nil {
+		return err
+	}
+	settings.layers = append(settings.layers, assignments)
+
+	return nil
+}
+
+// Value returns the expanded value of the build setting.
+func (s *ResolvedBuildSettings) Value(name string) (string, bool) {
+	return s.resolve(name, len(s.layers)-1, map[string]bool{})
+}
+
+// Expand expands the build setting references of the value, like `$(SRCROOT)/Scripts/inputs.xcfilelist`.
+// References of undefined build settings expand to an empty string.
+func (s *ResolvedBuildSettings) Expand(value string) string {
+	return s.expand(value, "", len(s.layers), map[string]bool{})
+}
+
+// All returns every build setting defined by any layer, with expanded values.
+func (s *ResolvedBuildSettings) All() map[string]string {
+	settings := map[string]string{}
+	for _, layer := range s.layers {
+		for _, assignment := range layer {
+			if _, found := settings[assignment.Name]; found {
+				continue
+			}
+			if value, found := s.Value(assignment.Name); found {
+				settings[assignment.Name] = value
+			}
+		}
+	}
+	return settings
+}
+
+// matches reports whether each condition of the assignment matches.
+func (s *ResolvedBuildSettings) matches(assignment buildSettingAssignment) bool {
+	for _, condition := range assignment.Conditions {
+		value := s.conditions[condition.Key]
+		if value == "" {
+			return false
+		}
+		if match, err := path.Match(condition.Value, value); err != nil || !match {
+			return false
+		}
+	}
+	return true
+}
+
+// resolve returns the expanded value of the setting, considering the layers up to the given one.
+// Within a layer the matching assignment with the most conditions wins, the later one for equally specific assignments.
+func (s *ResolvedBuildSettings) resolve(name string, layer int, visiting map[string]bool) (string, bool) {
+	for ; layer >= 0; layer-- {
+		var selected *buildSettingAssignment
+		for i, assignment := range s.layers[layer] {
+			if assignment.Name != name || !s.matches(assignment) {
+				continue
+			}
+			if selected == nil || len(assignment.Conditions) >= len(selected.Conditions) {
+				selected = &s.layers[layer][i]
+			}
+		}
+		if selected == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s@%d", name, layer)
+		if visiting[key] {
+			// recursive definition
+			return "", false
+		}
+		visiting[key] = true
+		value := s.expand(selected.Value, name, layer, visiting)
+		delete(visiting, key)
+
+		return value, true
+	}
+	return "", false
+}
+
+// expand expands the `$(VAR)` and `${VAR}` references of the value of the named setting, defined in the given layer.
+// `$(inherited)` refers to the value of the setting in the lower layers.
+func (s *ResolvedBuildSettings) expand(value, name string, layer int, visiting map[string]bool) string {
+	var expanded []byte
+	for i := 0; i < len(value); i++ {
+		if value[i] != '$' || i+1 >= len(value) || (value[i+1] != '(' && value[i+1] != '{') {
+			expanded = append(expanded, value[i])
+			continue
+		}
+
+		end := matchingMacroEnd(value, i+1)
+		if end == -1 {
+			expanded = append(expanded, value[i:]...)
+			break
+		}
+
+		reference := s.expand(value[i+2:end], name, layer, visiting)
+		operators := strings.Split(reference, ":")
+		reference = operators[0]
+
+		var referenced string
+		if reference == "inherited" {
+			referenced, _ = s.resolve(name, layer-1, visiting)
+		} else {
+			referenced, _ = s.resolve(reference, len(s.layers)-1, visiting)
+		}
+
+		for _, operator := range operators[1:] {
+			referenced = applyBuildSettingOperator(referenced, operator)
+		}
+
+		expanded = append(expanded, referenced...)
+		i = end
+	}
+
+	// `$(inherited)` of an undefined setting leaves a leading space behind
+	return strings.TrimSpace(string(expanded))
+}
+
+// matchingMacroEnd returns the index of the parenthesis or brace, which closes the one at start.
+func matchingMacroEnd(value string, start int) int {
+	open := value[start]
+	close := byte(')')
+	if open == '{' {
+		close = '}'
+	}
+
+	depth := 0
+	for i := start; i < len(value); i++ {
+		switch value[i] {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func applyBuildSettingOperator(value, operator string) string {
+	if strings.HasPrefix(operator, "default=") {
+		if value == "" {
+			return strings.TrimPrefix(operator, "default=")
+		}
+		return value
+	}
+
+	switch operator {
+	case "lower":
+		return strings.ToLower(value)
+	case "upper":
+		return strings.ToUpper(value)
+	case "rfc1034identifier":
+		return mapBuildSettingIdentifier(value, '-', func(r rune) bool {
+			return r == '-' || r == '.'
+		})
+	case "c99extidentifier", "identifier":
+		identifier := mapBuildSettingIdentifier(value, '_', func(r rune) bool {
+			return r == '_'
+		})
+		if identifier != "" && identifier[0] >= '0' && identifier[0] <= '9' {
+			identifier = "_" + identifier
+		}
+		return identifier
+	case "base":
+		return strings.TrimSuffix(filepath.Base(value), filepath.Ext(value))
+	case "dir":
+		return filepath.Dir(value) + "/"
+	case "file":
+		return filepath.Base(value)
+	case "suffix":
+		return filepath.Ext(value)
+	case "standardizepath":
+		return filepath.Clean(value)
+	}
+	return value
+}
+
+// mapBuildSettingIdentifier replaces the characters, which are not letters, digits or allowed, with the replacement.
+func mapBuildSettingIdentifier(value string, replacement rune, allowed func(rune) bool) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || allowed(r) {
+			return r
+		}
+		return replacement
+	}, value)
+}
@@ -0,0 +1,206 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const baseXCConfigContent = `// Base settings
+MARKETING_VERSION = 1.2.0
+GCC_PREPROCESSOR_DEFINITIONS = BASE=1
+OTHER_SWIFT_FLAGS = $(inherited) -DBASE
+OTHER_SWIFT_FLAGS[config=Release] = -DRELEASE
+VALID_ARCHS[sdk=iphonesimulator*] = x86_64
+EXCLUDED_ARCHS[sdk=iphonesimulator*][arch=arm64] = arm64
+DISPLAY_NAME = $(PRODUCT_NAME:upper) $(MARKETING_VERSION) // trailing comment
+`
+
+func openSampleAppProjectWithXCConfig(t *testing.T) (*Project, func()) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+
+	projectPth := filepath.Join(tmpDir, "SampleApp.xcodeproj")
+	writeTestProject(t, projectPth, sampleAppPbxprojContent)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "Base.xcconfig"), []byte(baseXCConfigContent), 0644))
+
+	project, err := OpenProject(projectPth)
+	require.NoError(t, err)
+
+	return project, func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}
+}
+
+func requireBuildSetting(t *testing.T, settings *ResolvedBuildSettings, name, expected string) {
+	value, found := settings.Value(name)
+	require.Equal(t, true, found, name)
+	require.Equal(t, expected, value, name)
+}
+
+func TestResolveBuildSettings(t *testing.T) {
+	project, cleanup := openSampleAppProjectWithXCConfig(t)
+	defer cleanup()
+
+	target, found := project.TargetByName("SampleApp")
+	require.Equal(t, true, found)
+
+	t.Log("Debug")
+	{
+		settings, err := project.ResolveBuildSettings(target, "Debug", BuildSettingsOptions{})
+		require.NoError(t, err)
+
+		requireBuildSetting(t, settings, "PRODUCT_BUNDLE_IDENTIFIER", "io.bitrise.SampleApp")
+		requireBuildSetting(t, settings, "PRODUCT_NAME", "SampleApp")
+		requireBuildSetting(t, settings, "INFOPLIST_FILE", "SampleApp/Info.plist")
+		requireBuildSetting(t, settings, "CODE_SIGN_STYLE", "Automatic")
+		requireBuildSetting(t, settings, "CODE_SIGN_IDENTITY", "iPhone Developer")
+		requireBuildSetting(t, settings, "SRCROOT", filepath.Dir(project.Path))
+
+		// project xcconfig < project build settings < target build settings
+		requireBuildSetting(t, settings, "MARKETING_VERSION", "1.2.0")
+		requireBuildSetting(t, settings, "GCC_PREPROCESSOR_DEFINITIONS", "DEBUG=1 BASE=1")
+		requireBuildSetting(t, settings, "LD_RUNPATH_SEARCH_PATHS", "@executable_path/Frameworks")
+		requireBuildSetting(t, settings, "OTHER_SWIFT_FLAGS", "-DBASE")
+		requireBuildSetting(t, settings, "DISPLAY_NAME", "SAMPLEAPP 1.2.0")
+
+		_, found := settings.Value("VALID_ARCHS")
+		require.Equal(t, false, found)
+		_, found = settings.Value("UNDEFINED_SETTING")
+		require.Equal(t, false, found)
+
+		all := settings.All()
+		require.Equal(t, "io.bitrise.SampleApp", all["PRODUCT_BUNDLE_IDENTIFIER"])
+		require.Equal(t, "iphoneos", all["SDKROOT"])
+	}
+
+	t.Log("Release")
+	{
+		settings, err := project.ResolveBuildSettings(target, "Release", BuildSettingsOptions{})
+		require.NoError(t, err)
+
+		requireBuildSetting(t, settings, "CODE_SIGN_STYLE", "Manual")
+		requireBuildSetting(t, settings, "CODE_SIGN_IDENTITY", "iPhone Distribution")
+		requireBuildSetting(t, settings, "OTHER_SWIFT_FLAGS", "-DRELEASE")
+		requireBuildSetting(t, settings, "GCC_PREPROCESSOR_DEFINITIONS", "BASE=1")
+	}
+
+	t.Log("simulator sdk and arch conditions")
+	{
+		settings, err := project.ResolveBuildSettings(target, "Release", BuildSettingsOptions{SDK: "iphonesimulator12.1", Arch: "arm64"})
+		require.NoError(t, err)
+
+		requireBuildSetting(t, settings, "CODE_SIGN_IDENTITY", "iPhone Developer")
+		requireBuildSetting(t, settings, "VALID_ARCHS", "x86_64")
+		requireBuildSetting(t, settings, "EXCLUDED_ARCHS", "arm64")
+	}
+
+	t.Log("project level settings")
+	{
+		settings, err := project.ResolveBuildSettings(nil, "Debug", BuildSettingsOptions{})
+		require.NoError(t, err)
+
+		requireBuildSetting(t, settings, "SWIFT_ACTIVE_COMPILATION_CONDITIONS", "DEBUG")
+		_, found := settings.Value("PRODUCT_BUNDLE_IDENTIFIER")
+		require.Equal(t, false, found)
+	}
+
+	t.Log("test target")
+	{
+		testTarget, found := project.TargetByName("SampleAppTests")
+		require.Equal(t, true, found)
+
+		settings, err := project.ResolveBuildSettings(testTarget, "Debug", BuildSettingsOptions{})
+		require.NoError(t, err)
+		requireBuildSetting(t, settings, "BUNDLE_LOADER", "/SampleApp.app/SampleApp")
+		requireBuildSetting(t, settings, "LD_RUNPATH_SEARCH_PATHS", "@executable_path/Frameworks @loader_path/Frameworks")
+	}
+
+	t.Log("missing configuration")
+	{
+		_, err := project.ResolveBuildSettings(target, "Staging", BuildSettingsOptions{})
+		require.EqualError(t, err, "build configuration (Staging) not found for target SampleApp")
+
+		_, err = project.ResolveBuildSettings(nil, "Staging", BuildSettingsOptions{})
+		require.EqualError(t, err, "build configuration (Staging) not found for project SampleApp")
+	}
+
+	t.Log("missing xcconfig")
+	{
+		require.NoError(t, os.Remove(filepath.Join(filepath.Dir(project.Path), "Base.xcconfig")))
+
+		_, err := project.ResolveBuildSettings(target, "Debug", BuildSettingsOptions{})
+		require.Error(t, err)
+		_, ok := err.(*XCConfigFileNotFoundError)
+		require.Equal(t, true, ok)
+	}
+}
+
+func TestParseBuildSettingKey(t *testing.T) {
+	name, conditions, err := parseBuildSettingKey("OTHER_LDFLAGS")
+	require.NoError(t, err)
+	require.Equal(t, "OTHER_LDFLAGS", name)
+	require.Equal(t, 0, len(conditions))
+
+	name, conditions, err = parseBuildSettingKey("OTHER_LDFLAGS[sdk=iphonesimulator*][arch=x86_64]")
+	require.NoError(t, err)
+	require.Equal(t, "OTHER_LDFLAGS", name)
+	require.Equal(t, []BuildSettingCondition{{Key: "sdk", Value: "iphonesimulator*"}, {Key: "arch", Value: "x86_64"}}, conditions)
+
+	_, _, err = parseBuildSettingKey("[sdk=iphoneos*]")
+	require.EqualError(t, err, "invalid build setting ([sdk=iphoneos*]): missing name")
+
+	_, _, err = parseBuildSettingKey("OTHER_LDFLAGS[sdk]")
+	require.EqualError(t, err, "invalid build setting (OTHER_LDFLAGS[sdk]): malformed condition")
+
+	_, _, err = parseBuildSettingKey("OTHER_LDFLAGS[sdk=iphoneos*")
+	require.EqualError(t, err, "invalid build setting (OTHER_LDFLAGS[sdk=iphoneos*): malformed condition")
+}
+
+func TestBuildSettingsExpansion(t *testing.T) {
+	settings := &ResolvedBuildSettings{
+		layers: [][]buildSettingAssignment{
+			{
+				{Name: "PRODUCT_NAME", Value: "Sample App"},
+				{Name: "FLAGS", Value: "-a"},
+				{Name: "VARIANT", Value: "Debug"},
+				{Name: "URL_Debug", Value: "http://localhost"},
+			},
+			{
+				{Name: "FLAGS", Value: "$(inherited) -b"},
+				{Name: "BUNDLE_ID", Value: "io.bitrise.${PRODUCT_NAME:rfc1034identifier}"},
+				{Name: "MODULE_NAME", Value: "$(PRODUCT_NAME:c99extidentifier)"},
+				{Name: "URL", Value: "$(URL_$(VARIANT))"},
+				{Name: "A", Value: "$(B)"},
+				{Name: "B", Value: "$(A)"},
+				{Name: "FALLBACK", Value: "$(UNDEFINED:default=fallback)"},
+				{Name: "UNTERMINATED", Value: "$(PRODUCT_NAME"},
+			},
+		},
+		conditions: map[string]string{},
+	}
+
+	requireBuildSetting(t, settings, "FLAGS", "-a -b")
+	requireBuildSetting(t, settings, "BUNDLE_ID", "io.bitrise.Sample-App")
+	requireBuildSetting(t, settings, "MODULE_NAME", "Sample_App")
+	requireBuildSetting(t, settings, "URL", "http://localhost")
+	requireBuildSetting(t, settings, "A", "")
+	requireBuildSetting(t, settings, "FALLBACK", "fallback")
+	requireBuildSetting(t, settings, "UNTERMINATED", "$(PRODUCT_NAME")
+}
+
+func TestApplyBuildSettingOperator(t *testing.T) {
+	require.Equal(t, "sample", applyBuildSettingOperator("Sample", "lower"))
+	require.Equal(t, "SAMPLE", applyBuildSettingOperator("Sample", "upper"))
+	require.Equal(t, "_2D_Game", applyBuildSettingOperator("2D Game", "c99extidentifier"))
+	require.Equal(t, "My-App.iOS", applyBuildSettingOperator("My App.iOS", "rfc1034identifier"))
+	require.Equal(t, "Info", applyBuildSettingOperator("SampleApp/Info.plist", "base"))
+	require.Equal(t, "SampleApp/", applyBuildSettingOperator("SampleApp/Info.plist", "dir"))
+	require.Equal(t, "Info.plist", applyBuildSettingOperator("SampleApp/Info.plist", "file"))
+	require.Equal(t, ".plist", applyBuildSettingOperator("SampleApp/Info.plist", "suffix"))
+	require.Equal(t, "a/c", applyBuildSettingOperator("a/b/../c", "standardizepath"))
+	require.Equal(t, "value", applyBuildSettingOperator("value", "unknown"))
+}
@@ -0,0 +1,258 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// Carthage file names
+const (
+	CartfileName         = "Cartfile"
+	CartfilePrivateName  = "Cartfile.private"
+	CartfileResolvedName = "Cartfile.resolved"
+)
+
+// Cartfile dependency origins
+const (
+	CartfileOriginGitHub = "github"
+	CartfileOriginGit    = "git"
+	CartfileOriginBinary = "binary"
+)
+
+// carthageBuildDir is the directory of the frameworks built (or downloaded) by Carthage, relative to the project's directory.
+const carthageBuildDir = "Carthage/Build"
+
+var (
+	cartfileLineRegexp           = regexp.MustCompile(`^(github|git|binary)\s+"([^"]+)"\s*(.*)$`)
+	carthageCopyFrameworksRegexp = regexp.MustCompile(`\bcarthage\s+copy-frameworks\b`)
+)
+
+// CartfileDependency is a dependency of a Cartfile, Cartfile.private or Cartfile.resolved.
+type CartfileDependency struct {
+	// Origin is github, git or binary.
+	Origin string
+	// Location is the `owner/repo` of a github dependency, or the URL (or path) of a git or binary dependency.
+	Location string
+	// Requirement is the version requirement, like `~> 5.6`, or a quoted git reference (branch, tag or commit),
+	// empty if any version is accepted. In Cartfile.resolved it is the resolved version or commit.
+	Requirement string
+	Line        int
+}
+
+// Name returns the name of the dependency, like `Alamofire` for `github "Alamofire/Alamofire"`,
+// which is the name of its framework for most of the dependencies.
+func (d CartfileDependency) Name() string {
+	name := path.Base(strings.TrimSuffix(d.Location, "/"))
+	if d.Origin == CartfileOriginBinary {
+		return strings.TrimSuffix(name, ".json")
+	}
+	return strings.TrimSuffix(name, ".git")
+}
+
+// Cartfile is a Cartfile, Cartfile.private or Cartfile.resolved.
+type Cartfile struct {
+	Path         string
+	Dependencies []CartfileDependency
+}
+
+// OpenCartfile parses the Cartfile, Cartfile.private or Cartfile.resolved at the given path.
+func OpenCartfile(pth string) (*Cartfile, error) {
+	if exist, err := pathutil.IsPathExists(pth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, fmt.Errorf("%s does not exist at: %s", filepath.Base(pth), pth)
+	}
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCartfile(content, pth)
+}
+
+// ParseCartfile parses Cartfile (or Cartfile.private, Cartfile.resolved) content: a dependency per line,
+// like `github "Alamofire/Alamofire" ~> 5.6`, comments start with `#`.
+func ParseCartfile(content, pth string) (*Cartfile, error) {
+	cartfile := &Cartfile{Path: pth, Dependencies: []CartfileDependency{}}
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		match := cartfileLineRegexp.FindStringSubmatch(line)
+		if match == nil {
+			return nil, fmt.Errorf("failed to parse %s: invalid dependency at line %d: %s", pth, i+1, line)
+		}
+
+		requirement := strings.TrimSpace(match[3])
+		if idx := strings.Index(requirement, "#"); idx != -1 && strings.Count(requirement[:idx], `"`)%2 == 0 {
+			requirement = strings.TrimSpace(requirement[:idx])
+		}
+
+		cartfile.Dependencies = append(cartfile.Dependencies, CartfileDependency{
+			Origin:      match[1],
+			Location:    match[2],
+			Requirement: strings.Trim(requirement, `"`),
+			Line:        i + 1,
+		})
+	}
+
+	return cartfile, nil
+}
+
+// Dependency returns the dependency with the given name (case-insensitively).
+func (c *Cartfile) Dependency(name string) (CartfileDependency, bool) {
+	for _, dependency := range c.Dependencies {
+		if strings.EqualFold(dependency.Name(), name) {
+			return dependency, true
+		}
+	}
+	return CartfileDependency{}, false
+}
+
+// CarthageFramework is a framework built by Carthage, referenced by the project.
+type CarthageFramework struct {
+	// Name is the name of the framework, like `Alamofire` for `Carthage/Build/Alamofire.xcframework`.
+	Name string
+	// Path is the path of the framework: the file reference's path resolved against its parent groups,
+	// or the input path of the `carthage copy-frameworks` phase.
+	Path string
+}
+
+// CarthageSearchPath is a FRAMEWORK_SEARCH_PATHS item, pointing into the Carthage/Build directory.
+type CarthageSearchPath struct {
+	// Target is nil for the project level build settings.
+	Target        Target
+	Configuration string
+	Path          string
+}
+
+// CarthageCopyFrameworksScripts returns the Run Script build phases, which run `carthage copy-frameworks`.
+func (p *Project) CarthageCopyFrameworksScripts() []TargetShellScript {
+	scripts := []TargetShellScript{}
+	for _, script := range p.ShellScripts() {
+		if carthageCopyFrameworksRegexp.MatchString(script.BuildPhase.ShellScript) {
+			scripts = append(scripts, script)
+		}
+	}
+	return scripts
+}
+
+// CarthageFrameworks returns the Carthage built frameworks, which the project references:
+// the .framework and .xcframework file references and the input paths of the `carthage copy-frameworks` phases
+// in the Carthage/Build directory. Frameworks are listed once: the file references in group tree order
+// (depth-first, from the main group), then the input paths in build phase order.
+// File references are matched by their resolved path, so a framework under a `Carthage/Build/iOS` group is found too.
+func (p *Project) CarthageFrameworks() []CarthageFramework {
+	paths := []string{}
+	if p.RootObject != nil && p.RootObject.MainGroup != nil {
+		for _, id := range fileElementTree(p.RootObject.MainGroup) {
+			fileReference, ok := p.Objects[id].(*PBXFileReference)
+			if !ok {
+				continue
+			}
+
+			pth, err := p.FileElementPath(fileReference)
+			if err != nil {
+				// paths relative to build settings can not be resolved
+				pth = fileReference.Path
+			}
+			paths = append(paths, filepath.ToSlash(pth))
+		}
+	}
+	for _, script := range p.CarthageCopyFrameworksScripts() {
+		paths = append(paths, script.BuildPhase.InputPaths...)
+	}
+
+	frameworks := []CarthageFramework{}
+	listed := map[string]bool{}
+	for _, pth := range paths {
+		ext := path.Ext(pth)
+		if !strings.Contains("/"+pth, "/"+carthageBuildDir+"/") || (ext != ".framework" && ext != ".xcframework") {
+			continue
+		}
+
+		name := strings.TrimSuffix(path.Base(pth), ext)
+		if listed[name] {
+			continue
+		}
+		listed[name] = true
+		frameworks = append(frameworks, CarthageFramework{Name: name, Path: pth})
+	}
+	return frameworks
+}
+
+// CarthageFrameworkSearchPaths returns the FRAMEWORK_SEARCH_PATHS items of the project and target build configurations,
+// which point into the Carthage/Build directory.
+func (p *Project) CarthageFrameworkSearchPaths() []CarthageSearchPath {
+	searchPaths := []CarthageSearchPath{}
+	if p.RootObject == nil {
+		return searchPaths
+	}
+
+	add := func(target Target, configurationList *XCConfigurationList) {
+		if configurationList == nil {
+			return
+		}
+		for _, configuration := range configurationList.BuildConfigurations {
+			var items []string
+			switch value := configuration.BuildSettings["FRAMEWORK_SEARCH_PATHS"].(type) {
+			case string:
+				items = strings.Fields(value)
+			case []string:
+				items = value
+			}
+
+			for _, item := range items {
+				item = strings.Trim(item, `"`)
+				if strings.Contains(item, carthageBuildDir) {
+					searchPaths = append(searchPaths, CarthageSearchPath{Target: target, Configuration: configuration.Name, Path: item})
+				}
+			}
+		}
+	}
+
+	add(nil, p.RootObject.BuildConfigurationList)
+	for _, target := range p.Targets() {
+		add(target, target.AbstractTarget().BuildConfigurationList)
+	}
+	return searchPaths
+}
+
+// CarthageMissingFrameworks returns the Carthage built frameworks referenced by the project,
+// which have no dependency of the same name in the Cartfile.resolved.
+// Dependencies building multiple (or differently named) frameworks are not recognised, their frameworks are reported.
+func (p *Project) CarthageMissingFrameworks(resolved *Cartfile) []CarthageFramework {
+	missing := []CarthageFramework{}
+	for _, framework := range p.CarthageFrameworks() {
+		if _, found := resolved.Dependency(framework.Name); !found {
+			missing = append(missing, framework)
+		}
+	}
+	return missing
+}
+
+// ProjectCarthageMissingFrameworks checks the Carthage built frameworks of the project against the Cartfile.resolved
+// next to the project.
+func ProjectCarthageMissingFrameworks(projectPth string) ([]CarthageFramework, error) {
+	project, err := OpenProject(projectPth)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := OpenCartfile(filepath.Join(filepath.Dir(projectPth), CartfileResolvedName))
+	if err != nil {
+		return nil, err
+	}
+
+	return project.CarthageMissingFrameworks(resolved), nil
+}
@@ -0,0 +1,153 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const cartfileContent = `# Networking
+github "Alamofire/Alamofire" ~> 5.6
+github "ReactiveCocoa/ReactiveSwift" == 7.1.0 # pinned until the migration
+git "https://example.com/team/Logger.git" "main"
+
+binary "https://example.com/frameworks/Analytics.json" >= 2.0
+github "Quick/Nimble"
+`
+
+const cartfileResolvedContent = `binary "https://example.com/frameworks/Analytics.json" "2.1.0"
+github "Alamofire/Alamofire" "5.6.1"
+git "https://example.com/team/Logger.git" "4b5f1e0a2d3c9e8f7a6b5c4d3e2f1a0b9c8d7e6f"
+github "Quick/Nimble" "v12.0.0"
+github "ReactiveCocoa/ReactiveSwift" "7.1.0"
+`
+
+func TestParseCartfile(t *testing.T) {
+	cartfile, err := ParseCartfile(cartfileContent, CartfileName)
+	require.NoError(t, err)
+	require.Equal(t, CartfileName, cartfile.Path)
+	require.Equal(t, []CartfileDependency{
+		{Origin: CartfileOriginGitHub, Location: "Alamofire/Alamofire", Requirement: "~> 5.6", Line: 2},
+		{Origin: CartfileOriginGitHub, Location: "ReactiveCocoa/ReactiveSwift", Requirement: "== 7.1.0", Line: 3},
+		{Origin: CartfileOriginGit, Location: "https://example.com/team/Logger.git", Requirement: "main", Line: 4},
+		{Origin: CartfileOriginBinary, Location: "https://example.com/frameworks/Analytics.json", Requirement: ">= 2.0", Line: 6},
+		{Origin: CartfileOriginGitHub, Location: "Quick/Nimble", Line: 7},
+	}, cartfile.Dependencies)
+
+	names := []string{}
+	for _, dependency := range cartfile.Dependencies {
+		names = append(names, dependency.Name())
+	}
+	require.Equal(t, []string{"Alamofire", "ReactiveSwift", "Logger", "Analytics", "Nimble"}, names)
+
+	t.Log("resolved")
+	{
+		resolved, err := ParseCartfile(cartfileResolvedContent, CartfileResolvedName)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(resolved.Dependencies))
+
+		dependency, found := resolved.Dependency("logger")
+		require.Equal(t, true, found)
+		require.Equal(t, "4b5f1e0a2d3c9e8f7a6b5c4d3e2f1a0b9c8d7e6f", dependency.Requirement)
+
+		_, found = resolved.Dependency("Quick")
+		require.Equal(t, false, found)
+	}
+
+	t.Log("invalid")
+	{
+		_, err := ParseCartfile("github Alamofire/Alamofire\n", CartfileName)
+		require.EqualError(t, err, "failed to parse Cartfile: invalid dependency at line 1: github Alamofire/Alamofire")
+	}
+}
+
+func TestCarthageFrameworks(t *testing.T) {
+	project, err := ParseProject(kitPbxprojContent)
+	require.NoError(t, err)
+	project.IDGenerator = NewDeterministicObjectIDGenerator(project, "Kit.xcodeproj")
+	kit, _ := project.TargetByName("Kit")
+	kitTests, _ := project.TargetByName("KitTests")
+
+	_, err = project.AddFileReference(project.RootObject.MainGroup, "Carthage/Build/Alamofire.xcframework", "<group>")
+	require.NoError(t, err)
+	_, err = project.AddFileReference(project.RootObject.MainGroup, "Carthage/Build/iOS/Analytics.framework", "<group>")
+	require.NoError(t, err)
+	_, err = project.AddFileReference(project.RootObject.MainGroup, "Vendor/Crashlytics.framework", "<group>")
+	require.NoError(t, err)
+	_, err = project.AddShellScriptBuildPhase(kitTests, ShellScript{
+		Name:   "Copy Carthage Frameworks",
+		Script: "/usr/local/bin/carthage copy-frameworks\n",
+		InputPaths: []string{
+			"$(SRCROOT)/Carthage/Build/iOS/Nimble.framework",
+			"$(SRCROOT)/Carthage/Build/iOS/Quick.framework",
+			"$(SRCROOT)/Carthage/Build/Alamofire.xcframework",
+		},
+	}, -1)
+	require.NoError(t, err)
+	_, err = project.AddShellScriptBuildPhase(kit, ShellScript{Name: "SwiftLint", Script: "swiftlint\n"}, -1)
+	require.NoError(t, err)
+
+	scripts := project.CarthageCopyFrameworksScripts()
+	require.Equal(t, 1, len(scripts))
+	require.Equal(t, kitTests, scripts[0].Target)
+
+	frameworks := project.CarthageFrameworks()
+	names := []string{}
+	for _, framework := range frameworks {
+		names = append(names, framework.Name)
+	}
+	require.Equal(t, []string{"Alamofire", "Analytics", "Nimble", "Quick"}, names)
+	require.Equal(t, "Carthage/Build/Alamofire.xcframework", frameworks[0].Path)
+
+	require.Equal(t, []CarthageSearchPath{
+		{Target: kit, Configuration: "Debug", Path: "$(PROJECT_DIR)/Carthage/Build/iOS"},
+		{Target: kit, Configuration: "Release", Path: "$(PROJECT_DIR)/Carthage/Build/iOS"},
+	}, project.CarthageFrameworkSearchPaths())
+
+	resolved, err := ParseCartfile(cartfileResolvedContent, CartfileResolvedName)
+	require.NoError(t, err)
+	require.Equal(t, []CarthageFramework{
+		{Name: "Quick", Path: "$(SRCROOT)/Carthage/Build/iOS/Quick.framework"},
+	}, project.CarthageMissingFrameworks(resolved))
+
+	t.Log("framework under a Carthage/Build group")
+	{
+		project, err := ParseProject(kitPbxprojContent)
+		require.NoError(t, err)
+		project.Path = "/Kit/Kit.xcodeproj"
+		project.IDGenerator = NewDeterministicObjectIDGenerator(project, "Kit.xcodeproj")
+
+		group, err := project.AddGroup(project.RootObject.MainGroup, "Carthage", "Carthage/Build/iOS")
+		require.NoError(t, err)
+		_, err = project.AddFileReference(group, "Alamofire.framework", "<group>")
+		require.NoError(t, err)
+		_, err = project.AddFileReference(project.RootObject.MainGroup, "MyCarthage/Build/Logger.framework", "<group>")
+		require.NoError(t, err)
+
+		require.Equal(t, []CarthageFramework{
+			{Name: "Alamofire", Path: "/Kit/Carthage/Build/iOS/Alamofire.framework"},
+		}, project.CarthageFrameworks())
+	}
+}
+
+func TestProjectCarthageMissingFrameworks(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	projectPth := filepath.Join(tmpDir, "Kit.xcodeproj")
+	writeTestProject(t, projectPth, kitPbxprojContent)
+
+	_, err = ProjectCarthageMissingFrameworks(projectPth)
+	require.EqualError(t, err, "Cartfile.resolved does not exist at: "+filepath.Join(tmpDir, CartfileResolvedName))
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, CartfileResolvedName), []byte(cartfileResolvedContent), 0644))
+	missing, err := ProjectCarthageMissingFrameworks(projectPth)
+	require.NoError(t, err)
+	require.Equal(t, []CarthageFramework{}, missing)
+}
@@ -0,0 +1,343 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// CocoaPods generated names
+const (
+	PodsProjectName = "Pods"
+	// CocoaPodsScriptPhasePrefix is the name prefix of the Run Script build phases, which CocoaPods adds to the integrated targets.
+	CocoaPodsScriptPhasePrefix = "[CP] "
+	// CocoaPodsCheckManifestLockPhaseName is the name of the build phase, which fails the build if Manifest.lock is out of sync with Podfile.lock.
+	CocoaPodsCheckManifestLockPhaseName = "[CP] Check Pods Manifest.lock"
+)
+
+var podVersionRegexp = regexp.MustCompile(`^(.+?) \((.+)\)$`)
+
+// Pod is a pod installed by CocoaPods, as listed in the PODS section of a Podfile.lock.
+type Pod struct {
+	// Name is the name of the pod, including the subspec, like `Firebase/Core`.
+	Name    string
+	Version string
+	// Dependencies are the requirements of the pod, like `FirebaseAnalytics (= 10.0.0)`.
+	Dependencies []string
+}
+
+// PodfileLock is a Podfile.lock (or the Pods/Manifest.lock copy of it), written by `pod install`.
+type PodfileLock struct {
+	Path string
+	Pods []Pod
+	// Dependencies are the requirements of the Podfile, like `Alamofire (~> 5.6)`.
+	Dependencies []string
+	// SpecRepos maps the spec repositories, like `trunk`, to the names of the pods installed from them.
+	SpecRepos map[string][]string
+	// Checksums maps the pod names (without subspecs) to the checksums of their podspecs.
+	Checksums        map[string]string
+	PodfileChecksum  string
+	CocoaPodsVersion string
+}
+
+// OpenPodfileLock parses the Podfile.lock (or Manifest.lock) at the given path.
+func OpenPodfileLock(pth string) (*PodfileLock, error) {
+	if exist, err := pathutil.IsPathExists(pth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, fmt.Errorf("Podfile.lock does not exist at: %s", pth)
+	}
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePodfileLock(content, pth)
+}
+
+// ParsePodfileLock parses Podfile.lock content: the PODS, DEPENDENCIES, SPEC REPOS, CHECKSUMS,
+// PODFILE CHECKSUM and COCOAPODS sections, other sections are skipped.
+func ParsePodfileLock(content, pth string) (*PodfileLock, error) {
+	lock := &PodfileLock{
+		Path:         pth,
+		Pods:         []Pod{},
+		Dependencies: []string{},
+		SpecRepos:    map[string][]string{},
+		Checksums:    map[string]string{},
+	}
+
+	section, specRepo := "", ""
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, " \r")
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" {
+			continue
+		}
+		indent := len(line) - len(trimmed)
+		item := strings.HasPrefix(trimmed, "- ")
+
+		if indent == 0 {
+			key, value := splitPodfileLockKeyValue(trimmed)
+			section = key
+			switch key {
+			case "PODFILE CHECKSUM":
+				lock.PodfileChecksum = value
+			case "COCOAPODS":
+				lock.CocoaPodsVersion = value
+			}
+			continue
+		}
+
+		switch {
+		case section == "PODS" && indent == 2 && item:
+			entry := unquotePodfileLockValue(strings.TrimSuffix(strings.TrimPrefix(trimmed, "- "), ":"))
+			match := podVersionRegexp.FindStringSubmatch(entry)
+			if match == nil {
+				return nil, fmt.Errorf("failed to parse Podfile.lock (%s): invalid pod at line %d: %s", pth, i+1, entry)
+			}
+			lock.Pods = append(lock.Pods, Pod{Name: match[1], Version: match[2], Dependencies: []string{}})
+		case section == "PODS" && indent == 4 && item:
+			if len(lock.Pods) == 0 {
+				return nil, fmt.Errorf("failed to parse Podfile.lock (%s): pod dependency without pod at line %d", pth, i+1)
+			}
+			pod := &lock.Pods[len(lock.Pods)-1]
+			pod.Dependencies = append(pod.Dependencies, unquotePodfileLockValue(strings.TrimPrefix(trimmed, "- ")))
+		case section == "DEPENDENCIES" && item:
+			lock.Dependencies = append(lock.Dependencies, unquotePodfileLockValue(strings.TrimPrefix(trimmed, "- ")))
+		case section == "SPEC REPOS" && indent == 2:
+			specRepo, _ = splitPodfileLockKeyValue(trimmed)
+			lock.SpecRepos[specRepo] = []string{}
+		case section == "SPEC REPOS" && item:
+			if specRepo == "" {
+				return nil, fmt.Errorf("failed to parse Podfile.lock (%s): pod without spec repo at line %d", pth, i+1)
+			}
+			lock.SpecRepos[specRepo] = append(lock.SpecRepos[specRepo], unquotePodfileLockValue(strings.TrimPrefix(trimmed, "- ")))
+		case section == "CHECKSUMS" && indent == 2:
+			name, checksum := splitPodfileLockKeyValue(trimmed)
+			lock.Checksums[name] = checksum
+		}
+	}
+
+	return lock, nil
+}
+
+// splitPodfileLockKeyValue splits a `key: value` (or `key:`) line, the key may be quoted.
+func splitPodfileLockKeyValue(line string) (string, string) {
+	keyEnd := 0
+	if strings.HasPrefix(line, `"`) {
+		if end := strings.Index(line[1:], `"`); end != -1 {
+			keyEnd = end + 2
+		}
+	}
+
+	idx := strings.Index(line[keyEnd:], ":")
+	if idx == -1 {
+		return unquotePodfileLockValue(line), ""
+	}
+	idx += keyEnd
+	return unquotePodfileLockValue(line[:idx]), unquotePodfileLockValue(strings.TrimSpace(line[idx+1:]))
+}
+
+func unquotePodfileLockValue(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// Pod returns the installed pod with the given name.
+func (l *PodfileLock) Pod(name string) (Pod, bool) {
+	for _, pod := range l.Pods {
+		if pod.Name == name {
+			return pod, true
+		}
+	}
+	return Pod{}, false
+}
+
+// PodsManifestLockPath returns the path of the Pods/Manifest.lock, which belongs to the given Podfile.lock.
+func PodsManifestLockPath(podfileLockPth string) string {
+	return filepath.Join(filepath.Dir(podfileLockPth), PodsProjectName, "Manifest.lock")
+}
+
+// PodsSyncIssueKind is the kind of a difference between the Podfile.lock and the Pods/Manifest.lock.
+type PodsSyncIssueKind string
+
+// Pods sync issue kinds
+const (
+	PodsSyncIssueMissingManifestLock     PodsSyncIssueKind = "missing_manifest_lock"
+	PodsSyncIssuePodfileChecksumMismatch PodsSyncIssueKind = "podfile_checksum_mismatch"
+	PodsSyncIssueNotInstalled            PodsSyncIssueKind = "not_installed"
+	PodsSyncIssueNotLocked               PodsSyncIssueKind = "not_locked"
+	PodsSyncIssueVersionMismatch         PodsSyncIssueKind = "version_mismatch"
+	PodsSyncIssueChecksumMismatch        PodsSyncIssueKind = "checksum_mismatch"
+)
+
+// PodsSyncIssue is a difference between the Podfile.lock and the Pods/Manifest.lock,
+// which fails the build in the `[CP] Check Pods Manifest.lock` build phase.
+type PodsSyncIssue struct {
+	Kind PodsSyncIssueKind
+	// Pod is empty for PodsSyncIssueMissingManifestLock and PodsSyncIssuePodfileChecksumMismatch.
+	Pod string
+	// PodfileLockValue and ManifestLockValue are the differing versions or checksums.
+	PodfileLockValue  string
+	ManifestLockValue string
+}
+
+func (i PodsSyncIssue) String() string {
+	switch i.Kind {
+	case PodsSyncIssueMissingManifestLock:
+		return "Pods/Manifest.lock does not exist, run `pod install`"
+	case PodsSyncIssuePodfileChecksumMismatch:
+		return fmt.Sprintf("Podfile checksum is %s in Podfile.lock, but %s in Manifest.lock", i.PodfileLockValue, i.ManifestLockValue)
+	case PodsSyncIssueNotInstalled:
+		return fmt.Sprintf("%s (%s) is locked in Podfile.lock, but not installed", i.Pod, i.PodfileLockValue)
+	case PodsSyncIssueNotLocked:
+		return fmt.Sprintf("%s (%s) is installed, but not locked in Podfile.lock", i.Pod, i.ManifestLockValue)
+	case PodsSyncIssueVersionMismatch:
+		return fmt.Sprintf("%s is %s in Podfile.lock, but %s in Manifest.lock", i.Pod, i.PodfileLockValue, i.ManifestLockValue)
+	case PodsSyncIssueChecksumMismatch:
+		return fmt.Sprintf("%s checksum is %s in Podfile.lock, but %s in Manifest.lock", i.Pod, i.PodfileLockValue, i.ManifestLockValue)
+	}
+	return string(i.Kind)
+}
+
+// PodsSyncIssues compares the Podfile.lock at the given path with its Pods/Manifest.lock.
+// No issues means the installed pods are in sync with the Podfile.lock.
+func PodsSyncIssues(podfileLockPth string) ([]PodsSyncIssue, error) {
+	podfileLock, err := OpenPodfileLock(podfileLockPth)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestLockPth := PodsManifestLockPath(podfileLockPth)
+	if exist, err := pathutil.IsPathExists(manifestLockPth); err != nil {
+		return nil, err
+	} else if !exist {
+		return []PodsSyncIssue{{Kind: PodsSyncIssueMissingManifestLock}}, nil
+	}
+
+	manifestLock, err := OpenPodfileLock(manifestLockPth)
+	if err != nil {
+		return nil, err
+	}
+
+	return ComparePodfileLocks(podfileLock, manifestLock), nil
+}
+
+// ComparePodfileLocks returns the differences of the Podfile.lock and the Manifest.lock:
+// the Podfile checksum, then the pods in Podfile.lock order, then the pods missing from the Podfile.lock.
+func ComparePodfileLocks(podfileLock, manifestLock *PodfileLock) []PodsSyncIssue {
+	issues := []PodsSyncIssue{}
+	if podfileLock.PodfileChecksum != manifestLock.PodfileChecksum {
+		issues = append(issues, PodsSyncIssue{
+			Kind:              PodsSyncIssuePodfileChecksumMismatch,
+			PodfileLockValue:  podfileLock.PodfileChecksum,
+			ManifestLockValue: manifestLock.PodfileChecksum,
+		})
+	}
+
+	for _, pod := range podfileLock.Pods {
+		installed, found := manifestLock.Pod(pod.Name)
+		if !found {
+			issues = append(issues, PodsSyncIssue{Kind: PodsSyncIssueNotInstalled, Pod: pod.Name, PodfileLockValue: pod.Version})
+		} else if installed.Version != pod.Version {
+			issues = append(issues, PodsSyncIssue{Kind: PodsSyncIssueVersionMismatch, Pod: pod.Name, PodfileLockValue: pod.Version, ManifestLockValue: installed.Version})
+		}
+	}
+
+	for _, pod := range manifestLock.Pods {
+		if _, found := podfileLock.Pod(pod.Name); !found {
+			issues = append(issues, PodsSyncIssue{Kind: PodsSyncIssueNotLocked, Pod: pod.Name, ManifestLockValue: pod.Version})
+		}
+	}
+
+	for _, pod := range podfileLock.Pods {
+		if strings.Contains(pod.Name, "/") {
+			continue
+		}
+		checksum, installedChecksum := podfileLock.Checksums[pod.Name], manifestLock.Checksums[pod.Name]
+		if installedChecksum != "" && checksum != installedChecksum {
+			issues = append(issues, PodsSyncIssue{Kind: PodsSyncIssueChecksumMismatch, Pod: pod.Name, PodfileLockValue: checksum, ManifestLockValue: installedChecksum})
+		}
+	}
+
+	return issues
+}
+
+// IsPodsProject reports whether the project is the CocoaPods generated Pods project.
+func IsPodsProject(projectPth string) bool {
+	return filepath.Base(projectPth) == PodsProjectName+XCodeProjExt
+}
+
+// ExcludePodsProjects returns the projects, which are not CocoaPods generated Pods projects.
+func ExcludePodsProjects(projects []string) []string {
+	filtered := []string{}
+	for _, project := range projects {
+		if !IsPodsProject(project) {
+			filtered = append(filtered, project)
+		}
+	}
+	return filtered
+}
+
+// ExcludePodsSchemeTargets returns the schemes, which are neither contained by a Pods project,
+// nor build only targets of a Pods project (like the schemes CocoaPods shares for the pods).
+func ExcludePodsSchemeTargets(schemes []SchemeTargets) []SchemeTargets {
+	filtered := []SchemeTargets{}
+	for _, scheme := range schemes {
+		if IsPodsProject(scheme.Container) {
+			continue
+		}
+
+		podsOnly := len(scheme.Build) > 0
+		for _, target := range scheme.Build {
+			if !IsPodsProject(target.Project.Path) {
+				podsOnly = false
+			}
+		}
+		if !podsOnly {
+			filtered = append(filtered, scheme)
+		}
+	}
+	return filtered
+}
+
+// CocoaPodsScripts returns the Run Script build phases, which CocoaPods added to the targets of the project,
+// like `[CP] Check Pods Manifest.lock` or `[CP] Embed Pods Frameworks`.
+func (p *Project) CocoaPodsScripts() []TargetShellScript {
+	scripts := []TargetShellScript{}
+	for _, script := range p.ShellScripts() {
+		if strings.HasPrefix(script.BuildPhase.Name, CocoaPodsScriptPhasePrefix) {
+			scripts = append(scripts, script)
+		}
+	}
+	return scripts
+}
+
+// CocoaPodsIntegratedTargets returns the targets of the project, which CocoaPods integrated:
+// the targets with a `[CP] Check Pods Manifest.lock` build phase.
+func (p *Project) CocoaPodsIntegratedTargets() []Target {
+	targets := []Target{}
+	for _, script := range p.CocoaPodsScripts() {
+		if script.BuildPhase.Name == CocoaPodsCheckManifestLockPhaseName {
+			targets = append(targets, script.Target)
+		}
+	}
+	return targets
+}
+
+// PodsProjectPath returns the path of the Pods project, referenced by the workspace.
+func (w *Workspace) PodsProjectPath() (string, bool) {
+	for _, fileRef := range w.fileRefs {
+		if fileRef.IsPodsProject() {
+			return fileRef.Path, true
+		}
+	}
+	return "", false
+}
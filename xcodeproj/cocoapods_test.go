@@ -0,0 +1,215 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePodfileLock(t *testing.T) {
+	lock, err := ParsePodfileLock(podfileLockContent, "Podfile.lock")
+	require.NoError(t, err)
+	require.Equal(t, "Podfile.lock", lock.Path)
+
+	require.Equal(t, []Pod{
+		{Name: "Alamofire", Version: "5.6.1", Dependencies: []string{}},
+		{Name: "Firebase/CoreOnly", Version: "10.0.0", Dependencies: []string{"FirebaseCore (= 10.0.0)"}},
+		{Name: "FirebaseCore", Version: "10.0.0", Dependencies: []string{"GoogleUtilities/Environment (~> 7.8)"}},
+		{Name: "GoogleUtilities/Environment", Version: "7.10.0", Dependencies: []string{}},
+		{Name: "Kit", Version: "1.0.0", Dependencies: []string{}},
+	}, lock.Pods)
+	require.Equal(t, []string{"Alamofire (~> 5.6)", "Firebase/CoreOnly", "Kit (from `../Kit`)"}, lock.Dependencies)
+	require.Equal(t, map[string][]string{"trunk": {"Alamofire", "Firebase", "FirebaseCore", "GoogleUtilities"}}, lock.SpecRepos)
+	require.Equal(t, 5, len(lock.Checksums))
+	require.Equal(t, "f3b09a368f1582ab751b3fff5460276e0d2cf5c9", lock.Checksums["Alamofire"])
+	require.Equal(t, "8b4d5a1c2e5b8f1e2c7f5f5a3e3b1d2c9a4f6e7d", lock.PodfileChecksum)
+	require.Equal(t, "1.11.3", lock.CocoaPodsVersion)
+
+	pod, found := lock.Pod("GoogleUtilities/Environment")
+	require.Equal(t, true, found)
+	require.Equal(t, "7.10.0", pod.Version)
+
+	t.Log("spec repo url")
+	{
+		lock, err := ParsePodfileLock(`SPEC REPOS:
+  "https://github.com/CocoaPods/Specs.git":
+    - Alamofire
+`, "Podfile.lock")
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{"https://github.com/CocoaPods/Specs.git": {"Alamofire"}}, lock.SpecRepos)
+	}
+
+	t.Log("invalid")
+	{
+		_, err := ParsePodfileLock("PODS:\n  - Alamofire\n", "Podfile.lock")
+		require.EqualError(t, err, "failed to parse Podfile.lock (Podfile.lock): invalid pod at line 2: Alamofire")
+	}
+}
+
+func TestPodsSyncIssues(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	podfileLockPth := filepath.Join(tmpDir, "Podfile.lock")
+	require.NoError(t, ioutil.WriteFile(podfileLockPth, []byte(podfileLockContent), 0644))
+	require.Equal(t, filepath.Join(tmpDir, "Pods", "Manifest.lock"), PodsManifestLockPath(podfileLockPth))
+
+	t.Log("pods not installed")
+	{
+		issues, err := PodsSyncIssues(podfileLockPth)
+		require.NoError(t, err)
+		require.Equal(t, []PodsSyncIssue{{Kind: PodsSyncIssueMissingManifestLock}}, issues)
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "Pods"), 0755))
+
+	t.Log("in sync")
+	{
+		require.NoError(t, ioutil.WriteFile(PodsManifestLockPath(podfileLockPth), []byte(podfileLockContent), 0644))
+		issues, err := PodsSyncIssues(podfileLockPth)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(issues))
+	}
+
+	t.Log("out of sync")
+	{
+		manifestLockContent := strings.Replace(podfileLockContent, "Alamofire (5.6.1)", "Alamofire (5.6.0)", 1)
+		manifestLockContent = strings.Replace(manifestLockContent, "  - Kit (1.0.0)\n", "  - Nimble (12.0.0)\n", 1)
+		manifestLockContent = strings.Replace(manifestLockContent, "FirebaseCore: 97f48a3a567a72b8d4daa0f03c3aadb78df4e995", "FirebaseCore: 0000000000000000000000000000000000000000", 1)
+		manifestLockContent = strings.Replace(manifestLockContent, "PODFILE CHECKSUM: 8b4d5a1c2e5b8f1e2c7f5f5a3e3b1d2c9a4f6e7d", "PODFILE CHECKSUM: 1e3ac8b08f4b5bf5b1f2a7e1e9c0d6a0f4b2c3d1", 1)
+		require.NoError(t, ioutil.WriteFile(PodsManifestLockPath(podfileLockPth), []byte(manifestLockContent), 0644))
+
+		issues, err := PodsSyncIssues(podfileLockPth)
+		require.NoError(t, err)
+
+		descriptions := []string{}
+		for _, issue := range issues {
+			descriptions = append(descriptions, issue.String())
+		}
+		require.Equal(t, []string{
+			"Podfile checksum is 8b4d5a1c2e5b8f1e2c7f5f5a3e3b1d2c9a4f6e7d in Podfile.lock, but 1e3ac8b08f4b5bf5b1f2a7e1e9c0d6a0f4b2c3d1 in Manifest.lock",
+			"Alamofire is 5.6.1 in Podfile.lock, but 5.6.0 in Manifest.lock",
+			"Kit (1.0.0) is locked in Podfile.lock, but not installed",
+			"Nimble (12.0.0) is installed, but not locked in Podfile.lock",
+			"FirebaseCore checksum is 97f48a3a567a72b8d4daa0f03c3aadb78df4e995 in Podfile.lock, but 0000000000000000000000000000000000000000 in Manifest.lock",
+		}, descriptions)
+	}
+
+	_, err = PodsSyncIssues(filepath.Join(tmpDir, "Kit", "Podfile.lock"))
+	require.EqualError(t, err, "Podfile.lock does not exist at: "+filepath.Join(tmpDir, "Kit", "Podfile.lock"))
+}
+
+func TestCocoaPodsScripts(t *testing.T) {
+	project, err := ParseProject(kitPbxprojContent)
+	require.NoError(t, err)
+	kit, _ := project.TargetByName("Kit")
+	kitTests, _ := project.TargetByName("KitTests")
+
+	for _, script := range []struct {
+		target Target
+		name   string
+	}{
+		{kit, CocoaPodsCheckManifestLockPhaseName},
+		{kit, "SwiftLint"},
+		{kitTests, CocoaPodsCheckManifestLockPhaseName},
+		{kitTests, "[CP] Embed Pods Frameworks"},
+	} {
+		_, err := project.AddShellScriptBuildPhase(script.target, ShellScript{Name: script.name, Script: "exit 0"}, -1)
+		require.NoError(t, err)
+	}
+
+	names := []string{}
+	for _, script := range project.CocoaPodsScripts() {
+		names = append(names, script.Target.AbstractTarget().Name+": "+script.BuildPhase.Name)
+	}
+	require.Equal(t, []string{
+		"Kit: [CP] Check Pods Manifest.lock",
+		"KitTests: [CP] Check Pods Manifest.lock",
+		"KitTests: [CP] Embed Pods Frameworks",
+	}, names)
+	require.Equal(t, []Target{kit, kitTests}, project.CocoaPodsIntegratedTargets())
+}
+
+func TestExcludePods(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	workspacePth := filepath.Join(tmpDir, "SampleApp.xcworkspace")
+	writeTestWorkspace(t, workspacePth, `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:SampleApp.xcodeproj">
+   </FileRef>
+   <FileRef
+      location = "group:Pods/Pods.xcodeproj">
+   </FileRef>
+</Workspace>
+`)
+	projectPth := writeSampleAppProject(t, tmpDir, sampleAppPbxprojContent)
+	podsProjectPth := filepath.Join(tmpDir, "Pods", "Pods.xcodeproj")
+	writeTestProject(t, podsProjectPth, kitPbxprojContent)
+
+	workspace, err := OpenWorkspace(workspacePth)
+	require.NoError(t, err)
+	pth, found := workspace.PodsProjectPath()
+	require.Equal(t, true, found)
+	require.Equal(t, podsProjectPth, pth)
+
+	projects, err := WorkspaceProjectReferences(workspacePth)
+	require.NoError(t, err)
+	require.Equal(t, []string{podsProjectPth, projectPth}, projects)
+	require.Equal(t, []string{projectPth}, ExcludePodsProjects(projects))
+
+	t.Log("targets")
+	{
+		projectTargets, err := ProjectTargets(projectPth)
+		require.NoError(t, err)
+
+		targets, err := WorkspaceTargets(workspacePth)
+		require.NoError(t, err)
+		require.Equal(t, true, targets["Kit"])
+
+		targets, err = WorkspaceTargetsWithOptions(workspacePth, WorkspaceListOptions{ExcludePods: true})
+		require.NoError(t, err)
+		require.Equal(t, projectTargets, targets)
+	}
+
+	t.Log("schemes")
+	{
+		podsSchemesDir := filepath.Join(podsProjectPth, "xcshareddata", "xcschemes")
+		require.NoError(t, os.MkdirAll(podsSchemesDir, 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(podsSchemesDir, "Kit.xcscheme"), []byte(compactSchemeContent), 0644))
+
+		schemes, err := WorkspaceSharedSchemes(workspacePth)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"Kit": true}, schemes)
+
+		schemes, err = WorkspaceSharedSchemesWithOptions(workspacePth, WorkspaceListOptions{ExcludePods: true})
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{}, schemes)
+	}
+
+	t.Log("scheme targets")
+	{
+		app := &Project{Path: projectPth}
+		pods := &Project{Path: podsProjectPth}
+		schemes := []SchemeTargets{
+			{Container: projectPth, Build: []SchemeTarget{{Project: app}, {Project: pods}}},
+			{Container: workspacePth, Build: []SchemeTarget{{Project: pods}}},
+			{Container: podsProjectPth, Build: []SchemeTarget{{Project: app}}},
+			{Container: workspacePth},
+		}
+		require.Equal(t, []SchemeTargets{schemes[0], schemes[3]}, ExcludePodsSchemeTargets(schemes))
+	}
+}
@@ -0,0 +1,113 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// Containers is the closure of a workspace or project:
+// every workspace and project reachable through workspace file references and projectReferences (sub-projects).
+type Containers struct {
+	// Workspaces lists the workspaces in discovery order, starting with the resolved workspace.
+	Workspaces []string
+	// Projects lists the projects in discovery order, a project is listed before its sub-projects.
+	Projects []string
+}
+
+// ResolveWorkspaceContainers walks the container graph of the workspace: nested workspaces, projects and sub-projects.
+// Containers referenced multiple times, including reference cycles, are visited once.
+func ResolveWorkspaceContainers(workspacePth string) (Containers, error) {
+	r := newContainerResolver()
+	if err := r.resolveWorkspace(workspacePth); err != nil {
+		return Containers{}, err
+	}
+	return r.containers, nil
+}
+
+// ResolveProjectContainers walks the sub-project graph of the project.
+// Projects referenced multiple times, including reference cycles, are visited once.
+func ResolveProjectContainers(projectPth string) (Containers, error) {
+	r := newContainerResolver()
+	if err := r.resolveProject(projectPth); err != nil {
+		return Containers{}, err
+	}
+	return r.containers, nil
+}
+
+type containerResolver struct {
+	visited    map[string]bool
+	containers Containers
+}
+
+func newContainerResolver() *containerResolver {
+	return &containerResolver{
+		visited: map[string]bool{},
+		containers: Containers{
+			Workspaces: []string{},
+			Projects:   []string{},
+		},
+	}
+}
+
+// visit reports whether the container is visited the first time.
+func (r *containerResolver) visit(pth string) (string, bool) {
+	pth = filepath.Clean(pth)
+	if r.visited[pth] {
+		return pth, false
+	}
+	r.visited[pth] = true
+	return pth, true
+}
+
+func (r *containerResolver) resolveWorkspace(workspacePth string) error {
+	workspacePth, first := r.visit(workspacePth)
+	if !first {
+		return nil
+	}
+	r.containers.Workspaces = append(r.containers.Workspaces, workspacePth)
+
+	workspace, err := OpenWorkspace(workspacePth)
+	if err != nil {
+		return err
+	}
+
+	for _, fileRef := range workspace.AllFileRefs() {
+		if IsXCWorkspace(fileRef.Path) {
+			if err := r.resolveWorkspace(fileRef.Path); err != nil {
+				return fmt.Errorf("failed to resolve workspace referenced by %s: %s", workspacePth, err)
+			}
+		} else if IsXCodeProj(fileRef.Path) {
+			if err := r.resolveProject(fileRef.Path); err != nil {
+				return fmt.Errorf("failed to resolve project referenced by %s: %s", workspacePth, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *containerResolver) resolveProject(projectPth string) error {
+	projectPth, first := r.visit(projectPth)
+	if !first {
+		return nil
+	}
+	r.containers.Projects = append(r.containers.Projects, projectPth)
+
+	project, err := OpenProject(projectPth)
+	if err != nil {
+		return err
+	}
+
+	subProjects, err := project.SubProjectPaths()
+	if err != nil {
+		return err
+	}
+
+	for _, subProject := range subProjects {
+		if err := r.resolveProject(subProject); err != nil {
+			return fmt.Errorf("failed to resolve sub-project of %s: %s", projectPth, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestWorkspace(t *testing.T, pth, content string) {
+	require.NoError(t, os.MkdirAll(pth, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pth, "contents.xcworkspacedata"), []byte(content), 0644))
+}
+
+func writeTestProject(t *testing.T, pth, content string) {
+	require.NoError(t, os.MkdirAll(pth, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pth, "project.pbxproj"), []byte(content), 0644))
+}
+
+func TestResolveWorkspaceContainers(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	// SampleApp.xcworkspace -> SampleApp.xcodeproj, Libs/Libs.xcworkspace
+	// Libs.xcworkspace -> Kit/Kit.xcodeproj, SampleApp.xcworkspace (cycle)
+	// Kit.xcodeproj -> Sub/Sub.xcodeproj (sub-project)
+	// Sub.xcodeproj -> Sub/Sub.xcodeproj (itself)
+	writeTestWorkspace(t, filepath.Join(tmpDir, "SampleApp.xcworkspace"), `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:SampleApp.xcodeproj">
+   </FileRef>
+   <Group
+      location = "group:Libs"
+      name = "Libs">
+      <FileRef
+         location = "group:Libs.xcworkspace">
+      </FileRef>
+   </Group>
+</Workspace>
+`)
+	writeTestWorkspace(t, filepath.Join(tmpDir, "Libs", "Libs.xcworkspace"), `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:Kit/Kit.xcodeproj">
+   </FileRef>
+   <FileRef
+      location = "container:../SampleApp.xcworkspace">
+   </FileRef>
+</Workspace>
+`)
+	writeTestProject(t, filepath.Join(tmpDir, "SampleApp.xcodeproj"), sampleAppPbxprojContent)
+	writeTestProject(t, filepath.Join(tmpDir, "Libs", "Kit", "Kit.xcodeproj"), kitPbxprojContent)
+	writeTestProject(t, filepath.Join(tmpDir, "Libs", "Sub", "Sub.xcodeproj"), kitPbxprojContent)
+
+	t.Log("workspace closure")
+	{
+		containers, err := ResolveWorkspaceContainers(filepath.Join(tmpDir, "SampleApp.xcworkspace"))
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			filepath.Join(tmpDir, "SampleApp.xcworkspace"),
+			filepath.Join(tmpDir, "Libs", "Libs.xcworkspace"),
+		}, containers.Workspaces)
+		require.Equal(t, []string{
+			filepath.Join(tmpDir, "SampleApp.xcodeproj"),
+			filepath.Join(tmpDir, "Libs", "Kit", "Kit.xcodeproj"),
+			filepath.Join(tmpDir, "Libs", "Sub", "Sub.xcodeproj"),
+		}, containers.Projects)
+	}
+
+	t.Log("project closure")
+	{
+		containers, err := ResolveProjectContainers(filepath.Join(tmpDir, "Libs", "Kit", "Kit.xcodeproj"))
+		require.NoError(t, err)
+		require.Equal(t, 0, len(containers.Workspaces))
+		require.Equal(t, []string{
+			filepath.Join(tmpDir, "Libs", "Kit", "Kit.xcodeproj"),
+			filepath.Join(tmpDir, "Libs", "Sub", "Sub.xcodeproj"),
+		}, containers.Projects)
+	}
+
+	t.Log("Workspace* functions")
+	{
+		projects, err := WorkspaceProjectReferences(filepath.Join(tmpDir, "SampleApp.xcworkspace"))
+		require.NoError(t, err)
+		require.Equal(t, []string{filepath.Join(tmpDir, "SampleApp.xcodeproj")}, projects)
+
+		projects, err = WorkspaceProjectReferencesWithOptions(filepath.Join(tmpDir, "SampleApp.xcworkspace"), WorkspaceListOptions{Recursive: true})
+		require.NoError(t, err)
+		require.Equal(t, 3, len(projects))
+
+		targets, err := WorkspaceTargets(filepath.Join(tmpDir, "SampleApp.xcworkspace"))
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"SampleApp": true}, targets)
+
+		targets, err = WorkspaceTargetsWithOptions(filepath.Join(tmpDir, "SampleApp.xcworkspace"), WorkspaceListOptions{Recursive: true})
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"SampleApp": true, "Kit": true}, targets)
+	}
+
+	t.Log("missing project")
+	{
+		require.NoError(t, os.RemoveAll(filepath.Join(tmpDir, "Libs", "Sub")))
+
+		_, err := ResolveWorkspaceContainers(filepath.Join(tmpDir, "SampleApp.xcworkspace"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to resolve sub-project of "+filepath.Join(tmpDir, "Libs", "Kit", "Kit.xcodeproj"))
+	}
+}
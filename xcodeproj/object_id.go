@@ -0,0 +1,95 @@
+package xcodeproj
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var objectIDRegexp = regexp.MustCompile(`^[0-9A-F]{24}$`)
+
+// randomRead fills the bytes with random data, replaced in tests.
+var randomRead = rand.Read
+
+// IsObjectID reports whether the string is an Xcode style object ID: 24 uppercase hexadecimal digits.
+func IsObjectID(id string) bool {
+	return objectIDRegexp.MatchString(id)
+}
+
+// ObjectIDGenerator generates Xcode style object IDs for new objects of a project.
+// Generated IDs are unique: they are checked against every object of the project and every ID generated before.
+type ObjectIDGenerator struct {
+	project   *Project
+	seed      string
+	random    bool
+	generated map[string]bool
+}
+
+// NewObjectIDGenerator returns a generator of random object IDs, like Xcode creates them.
+func NewObjectIDGenerator(project *Project) *ObjectIDGenerator {
+	return &ObjectIDGenerator{
+		project:   project,
+		random:    true,
+		generated: map[string]bool{},
+	}
+}
+
+// NewDeterministicObjectIDGenerator returns a generator of stable object IDs: the ID is the hash of the seed
+// (like the path or name of the project), the isa and the context of the new object,
+// so generating the same objects again results in the same IDs.
+func NewDeterministicObjectIDGenerator(project *Project, seed string) *ObjectIDGenerator {
+	return &ObjectIDGenerator{
+		project:   project,
+		seed:      seed,
+		generated: map[string]bool{},
+	}
+}
+
+// IsUsed reports whether the ID is the ID of an object of the project or was already generated.
+func (g *ObjectIDGenerator) IsUsed(id string) bool {
+	if g.generated[id] {
+		return true
+	}
+	if g.project == nil || g.project.objects == nil {
+		return false
+	}
+	_, found := g.project.objects.Get(id)
+	return found
+}
+
+// Generate returns a new, unused object ID for an object of the isa.
+// The context identifies the object in deterministic mode, like `SampleApp/Debug` for a build configuration of a target.
+// On a collision the next candidate of the same (deterministic) sequence is used.
+// An error is returned only if no random data is available.
+func (g *ObjectIDGenerator) Generate(isa, context string) (string, error) {
+	for i := 0; ; i++ {
+		id, err := g.candidate(isa, context, i)
+		if err != nil {
+			return "", err
+		}
+		if !g.IsUsed(id) {
+			g.generated[id] = true
+			return id, nil
+		}
+	}
+}
+
+func (g *ObjectIDGenerator) candidate(isa, context string, attempt int) (string, error) {
+	if g.random {
+		bytes := make([]byte, 12)
+		if _, err := randomRead(bytes); err != nil {
+			return "", fmt.Errorf("failed to generate random object ID: %s", err)
+		}
+		return strings.ToUpper(hex.EncodeToString(bytes)), nil
+	}
+
+	key := g.seed + "\x00" + isa + "\x00" + context
+	if attempt > 0 {
+		key += fmt.Sprintf("\x00%d", attempt)
+	}
+	hash := md5.Sum([]byte(key))
+	return strings.ToUpper(hex.EncodeToString(hash[:12])), nil
+}
@@ -0,0 +1,112 @@
+package xcodeproj
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsObjectID(t *testing.T) {
+	require.Equal(t, true, IsObjectID("8D3E2A042176C1D300A4F1B2"))
+	require.Equal(t, false, IsObjectID("8d3e2a042176c1d300a4f1b2"))
+	require.Equal(t, false, IsObjectID("8D3E2A042176C1D300A4F1B"))
+	require.Equal(t, false, IsObjectID("OBJ_12"))
+}
+
+func TestObjectIDGenerator(t *testing.T) {
+	generate := func(generator *ObjectIDGenerator, isa, context string) string {
+		id, err := generator.Generate(isa, context)
+		require.NoError(t, err)
+		return id
+	}
+
+	t.Log("random IDs")
+	{
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+
+		generator := NewObjectIDGenerator(project)
+		first := generate(generator, "PBXFileReference", "Kit.framework")
+		second := generate(generator, "PBXFileReference", "Kit.framework")
+		require.Equal(t, true, IsObjectID(first))
+		require.Equal(t, true, IsObjectID(second))
+		require.NotEqual(t, first, second)
+		require.Equal(t, true, generator.IsUsed(first))
+		require.Equal(t, true, generator.IsUsed("8D3E2A042176C1D300A4F1B2"))
+	}
+
+	t.Log("deterministic IDs")
+	{
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+
+		id := generate(NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj"), "PBXNativeTarget", "Kit")
+		require.Equal(t, true, IsObjectID(id))
+		require.Equal(t, id, generate(NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj"), "PBXNativeTarget", "Kit"))
+		require.NotEqual(t, id, generate(NewDeterministicObjectIDGenerator(project, "Other.xcodeproj"), "PBXNativeTarget", "Kit"))
+		require.NotEqual(t, id, generate(NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj"), "PBXNativeTarget", "Core"))
+		require.NotEqual(t, id, generate(NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj"), "PBXAggregateTarget", "Kit"))
+
+		generator := NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj")
+		require.Equal(t, id, generate(generator, "PBXNativeTarget", "Kit"))
+		next := generate(generator, "PBXNativeTarget", "Kit")
+		require.NotEqual(t, id, next)
+		candidate, err := NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj").candidate("PBXNativeTarget", "Kit", 1)
+		require.NoError(t, err)
+		require.Equal(t, next, candidate)
+	}
+
+	t.Log("deterministic IDs colliding with project objects")
+	{
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+		id := generate(NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj"), "PBXNativeTarget", "Kit")
+
+		content := strings.Replace(sampleAppPbxprojContent, "8D3E2A382176C1D300A4F1B2", id, -1)
+		project, err = ParseProject(content)
+		require.NoError(t, err)
+
+		generator := NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj")
+		require.Equal(t, true, generator.IsUsed(id))
+		require.NotEqual(t, id, generate(generator, "PBXNativeTarget", "Kit"))
+	}
+
+	t.Log("no random data")
+	{
+		defer func(read func([]byte) (int, error)) {
+			randomRead = read
+		}(randomRead)
+		randomRead = func([]byte) (int, error) {
+			return 0, errors.New("entropy source unavailable")
+		}
+
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+		_, err = NewObjectIDGenerator(project).Generate("PBXNativeTarget", "Kit")
+		require.EqualError(t, err, "failed to generate random object ID: entropy source unavailable")
+
+		_, err = project.AddNativeTarget("Kit", ProductTypeFramework, nil)
+		require.EqualError(t, err, "failed to add target: failed to generate random object ID: entropy source unavailable")
+	}
+}
+
+func TestDeterministicProjectEdits(t *testing.T) {
+	encodeWithNewTarget := func() string {
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+		project.IDGenerator = NewDeterministicObjectIDGenerator(project, "SampleApp.xcodeproj")
+
+		target, err := project.AddNativeTarget("Kit", ProductTypeFramework, nil)
+		require.NoError(t, err)
+		sampleApp, _ := project.TargetByName("SampleApp")
+		require.NoError(t, project.AddTargetDependency(sampleApp, target))
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		return content
+	}
+
+	require.Equal(t, encodeWithNewTarget(), encodeWithNewTarget())
+}
@@ -0,0 +1,283 @@
+package xcodeproj
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// PackageResolvedPin is a resolved Swift package of a Package.resolved file.
+type PackageResolvedPin struct {
+	// Identity is the package identity, like `alamofire`, derived from the location for version 1 files.
+	Identity string
+	// Kind is remoteSourceControl, localSourceControl or registry, empty for version 1 files.
+	Kind     string
+	Location string
+	Version  string
+	Branch   string
+	Revision string
+}
+
+// PackageResolved is a Package.resolved file, which pins the resolved versions of a workspace's (or project's) Swift packages.
+type PackageResolved struct {
+	Path string
+	// SchemaVersion is the version of the file format: 1, 2 or 3.
+	SchemaVersion int
+	// OriginHash is the hash of the package requirements, the pins were resolved for (version 3 only).
+	OriginHash string
+	Pins       []PackageResolvedPin
+}
+
+type packageResolvedState struct {
+	Version  string `json:"version"`
+	Branch   string `json:"branch"`
+	Revision string `json:"revision"`
+}
+
+type packageResolvedV1 struct {
+	Object struct {
+		Pins []struct {
+			Package       string               `json:"package"`
+			RepositoryURL string               `json:"repositoryURL"`
+			State         packageResolvedState `json:"state"`
+		} `json:"pins"`
+	} `json:"object"`
+}
+
+type packageResolvedV2 struct {
+	OriginHash string `json:"originHash"`
+	Pins       []struct {
+		Identity string               `json:"identity"`
+		Kind     string               `json:"kind"`
+		Location string               `json:"location"`
+		State    packageResolvedState `json:"state"`
+	} `json:"pins"`
+}
+
+// ProjectPackageResolvedPath returns the path of the project's Package.resolved file.
+func ProjectPackageResolvedPath(projectPth string) string {
+	return filepath.Join(projectPth, "project.xcworkspace", "xcshareddata", "swiftpm", "Package.resolved")
+}
+
+// WorkspacePackageResolvedPath returns the path of the workspace's Package.resolved file.
+func WorkspacePackageResolvedPath(workspacePth string) string {
+	return filepath.Join(workspacePth, "xcshareddata", "swiftpm", "Package.resolved")
+}
+
+// OpenPackageResolved parses the Package.resolved file at the given path.
+func OpenPackageResolved(pth string) (*PackageResolved, error) {
+	if exist, err := pathutil.IsPathExists(pth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, fmt.Errorf("Package.resolved does not exist at: %s", pth)
+	}
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePackageResolved(content, pth)
+}
+
+// ParsePackageResolved parses Package.resolved content of the version 1, 2 or 3 format.
+func ParsePackageResolved(content, pth string) (*PackageResolved, error) {
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal([]byte(content), &header); err != nil {
+		return nil, fmt.Errorf("failed to parse Package.resolved (%s): %s", pth, err)
+	}
+
+	resolved := &PackageResolved{
+		Path:          pth,
+		SchemaVersion: header.Version,
+		Pins:          []PackageResolvedPin{},
+	}
+
+	switch header.Version {
+	case 1:
+		var v1 packageResolvedV1
+		if err := json.Unmarshal([]byte(content), &v1); err != nil {
+			return nil, fmt.Errorf("failed to parse Package.resolved (%s): %s", pth, err)
+		}
+		for _, pin := range v1.Object.Pins {
+			resolved.Pins = append(resolved.Pins, PackageResolvedPin{
+				Identity: SwiftPackageIdentity(pin.RepositoryURL),
+				Location: pin.RepositoryURL,
+				Version:  pin.State.Version,
+				Branch:   pin.State.Branch,
+				Revision: pin.State.Revision,
+			})
+		}
+	case 2, 3:
+		var v2 packageResolvedV2
+		if err := json.Unmarshal([]byte(content), &v2); err != nil {
+			return nil, fmt.Errorf("failed to parse Package.resolved (%s): %s", pth, err)
+		}
+		resolved.OriginHash = v2.OriginHash
+		for _, pin := range v2.Pins {
+			resolved.Pins = append(resolved.Pins, PackageResolvedPin{
+				Identity: pin.Identity,
+				Kind:     pin.Kind,
+				Location: pin.Location,
+				Version:  pin.State.Version,
+				Branch:   pin.State.Branch,
+				Revision: pin.State.Revision,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("failed to parse Package.resolved (%s): unsupported version: %d", pth, header.Version)
+	}
+
+	return resolved, nil
+}
+
+// Pin returns the pin of the package with the given identity.
+func (r *PackageResolved) Pin(identity string) (PackageResolvedPin, bool) {
+	identity = strings.ToLower(identity)
+	for _, pin := range r.Pins {
+		if strings.ToLower(pin.Identity) == identity {
+			return pin, true
+		}
+	}
+	return PackageResolvedPin{}, false
+}
+
+// SwiftPackageIdentity returns the identity of the package at the given URL or path, as Swift Package Manager computes it:
+// the lowercased last path component, without the `.git` extension.
+func SwiftPackageIdentity(location string) string {
+	return strings.ToLower(strings.TrimSuffix(path.Base(strings.TrimSuffix(location, "/")), ".git"))
+}
+
+// IsSatisfiedBy reports whether the pinned version (or branch or revision) satisfies the requirement.
+func (r SwiftPackageRequirement) IsSatisfiedBy(pin PackageResolvedPin) bool {
+	switch r.Kind {
+	case SwiftPackageRequirementUpToNextMajorVersion, SwiftPackageRequirementUpToNextMinorVersion, SwiftPackageRequirementVersionRange:
+		if pin.Version == "" || compareVersions(pin.Version, r.MinimumVersion) < 0 {
+			return false
+		}
+
+		maximumVersion := r.MaximumVersion
+		if r.Kind != SwiftPackageRequirementVersionRange {
+			maximumVersion = nextVersion(r.MinimumVersion, r.Kind == SwiftPackageRequirementUpToNextMajorVersion)
+		}
+		return compareVersions(pin.Version, maximumVersion) < 0
+	case SwiftPackageRequirementExactVersion:
+		return pin.Version != "" && compareVersions(pin.Version, r.Version) == 0
+	case SwiftPackageRequirementBranch:
+		return pin.Branch == r.Branch
+	case SwiftPackageRequirementRevision:
+		return pin.Revision == r.Revision
+	}
+	return false
+}
+
+// nextVersion returns the next major (like 6.0.0 for 5.2.1) or minor (like 5.3.0) version.
+func nextVersion(version string, major bool) string {
+	components := append(versionComponents(version), 0)
+	if major {
+		return fmt.Sprintf("%d.0.0", components[0]+1)
+	}
+	return fmt.Sprintf("%d.%d.0", components[0], components[1]+1)
+}
+
+// PackageResolvedIssueKind is the kind of a problem found in the pins of a Package.resolved file.
+type PackageResolvedIssueKind string
+
+// Package.resolved issue kinds
+const (
+	PackageResolvedIssueMissingPin           PackageResolvedIssueKind = "missing_pin"
+	PackageResolvedIssueRequirementViolation PackageResolvedIssueKind = "requirement_violation"
+)
+
+// PackageResolvedIssue is a remote Swift package of a project, which Package.resolved does not pin according to its requirement.
+type PackageResolvedIssue struct {
+	Kind    PackageResolvedIssueKind
+	Project string
+	Package *XCRemoteSwiftPackageReference
+	// Pin is the pin violating the requirement, nil for PackageResolvedIssueMissingPin.
+	Pin *PackageResolvedPin
+}
+
+func (i PackageResolvedIssue) String() string {
+	name := i.Package.PackageName()
+	if i.Pin == nil {
+		return fmt.Sprintf("%s is not pinned in Package.resolved", name)
+	}
+
+	pinned := i.Pin.Version
+	if pinned == "" && i.Pin.Branch != "" {
+		pinned = "branch " + i.Pin.Branch
+	}
+	if pinned == "" {
+		pinned = "revision " + i.Pin.Revision
+	}
+	return fmt.Sprintf("%s is pinned to %s, which does not satisfy the requirement (%s)", name, pinned, i.Package.Requirement)
+}
+
+// ProjectPackageResolvedIssues checks the project's Package.resolved against the remote Swift packages
+// of the project and its sub-projects.
+func ProjectPackageResolvedIssues(projectPth string) ([]PackageResolvedIssue, error) {
+	containers, err := ResolveProjectContainers(projectPth)
+	if err != nil {
+		return nil, err
+	}
+	return packageResolvedIssues(ProjectPackageResolvedPath(projectPth), containers.Projects)
+}
+
+// WorkspacePackageResolvedIssues checks the workspace's Package.resolved against the remote Swift packages
+// of every project of the workspace's container closure.
+func WorkspacePackageResolvedIssues(workspacePth string) ([]PackageResolvedIssue, error) {
+	containers, err := ResolveWorkspaceContainers(workspacePth)
+	if err != nil {
+		return nil, err
+	}
+	return packageResolvedIssues(WorkspacePackageResolvedPath(workspacePth), containers.Projects)
+}
+
+func packageResolvedIssues(packageResolvedPth string, projectPths []string) ([]PackageResolvedIssue, error) {
+	resolved, err := OpenPackageResolved(packageResolvedPth)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := []PackageResolvedIssue{}
+	for _, projectPth := range projectPths {
+		project, err := OpenProject(projectPth)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, project.PackageResolvedIssues(resolved)...)
+	}
+	return issues, nil
+}
+
+// PackageResolvedIssues checks the pins of the Package.resolved against the remote Swift packages of the project:
+// it reports packages without a pin and pins, which do not satisfy their package's requirement.
+func (p *Project) PackageResolvedIssues(resolved *PackageResolved) []PackageResolvedIssue {
+	issues := []PackageResolvedIssue{}
+	if p.RootObject == nil {
+		return issues
+	}
+
+	for _, reference := range p.RootObject.PackageReferences {
+		remote, ok := reference.(*XCRemoteSwiftPackageReference)
+		if !ok {
+			continue
+		}
+
+		pin, found := resolved.Pin(SwiftPackageIdentity(remote.RepositoryURL))
+		if !found {
+			issues = append(issues, PackageResolvedIssue{Kind: PackageResolvedIssueMissingPin, Project: p.Path, Package: remote})
+		} else if !remote.Requirement.IsSatisfiedBy(pin) {
+			issues = append(issues, PackageResolvedIssue{Kind: PackageResolvedIssueRequirementViolation, Project: p.Path, Package: remote, Pin: &pin})
+		}
+	}
+	return issues
+}
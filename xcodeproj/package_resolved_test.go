@@ -0,0 +1,159 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePackageResolved(t *testing.T) {
+	t.Log("version 1")
+	{
+		resolved, err := ParsePackageResolved(packageResolvedV1Content, "Package.resolved")
+		require.NoError(t, err)
+		require.Equal(t, 1, resolved.SchemaVersion)
+		require.Equal(t, []PackageResolvedPin{
+			{
+				Identity: "alamofire",
+				Location: "https://github.com/Alamofire/Alamofire.git",
+				Version:  "5.6.1",
+				Revision: "354dda32d89fc8cd4f5c46487f64957d355f53d8",
+			},
+			{
+				Identity: "swift-collections",
+				Location: "https://github.com/apple/swift-collections",
+				Branch:   "main",
+				Revision: "94cf62b3ba8d4bed62680a282d4c25f9c63c2efb",
+			},
+		}, resolved.Pins)
+	}
+
+	t.Log("version 2")
+	{
+		resolved, err := ParsePackageResolved(packageResolvedV2Content, "Package.resolved")
+		require.NoError(t, err)
+		require.Equal(t, 2, resolved.SchemaVersion)
+		require.Equal(t, "", resolved.OriginHash)
+		require.Equal(t, 2, len(resolved.Pins))
+		require.Equal(t, "remoteSourceControl", resolved.Pins[0].Kind)
+
+		pin, found := resolved.Pin("Swift-Collections")
+		require.Equal(t, true, found)
+		require.Equal(t, "main", pin.Branch)
+
+		_, found = resolved.Pin("nimble")
+		require.Equal(t, false, found)
+	}
+
+	t.Log("version 3")
+	{
+		resolved, err := ParsePackageResolved(packageResolvedV3Content, "Package.resolved")
+		require.NoError(t, err)
+		require.Equal(t, 3, resolved.SchemaVersion)
+		require.Equal(t, "a8f3b0d2c6e9fb58bd5b1c1b8e0bdfa7e2d0bd8f2c8a6f4f4e1e4d1c8a5f7e3b", resolved.OriginHash)
+		require.Equal(t, 1, len(resolved.Pins))
+		require.Equal(t, "6.0.0", resolved.Pins[0].Version)
+	}
+
+	t.Log("invalid")
+	{
+		_, err := ParsePackageResolved(`{"pins": [], "version": 4}`, "Package.resolved")
+		require.EqualError(t, err, "failed to parse Package.resolved (Package.resolved): unsupported version: 4")
+
+		_, err = ParsePackageResolved(`{"pins": [`, "Package.resolved")
+		require.Error(t, err)
+	}
+}
+
+func TestSwiftPackageIdentity(t *testing.T) {
+	require.Equal(t, "alamofire", SwiftPackageIdentity("https://github.com/Alamofire/Alamofire.git"))
+	require.Equal(t, "swift-collections", SwiftPackageIdentity("https://github.com/apple/swift-collections/"))
+	require.Equal(t, "nimble", SwiftPackageIdentity("git@github.com:Quick/Nimble.git"))
+	require.Equal(t, "networking", SwiftPackageIdentity("../Packages/Networking"))
+}
+
+func TestSwiftPackageRequirementIsSatisfiedBy(t *testing.T) {
+	for _, test := range []struct {
+		requirement SwiftPackageRequirement
+		pin         PackageResolvedPin
+		satisfied   bool
+	}{
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: "5.0.0"}, PackageResolvedPin{Version: "5.6.1"}, true},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: "5.0.0"}, PackageResolvedPin{Version: "6.0.0"}, false},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: "5.7.0"}, PackageResolvedPin{Version: "5.6.1"}, false},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: "5.0.0"}, PackageResolvedPin{Branch: "main"}, false},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMinorVersion, MinimumVersion: "5.6.0"}, PackageResolvedPin{Version: "5.6.1"}, true},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMinorVersion, MinimumVersion: "5.6.0"}, PackageResolvedPin{Version: "5.7.0"}, false},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementVersionRange, MinimumVersion: "5.6.0", MaximumVersion: "5.10.0"}, PackageResolvedPin{Version: "5.9.2"}, true},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementVersionRange, MinimumVersion: "5.6.0", MaximumVersion: "5.10.0"}, PackageResolvedPin{Version: "5.10.0"}, false},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementExactVersion, Version: "5.6.1"}, PackageResolvedPin{Version: "5.6.1"}, true},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementExactVersion, Version: "5.6.1"}, PackageResolvedPin{Version: "5.6.2"}, false},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementBranch, Branch: "main"}, PackageResolvedPin{Branch: "main", Revision: "94cf62b"}, true},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementBranch, Branch: "main"}, PackageResolvedPin{Branch: "develop"}, false},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementRevision, Revision: "94cf62b"}, PackageResolvedPin{Revision: "94cf62b"}, true},
+		{SwiftPackageRequirement{Kind: SwiftPackageRequirementRevision, Revision: "94cf62b"}, PackageResolvedPin{Version: "1.0.0", Revision: "354dda3"}, false},
+	} {
+		require.Equal(t, test.satisfied, test.requirement.IsSatisfiedBy(test.pin), "%s, pin: %v", test.requirement, test.pin)
+	}
+}
+
+func TestPackageResolvedIssues(t *testing.T) {
+	t.Log("project")
+	{
+		project, err := ParseProject(kitPackageReferencesPbxprojContent)
+		require.NoError(t, err)
+
+		resolved, err := ParsePackageResolved(packageResolvedV2Content, "Package.resolved")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(project.PackageResolvedIssues(resolved)))
+
+		resolved, err = ParsePackageResolved(packageResolvedV3Content, "Package.resolved")
+		require.NoError(t, err)
+		issues := project.PackageResolvedIssues(resolved)
+		require.Equal(t, 2, len(issues))
+
+		require.Equal(t, PackageResolvedIssueRequirementViolation, issues[0].Kind)
+		require.Equal(t, "C4F1B3352245E0A700D2C8F1", issues[0].Package.ID)
+		require.Equal(t, "6.0.0", issues[0].Pin.Version)
+		require.Equal(t, "Alamofire is pinned to 6.0.0, which does not satisfy the requirement (Up to Next Major Version: 5.0.0)", issues[0].String())
+
+		require.Equal(t, PackageResolvedIssueMissingPin, issues[1].Kind)
+		require.Equal(t, "C4F1B33C2245E0A700D2C8F1", issues[1].Package.ID)
+		require.Equal(t, "swift-collections is not pinned in Package.resolved", issues[1].String())
+	}
+
+	t.Log("project path")
+	{
+		tmpDir, err := ioutil.TempDir("", "xcodeproj")
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, os.RemoveAll(tmpDir))
+		}()
+
+		projectPth := filepath.Join(tmpDir, "Kit", "Kit.xcodeproj")
+		writeTestProject(t, projectPth, kitPackageReferencesPbxprojContent)
+		writeTestProject(t, filepath.Join(tmpDir, "Sub", "Sub.xcodeproj"), subPbxprojContent)
+
+		_, err = ProjectPackageResolvedIssues(projectPth)
+		require.EqualError(t, err, "Package.resolved does not exist at: "+ProjectPackageResolvedPath(projectPth))
+
+		resolvedPth := ProjectPackageResolvedPath(projectPth)
+		require.NoError(t, os.MkdirAll(filepath.Dir(resolvedPth), 0755))
+		require.NoError(t, ioutil.WriteFile(resolvedPth, []byte(packageResolvedV1Content), 0644))
+
+		issues, err := ProjectPackageResolvedIssues(projectPth)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(issues))
+
+		require.NoError(t, ioutil.WriteFile(resolvedPth, []byte(packageResolvedV3Content), 0644))
+		issues, err = ProjectPackageResolvedIssues(projectPth)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(issues))
+		require.Equal(t, projectPth, issues[0].Project)
+		require.Equal(t, PackageResolvedIssueRequirementViolation, issues[0].Kind)
+		require.Equal(t, PackageResolvedIssueMissingPin, issues[1].Kind)
+	}
+}
@@ -0,0 +1,649 @@
+package xcodeproj
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PlistString is a string value of an OpenStep property list.
+// Comment holds the inline annotation (like `/* Sources */` in project.pbxproj), which followed the value, if any.
+type PlistString struct {
+	Value   string
+	Comment string
+}
+
+// PlistData is a `<0fbd77>` style data value of an OpenStep property list.
+type PlistData []byte
+
+// PlistArray is an array value of an OpenStep property list.
+// Its elements are PlistString, PlistData, PlistArray or *PlistDict values.
+type PlistArray []interface{}
+
+// PlistDictEntry is a key - value pair of a PlistDict.
+type PlistDictEntry struct {
+	Key   PlistString
+	Value interface{}
+}
+
+// PlistDict is a dictionary value of an OpenStep property list, which keeps the order of its entries.
+// Values are PlistString, PlistData, PlistArray or *PlistDict values.
+type PlistDict struct {
+	entries []PlistDictEntry
+	index   map[string]int
+}
+
+// NewPlistDict ...
+func NewPlistDict() *PlistDict {
+	return &PlistDict{
+		entries: []PlistDictEntry{},
+		index:   map[string]int{},
+	}
+}
+
+// Len ...
+func (dict *PlistDict) Len() int {
+	return len(dict.entries)
+}
+
+// Keys returns the dictionary keys in order.
+func (dict *PlistDict) Keys() []string {
+	keys := []string{}
+	for _, entry := range dict.entries {
+		keys = append(keys, entry.Key.Value)
+	}
+	return keys
+}
+
+// Entries returns the dictionary entries in order.
+func (dict *PlistDict) Entries() []PlistDictEntry {
+	return dict.entries
+}
+
+// Entry ...
+func (dict *PlistDict) Entry(key string) (PlistDictEntry, bool) {
+	idx, found := dict.index[key]
+	if !found {
+		return PlistDictEntry{}, false
+	}
+	return dict.entries[idx], true
+}
+
+// Get ...
+func (dict *PlistDict) Get(key string) (interface{}, bool) {
+	entry, found := dict.Entry(key)
+	if !found {
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// GetString returns the value for the key, if it is a string.
+func (dict *PlistDict) GetString(key string) (string, bool) {
+	value, found := dict.Get(key)
+	if !found {
+		return "", false
+	}
+	str, ok := value.(PlistString)
+	if !ok {
+		return "", false
+	}
+	return str.Value, true
+}
+
+// GetDict returns the value for the key, if it is a dictionary.
+func (dict *PlistDict) GetDict(key string) (*PlistDict, bool) {
+	value, found := dict.Get(key)
+	if !found {
+		return nil, false
+	}
+	d, ok := value.(*PlistDict)
+	return d, ok
+}
+
+// GetArray returns the value for the key, if it is an array.
+func (dict *PlistDict) GetArray(key string) (PlistArray, bool) {
+	value, found := dict.Get(key)
+	if !found {
+		return nil, false
+	}
+	array, ok := value.(PlistArray)
+	return array, ok
+}
+
+// GetStrings returns the string elements of the array value for the key.
+func (dict *PlistDict) GetStrings(key string) []string {
+	strs := []string{}
+	array, _ := dict.GetArray(key)
+	for _, value := range array {
+		if str, ok := value.(PlistString); ok {
+			strs = append(strs, str.Value)
+		}
+	}
+	return strs
+}
+
+// Set replaces the value of an existing key or appends a new entry.
+func (dict *PlistDict) Set(key string, value interface{}) {
+	if idx, found := dict.index[key]; found {
+		dict.entries[idx].Value = value
+		return
+	}
+	dict.SetEntry(PlistDictEntry{Key: PlistString{Value: key}, Value: value})
+}
+
+// SetEntry replaces an existing entry (including its key comment) or appends a new one.
+func (dict *PlistDict) SetEntry(entry PlistDictEntry) {
+	if idx, found := dict.index[entry.Key.Value]; found {
+		dict.entries[idx] = entry
+		return
+	}
+	dict.index[entry.Key.Value] = len(dict.entries)
+	dict.entries = append(dict.entries, entry)
+}
+
+// Insert inserts a new entry at the given position (clamped to the dictionary bounds),
+// an existing entry of the key is replaced in place.
+func (dict *PlistDict) Insert(idx int, entry PlistDictEntry) {
+	if _, found := dict.index[entry.Key.Value]; found {
+		dict.SetEntry(entry)
+		return
+	}
+
+	if idx < 0 {
+		idx = 0
+	} else if idx > len(dict.entries) {
+		idx = len(dict.entries)
+	}
+
+	dict.entries = append(dict.entries, PlistDictEntry{})
+	copy(dict.entries[idx+1:], dict.entries[idx:])
+	dict.entries[idx] = entry
+	for i := idx; i < len(dict.entries); i++ {
+		dict.index[dict.entries[i].Key.Value] = i
+	}
+}
+
+// Delete removes the entry for the key and reports whether it was present.
+func (dict *PlistDict) Delete(key string) bool {
+	idx, found := dict.index[key]
+	if !found {
+		return false
+	}
+
+	dict.entries = append(dict.entries[:idx], dict.entries[idx+1:]...)
+	delete(dict.index, key)
+	for i := idx; i < len(dict.entries); i++ {
+		dict.index[dict.entries[i].Key.Value] = i
+	}
+	return true
+}
+
+// ------------------------------
+// Parsing
+
+// PlistSyntaxError describes a malformed OpenStep property list and the exact position of the problem.
+type PlistSyntaxError struct {
+	Offset int
+	Line   int
+	Column int
+	Msg    string
+}
+
+// Error ...
+func (err *PlistSyntaxError) Error() string {
+	return fmt.Sprintf("plist syntax error at line %d, column %d: %s", err.Line, err.Column, err.Msg)
+}
+
+// ParsePlist parses an ASCII (OpenStep) property list, like project.pbxproj.
+// The returned value is a PlistString, PlistData, PlistArray or *PlistDict.
+// A top level list of `key = value;` pairs without enclosing braces (the .strings file format) is parsed as a dictionary.
+func ParsePlist(content string) (interface{}, error) {
+	p := plistParser{data: content}
+	return p.parse()
+}
+
+// ParsePlistDict parses an OpenStep property list, which has a dictionary root.
+func ParsePlistDict(content string) (*PlistDict, error) {
+	value, err := ParsePlist(content)
+	if err != nil {
+		return nil, err
+	}
+	return plistRootDict(value)
+}
+
+// parseTruncatedPlistDict parses a dictionary rooted property list, which may end before the closing braces of its open dictionaries,
+// like a chunk cut out of a project.pbxproj file.
+func parseTruncatedPlistDict(content string) (*PlistDict, error) {
+	p := plistParser{data: content, truncated: true}
+	value, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	return plistRootDict(value)
+}
+
+func plistRootDict(value interface{}) (*PlistDict, error) {
+	dict, ok := value.(*PlistDict)
+	if !ok {
+		return nil, fmt.Errorf("property list root is not a dictionary")
+	}
+	return dict, nil
+}
+
+type plistParser struct {
+	data string
+	pos  int
+	// truncated accepts the end of file in place of the closing braces of the open dictionaries
+	truncated bool
+}
+
+func (p *plistParser) parse() (interface{}, error) {
+	if err := p.skipWhitespaceAndComments(); err != nil {
+		return nil, err
+	}
+	if p.eof() {
+		return nil, p.errorf(p.pos, "empty property list")
+	}
+
+	if p.isStringsFile() {
+		return p.parseDictBody(0)
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.skipWhitespaceAndComments(); err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		return nil, p.errorf(p.pos, "unexpected %q after the root value", p.data[p.pos])
+	}
+
+	return value, nil
+}
+
+func (p *plistParser) eof() bool {
+	return p.pos >= len(p.data)
+}
+
+func (p *plistParser) errorf(offset int, format string, v ...interface{}) error {
+	line := 1 + strings.Count(p.data[:offset], "\n")
+	column := offset + 1
+	if idx := strings.LastIndex(p.data[:offset], "\n"); idx != -1 {
+		column = offset - idx
+	}
+
+	return &PlistSyntaxError{
+		Offset: offset,
+		Line:   line,
+		Column: column,
+		Msg:    fmt.Sprintf(format, v...),
+	}
+}
+
+func isPlistWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+func isPlistUnquotedStringChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c == '_' || c == '$' || c == '/' || c == ':' || c == '.' || c == '-'
+}
+
+func isPlistHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func (p *plistParser) skipWhitespaceAndComments() error {
+	for !p.eof() {
+		c := p.data[p.pos]
+
+		if isPlistWhitespace(c) {
+			p.pos++
+			continue
+		}
+
+		if strings.HasPrefix(p.data[p.pos:], "//") {
+			end := strings.IndexByte(p.data[p.pos:], '\n')
+			if end == -1 {
+				p.pos = len(p.data)
+			} else {
+				p.pos += end + 1
+			}
+			continue
+		}
+
+		if strings.HasPrefix(p.data[p.pos:], "/*") {
+			if _, err := p.readBlockComment(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		return nil
+	}
+	return nil
+}
+
+func (p *plistParser) readBlockComment() (string, error) {
+	start := p.pos
+	end := strings.Index(p.data[p.pos+2:], "*/")
+	if end == -1 {
+		return "", p.errorf(start, "unterminated comment")
+	}
+	p.pos += 2 + end + 2
+	return strings.TrimSpace(p.data[start+2 : p.pos-2]), nil
+}
+
+// readAnnotation reads the block comment following a string value on the same line, like: `BAAFFECD19EE788800F3AC91 /* Sources */`.
+func (p *plistParser) readAnnotation() (string, error) {
+	pos := p.pos
+	for pos < len(p.data) && (p.data[pos] == ' ' || p.data[pos] == '\t') {
+		pos++
+	}
+	if !strings.HasPrefix(p.data[pos:], "/*") {
+		return "", nil
+	}
+	p.pos = pos
+	return p.readBlockComment()
+}
+
+func (p *plistParser) expect(c byte) error {
+	if err := p.skipWhitespaceAndComments(); err != nil {
+		return err
+	}
+	if p.eof() {
+		return p.errorf(p.pos, "expected %q, found end of file", c)
+	}
+	if p.data[p.pos] != c {
+		return p.errorf(p.pos, "expected %q, found %q", c, p.data[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+func (p *plistParser) isStringsFile() bool {
+	c := p.data[p.pos]
+	if c != '"' && c != '\'' && !isPlistUnquotedStringChar(c) {
+		return false
+	}
+
+	probe := plistParser{data: p.data, pos: p.pos}
+	if _, err := probe.parseString(); err != nil {
+		return false
+	}
+	if err := probe.skipWhitespaceAndComments(); err != nil {
+		return false
+	}
+	return !probe.eof() && probe.data[probe.pos] == '='
+}
+
+func (p *plistParser) parseValue() (interface{}, error) {
+	if err := p.skipWhitespaceAndComments(); err != nil {
+		return nil, err
+	}
+	if p.eof() {
+		return nil, p.errorf(p.pos, "unexpected end of file, expected a value")
+	}
+
+	switch c := p.data[p.pos]; {
+	case c == '{':
+		p.pos++
+		return p.parseDictBody('}')
+	case c == '(':
+		p.pos++
+		return p.parseArrayBody()
+	case c == '<':
+		return p.parseData()
+	case c == '"' || c == '\'' || isPlistUnquotedStringChar(c):
+		return p.parseString()
+	default:
+		return nil, p.errorf(p.pos, "unexpected %q, expected a value", c)
+	}
+}
+
+// parseDictBody parses `key = value;` entries until the terminator, or until the end of file if the terminator is 0.
+func (p *plistParser) parseDictBody(terminator byte) (*PlistDict, error) {
+	dict := NewPlistDict()
+
+	for {
+		if err := p.skipWhitespaceAndComments(); err != nil {
+			return nil, err
+		}
+
+		if p.eof() {
+			if terminator == 0 || p.truncated {
+				return dict, nil
+			}
+			return nil, p.errorf(p.pos, "unexpected end of file, expected %q", terminator)
+		}
+
+		if terminator != 0 && p.data[p.pos] == terminator {
+			p.pos++
+			return dict, nil
+		}
+
+		c := p.data[p.pos]
+		if c != '"' && c != '\'' && !isPlistUnquotedStringChar(c) {
+			return nil, p.errorf(p.pos, "unexpected %q, expected a dictionary key", c)
+		}
+
+		key, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.truncated {
+			if err := p.skipWhitespaceAndComments(); err != nil {
+				return nil, err
+			}
+			if p.eof() {
+				dict.SetEntry(PlistDictEntry{Key: key, Value: value})
+				return dict, nil
+			}
+		}
+
+		if err := p.expect(';'); err != nil {
+			return nil, err
+		}
+
+		// duplicated keys (like the leftovers of a hand-resolved merge conflict) are accepted, the last value wins
+		dict.SetEntry(PlistDictEntry{Key: key, Value: value})
+	}
+}
+
+func (p *plistParser) parseArrayBody() (PlistArray, error) {
+	array := PlistArray{}
+
+	for {
+		if err := p.skipWhitespaceAndComments(); err != nil {
+			return nil, err
+		}
+		if p.eof() {
+			return nil, p.errorf(p.pos, "unexpected end of file, expected ')'")
+		}
+		if p.data[p.pos] == ')' {
+			p.pos++
+			return array, nil
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, value)
+
+		if err := p.skipWhitespaceAndComments(); err != nil {
+			return nil, err
+		}
+		if p.eof() {
+			return nil, p.errorf(p.pos, "unexpected end of file, expected ')'")
+		}
+
+		switch p.data[p.pos] {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return array, nil
+		default:
+			return nil, p.errorf(p.pos, "unexpected %q, expected ',' or ')'", p.data[p.pos])
+		}
+	}
+}
+
+func (p *plistParser) parseData() (PlistData, error) {
+	start := p.pos
+	p.pos++
+
+	var hexDigits bytes.Buffer
+	for {
+		if p.eof() {
+			return nil, p.errorf(start, "unterminated data")
+		}
+
+		c := p.data[p.pos]
+		if c == '>' {
+			p.pos++
+			break
+		}
+		if isPlistWhitespace(c) {
+			p.pos++
+			continue
+		}
+		if !isPlistHexDigit(c) {
+			return nil, p.errorf(p.pos, "unexpected %q in data", c)
+		}
+		hexDigits.WriteByte(c)
+		p.pos++
+	}
+
+	digits := hexDigits.String()
+	if len(digits)%2 != 0 {
+		return nil, p.errorf(start, "odd number of hex digits in data")
+	}
+
+	data := PlistData{}
+	for i := 0; i < len(digits); i += 2 {
+		b, err := strconv.ParseUint(digits[i:i+2], 16, 8)
+		if err != nil {
+			return nil, p.errorf(start, "invalid data: %s", err)
+		}
+		data = append(data, byte(b))
+	}
+	return data, nil
+}
+
+func (p *plistParser) parseString() (PlistString, error) {
+	var value string
+	var err error
+
+	c := p.data[p.pos]
+	if c == '"' || c == '\'' {
+		value, err = p.parseQuotedString(c)
+	} else {
+		start := p.pos
+		for !p.eof() && isPlistUnquotedStringChar(p.data[p.pos]) {
+			p.pos++
+		}
+		value = p.data[start:p.pos]
+	}
+	if err != nil {
+		return PlistString{}, err
+	}
+
+	comment, err := p.readAnnotation()
+	if err != nil {
+		return PlistString{}, err
+	}
+
+	return PlistString{Value: value, Comment: comment}, nil
+}
+
+func (p *plistParser) parseQuotedString(quote byte) (string, error) {
+	start := p.pos
+	p.pos++
+
+	var buffer bytes.Buffer
+	for {
+		if p.eof() {
+			return "", p.errorf(start, "unterminated string")
+		}
+
+		c := p.data[p.pos]
+		if c == quote {
+			p.pos++
+			return buffer.String(), nil
+		}
+
+		if c != '\\' {
+			buffer.WriteByte(c)
+			p.pos++
+			continue
+		}
+
+		// Escape sequence
+		escapePos := p.pos
+		p.pos++
+		if p.eof() {
+			return "", p.errorf(start, "unterminated string")
+		}
+
+		c = p.data[p.pos]
+		p.pos++
+
+		switch c {
+		case 'a':
+			buffer.WriteByte('\a')
+		case 'b':
+			buffer.WriteByte('\b')
+		case 'f':
+			buffer.WriteByte('\f')
+		case 'n':
+			buffer.WriteByte('\n')
+		case 'r':
+			buffer.WriteByte('\r')
+		case 't':
+			buffer.WriteByte('\t')
+		case 'v':
+			buffer.WriteByte('\v')
+		case 'U':
+			if p.pos+4 > len(p.data) {
+				return "", p.errorf(escapePos, "invalid unicode escape sequence")
+			}
+			r, err := strconv.ParseUint(p.data[p.pos:p.pos+4], 16, 32)
+			if err != nil {
+				return "", p.errorf(escapePos, "invalid unicode escape sequence")
+			}
+			buffer.WriteRune(rune(r))
+			p.pos += 4
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			end := p.pos - 1
+			for end < len(p.data) && end < p.pos+2 && p.data[end] >= '0' && p.data[end] <= '7' {
+				end++
+			}
+			r, err := strconv.ParseUint(p.data[p.pos-1:end], 8, 32)
+			if err != nil {
+				return "", p.errorf(escapePos, "invalid octal escape sequence")
+			}
+			buffer.WriteRune(rune(r))
+			p.pos = end
+		default:
+			// \\, \", \' and unknown escapes stand for the escaped character itself
+			buffer.WriteByte(c)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlist(t *testing.T) {
+	t.Log("dictionary")
+	{
+		value, err := ParsePlist(`{ a = 1; "b c" = "d e"; }`)
+		require.NoError(t, err)
+
+		dict, ok := value.(*PlistDict)
+		require.Equal(t, true, ok)
+		require.Equal(t, []string{"a", "b c"}, dict.Keys())
+
+		a, found := dict.GetString("a")
+		require.Equal(t, true, found)
+		require.Equal(t, "1", a)
+
+		bc, found := dict.GetString("b c")
+		require.Equal(t, true, found)
+		require.Equal(t, "d e", bc)
+	}
+
+	t.Log("array")
+	{
+		value, err := ParsePlist(`(a, "b", (c), {d = e;},)`)
+		require.NoError(t, err)
+
+		array, ok := value.(PlistArray)
+		require.Equal(t, true, ok)
+		require.Equal(t, 4, len(array))
+		require.Equal(t, PlistString{Value: "a"}, array[0])
+		require.Equal(t, PlistString{Value: "b"}, array[1])
+		require.Equal(t, PlistArray{PlistString{Value: "c"}}, array[2])
+
+		dict, ok := array[3].(*PlistDict)
+		require.Equal(t, true, ok)
+		d, found := dict.GetString("d")
+		require.Equal(t, true, found)
+		require.Equal(t, "e", d)
+	}
+
+	t.Log("data")
+	{
+		value, err := ParsePlist(`<0fbd 77>`)
+		require.NoError(t, err)
+		require.Equal(t, PlistData{0x0f, 0xbd, 0x77}, value)
+	}
+
+	t.Log("quoted string escapes")
+	{
+		value, err := ParsePlist(`"a\"b\\c\nd\te\U00e9\101"`)
+		require.NoError(t, err)
+		require.Equal(t, PlistString{Value: "a\"b\\c\nd\teéA"}, value)
+	}
+
+	t.Log("annotations and comments")
+	{
+		content := `// !$*UTF8*$!
+{
+	/* comment */
+	rootObject = BAAFFEC919EE788800F3AC91 /* Project object */;
+	buildPhases = (
+		BAAFFECD19EE788800F3AC91 /* Sources */,
+		BAAFFECE19EE788800F3AC91,
+	);
+	BAAFFED019EE788800F3AC91 /* SampleAppWithCocoapods */ = {isa = PBXNativeTarget; };
+}
+`
+		dict, err := ParsePlistDict(content)
+		require.NoError(t, err)
+
+		rootObject, found := dict.Get("rootObject")
+		require.Equal(t, true, found)
+		require.Equal(t, PlistString{Value: "BAAFFEC919EE788800F3AC91", Comment: "Project object"}, rootObject)
+
+		buildPhases, found := dict.GetArray("buildPhases")
+		require.Equal(t, true, found)
+		require.Equal(t, PlistArray{
+			PlistString{Value: "BAAFFECD19EE788800F3AC91", Comment: "Sources"},
+			PlistString{Value: "BAAFFECE19EE788800F3AC91"},
+		}, buildPhases)
+
+		entry, found := dict.Entry("BAAFFED019EE788800F3AC91")
+		require.Equal(t, true, found)
+		require.Equal(t, "SampleAppWithCocoapods", entry.Key.Comment)
+	}
+
+	t.Log("strings file format")
+	{
+		dict, err := ParsePlistDict(pbxNativeTargetSectionWithSpace)
+		require.NoError(t, err)
+		require.Equal(t, []string{"BADDFA051A703F87004C3526", "BADDF9E61A703F87004C3526", "BADDFA021A703F87004C3526"}, dict.Keys())
+	}
+
+	t.Log("single line objects")
+	{
+		dict, err := ParsePlistDict(`{BAAFFEDB19EE788800F3AC91 /* main.m in Sources */ = {isa = PBXBuildFile; fileRef = BAAFFEDA19EE788800F3AC91 /* main.m */; };}`)
+		require.NoError(t, err)
+
+		object, found := dict.GetDict("BAAFFEDB19EE788800F3AC91")
+		require.Equal(t, true, found)
+
+		fileRef, found := object.Get("fileRef")
+		require.Equal(t, true, found)
+		require.Equal(t, PlistString{Value: "BAAFFEDA19EE788800F3AC91", Comment: "main.m"}, fileRef)
+	}
+
+	t.Log("duplicated key")
+	{
+		dict, err := ParsePlistDict("{ a = b; c = d; a = e; }")
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "c"}, dict.Keys())
+
+		value, found := dict.GetString("a")
+		require.Equal(t, true, found)
+		require.Equal(t, "e", value)
+	}
+}
+
+func TestParsePlistErrors(t *testing.T) {
+	t.Log("missing semicolon")
+	{
+		_, err := ParsePlist("{\n\ta = b\n}")
+		require.Error(t, err)
+
+		syntaxErr, ok := err.(*PlistSyntaxError)
+		require.Equal(t, true, ok)
+		require.Equal(t, 3, syntaxErr.Line)
+		require.Equal(t, 1, syntaxErr.Column)
+		require.Equal(t, "plist syntax error at line 3, column 1: expected ';', found '}'", err.Error())
+	}
+
+	t.Log("unterminated string")
+	{
+		_, err := ParsePlist("{\n\ta = \"b;\n}")
+		require.EqualError(t, err, "plist syntax error at line 2, column 6: unterminated string")
+	}
+
+	t.Log("unterminated comment")
+	{
+		_, err := ParsePlist("{ /* a = b; }")
+		require.EqualError(t, err, "plist syntax error at line 1, column 3: unterminated comment")
+	}
+
+	t.Log("unclosed dictionary")
+	{
+		_, err := ParsePlist("{\n\ta = {\n\t\tb = c;\n\t};\n")
+		require.EqualError(t, err, "plist syntax error at line 5, column 1: unexpected end of file, expected '}'")
+	}
+
+	t.Log("invalid data")
+	{
+		_, err := ParsePlist("<0fb>")
+		require.EqualError(t, err, "plist syntax error at line 1, column 1: odd number of hex digits in data")
+	}
+
+	t.Log("trailing content")
+	{
+		_, err := ParsePlist("{ a = b; } c")
+		require.EqualError(t, err, "plist syntax error at line 1, column 12: unexpected 'c' after the root value")
+	}
+}
+
+func TestParseTruncatedPlistDict(t *testing.T) {
+	t.Log("missing closing braces")
+	{
+		content := `{
+	objects = {
+		A = { isa = PBXGroup; };
+`
+		_, err := ParsePlistDict(content)
+		require.EqualError(t, err, "plist syntax error at line 4, column 1: unexpected end of file, expected '}'")
+
+		dict, err := parseTruncatedPlistDict(content)
+		require.NoError(t, err)
+
+		objects, found := dict.GetDict("objects")
+		require.Equal(t, true, found)
+		require.Equal(t, []string{"A"}, objects.Keys())
+	}
+
+	t.Log("unclosed array")
+	{
+		_, err := parseTruncatedPlistDict("{ a = (b, c")
+		require.EqualError(t, err, "plist syntax error at line 1, column 12: unexpected end of file, expected ')'")
+	}
+}
+
+func TestPlistDict(t *testing.T) {
+	dict := NewPlistDict()
+	dict.Set("a", PlistString{Value: "1"})
+	dict.Set("b", PlistString{Value: "2"})
+	dict.Set("c", PlistString{Value: "3"})
+	dict.Set("a", PlistString{Value: "4"})
+	require.Equal(t, []string{"a", "b", "c"}, dict.Keys())
+
+	a, _ := dict.GetString("a")
+	require.Equal(t, "4", a)
+
+	require.Equal(t, true, dict.Delete("b"))
+	require.Equal(t, false, dict.Delete("b"))
+	require.Equal(t, []string{"a", "c"}, dict.Keys())
+
+	c, found := dict.GetString("c")
+	require.Equal(t, true, found)
+	require.Equal(t, "3", c)
+
+	dict.Insert(1, PlistDictEntry{Key: PlistString{Value: "b"}, Value: PlistString{Value: "5"}})
+	dict.Insert(10, PlistDictEntry{Key: PlistString{Value: "d"}, Value: PlistString{Value: "6"}})
+	dict.Insert(0, PlistDictEntry{Key: PlistString{Value: "c"}, Value: PlistString{Value: "7"}})
+	require.Equal(t, []string{"a", "b", "c", "d"}, dict.Keys())
+
+	c, _ = dict.GetString("c")
+	require.Equal(t, "7", c)
+	require.Equal(t, true, dict.Delete("a"))
+	d, _ := dict.GetString("d")
+	require.Equal(t, "6", d)
+}
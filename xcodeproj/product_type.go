@@ -0,0 +1,183 @@
+package xcodeproj
+
+import "strings"
+
+// ProductType is the productType of a PBXNativeTarget, like `com.apple.product-type.application`.
+type ProductType string
+
+const productTypePrefix = "com.apple.product-type."
+
+// Product types
+const (
+	ProductTypeApplication             ProductType = "com.apple.product-type.application"
+	ProductTypeAppClip                 ProductType = "com.apple.product-type.application.on-demand-install-capable"
+	ProductTypeMessagesApplication     ProductType = "com.apple.product-type.application.messages"
+	ProductTypeWatchApp                ProductType = "com.apple.product-type.application.watchapp"
+	ProductTypeWatch2App               ProductType = "com.apple.product-type.application.watchapp2"
+	ProductTypeWatch2AppContainer      ProductType = "com.apple.product-type.application.watchapp2-container"
+	ProductTypeAppExtension            ProductType = "com.apple.product-type.app-extension"
+	ProductTypeMessagesExtension       ProductType = "com.apple.product-type.app-extension.messages"
+	ProductTypeStickerPack             ProductType = "com.apple.product-type.app-extension.messages-sticker-pack"
+	ProductTypeIntentsServiceExtension ProductType = "com.apple.product-type.app-extension.intents-service"
+	ProductTypeWatchExtension          ProductType = "com.apple.product-type.watchkit-extension"
+	ProductTypeWatch2Extension         ProductType = "com.apple.product-type.watchkit2-extension"
+	ProductTypeTVExtension             ProductType = "com.apple.product-type.tv-app-extension"
+	ProductTypeExtensionKitExtension   ProductType = "com.apple.product-type.extensionkit-extension"
+	ProductTypeXcodeExtension          ProductType = "com.apple.product-type.xcode-extension"
+	ProductTypeFramework               ProductType = "com.apple.product-type.framework"
+	ProductTypeStaticFramework         ProductType = "com.apple.product-type.framework.static"
+	ProductTypeXCFramework             ProductType = "com.apple.product-type.xcframework"
+	ProductTypeStaticLibrary           ProductType = "com.apple.product-type.library.static"
+	ProductTypeDynamicLibrary          ProductType = "com.apple.product-type.library.dynamic"
+	ProductTypeBundle                  ProductType = "com.apple.product-type.bundle"
+	ProductTypeUnitTestBundle          ProductType = "com.apple.product-type.bundle.unit-test"
+	ProductTypeUITestBundle            ProductType = "com.apple.product-type.bundle.ui-testing"
+	ProductTypeOCUnitTestBundle        ProductType = "com.apple.product-type.bundle.ocunit-test"
+	ProductTypeCommandLineTool         ProductType = "com.apple.product-type.tool"
+	ProductTypeXPCService              ProductType = "com.apple.product-type.xpc-service"
+	ProductTypeSystemExtension         ProductType = "com.apple.product-type.system-extension"
+	ProductTypeDriverExtension         ProductType = "com.apple.product-type.driver-extension"
+	ProductTypeKernelExtension         ProductType = "com.apple.product-type.kernel-extension"
+	ProductTypeInstrumentsPackage      ProductType = "com.apple.product-type.instruments-package"
+	ProductTypeMetalLibrary            ProductType = "com.apple.product-type.metal-library"
+	ProductTypeInAppPurchaseContent    ProductType = "com.apple.product-type.in-app-purchase-content"
+)
+
+var knownProductTypes = map[ProductType]bool{
+	ProductTypeApplication:             true,
+	ProductTypeAppClip:                 true,
+	ProductTypeMessagesApplication:     true,
+	ProductTypeWatchApp:                true,
+	ProductTypeWatch2App:               true,
+	ProductTypeWatch2AppContainer:      true,
+	ProductTypeAppExtension:            true,
+	ProductTypeMessagesExtension:       true,
+	ProductTypeStickerPack:             true,
+	ProductTypeIntentsServiceExtension: true,
+	ProductTypeWatchExtension:          true,
+	ProductTypeWatch2Extension:         true,
+	ProductTypeTVExtension:             true,
+	ProductTypeExtensionKitExtension:   true,
+	ProductTypeXcodeExtension:          true,
+	ProductTypeFramework:               true,
+	ProductTypeStaticFramework:         true,
+	ProductTypeXCFramework:             true,
+	ProductTypeStaticLibrary:           true,
+	ProductTypeDynamicLibrary:          true,
+	ProductTypeBundle:                  true,
+	ProductTypeUnitTestBundle:          true,
+	ProductTypeUITestBundle:            true,
+	ProductTypeOCUnitTestBundle:        true,
+	ProductTypeCommandLineTool:         true,
+	ProductTypeXPCService:              true,
+	ProductTypeSystemExtension:         true,
+	ProductTypeDriverExtension:         true,
+	ProductTypeKernelExtension:         true,
+	ProductTypeInstrumentsPackage:      true,
+	ProductTypeMetalLibrary:            true,
+	ProductTypeInAppPurchaseContent:    true,
+}
+
+// IsKnown reports whether the product type is one of the ProductType constants.
+func (t ProductType) IsKnown() bool {
+	return knownProductTypes[t]
+}
+
+// Name returns the product type without the `com.apple.product-type.` prefix, like `bundle.unit-test`.
+func (t ProductType) Name() string {
+	return strings.TrimPrefix(string(t), productTypePrefix)
+}
+
+// IsTest reports whether the product is a unit or UI test bundle.
+func (t ProductType) IsTest() bool {
+	switch t {
+	case ProductTypeUnitTestBundle, ProductTypeUITestBundle, ProductTypeOCUnitTestBundle:
+		return true
+	}
+	return false
+}
+
+// IsUITest reports whether the product is a UI test bundle.
+func (t ProductType) IsUITest() bool {
+	return t == ProductTypeUITestBundle
+}
+
+// IsApp reports whether the product is an application: iOS, macOS, tvOS, watchOS or Messages app, or an App Clip.
+func (t ProductType) IsApp() bool {
+	switch t {
+	case ProductTypeApplication, ProductTypeAppClip, ProductTypeMessagesApplication,
+		ProductTypeWatchApp, ProductTypeWatch2App, ProductTypeWatch2AppContainer:
+		return true
+	}
+	return false
+}
+
+// IsAppClip reports whether the product is an App Clip (on demand install capable application).
+func (t ProductType) IsAppClip() bool {
+	return t == ProductTypeAppClip
+}
+
+// IsExtension reports whether the product is an app extension, including watchOS, tvOS, ExtensionKit and Xcode extensions.
+// System, driver and kernel extensions are not app extensions.
+func (t ProductType) IsExtension() bool {
+	switch t {
+	case ProductTypeAppExtension, ProductTypeMessagesExtension, ProductTypeStickerPack, ProductTypeIntentsServiceExtension,
+		ProductTypeWatchExtension, ProductTypeWatch2Extension, ProductTypeTVExtension,
+		ProductTypeExtensionKitExtension, ProductTypeXcodeExtension:
+		return true
+	}
+	return false
+}
+
+// IsFramework reports whether the product is a dynamic, static or XCFramework.
+func (t ProductType) IsFramework() bool {
+	switch t {
+	case ProductTypeFramework, ProductTypeStaticFramework, ProductTypeXCFramework:
+		return true
+	}
+	return false
+}
+
+// IsLibrary reports whether the product is a static or dynamic library.
+func (t ProductType) IsLibrary() bool {
+	return t == ProductTypeStaticLibrary || t == ProductTypeDynamicLibrary
+}
+
+// productFile returns the file name and the explicitFileType of the product of a target with the given product name,
+// like `SampleApp.app` and `wrapper.application`.
+func (t ProductType) productFile(productName string) (string, string, bool) {
+	switch {
+	case t.IsApp():
+		return productName + ".app", "wrapper.application", true
+	case t.IsExtension():
+		return productName + ".appex", "wrapper.app-extension", true
+	case t.IsTest():
+		return productName + ".xctest", "wrapper.cfbundle", true
+	}
+
+	switch t {
+	case ProductTypeFramework, ProductTypeStaticFramework:
+		return productName + ".framework", "wrapper.framework", true
+	case ProductTypeXCFramework:
+		return productName + ".xcframework", "wrapper.xcframework", true
+	case ProductTypeStaticLibrary:
+		return "lib" + productName + ".a", "archive.ar", true
+	case ProductTypeDynamicLibrary:
+		return "lib" + productName + ".dylib", "compiled.mach-o.dylib", true
+	case ProductTypeBundle:
+		return productName + ".bundle", "wrapper.cfbundle", true
+	case ProductTypeCommandLineTool:
+		return productName, "compiled.mach-o.executable", true
+	case ProductTypeXPCService:
+		return productName + ".xpc", "wrapper.xpc-service", true
+	case ProductTypeSystemExtension:
+		return productName + ".systemextension", "wrapper.system-extension", true
+	case ProductTypeDriverExtension:
+		return productName + ".dext", "wrapper.driver-extension", true
+	case ProductTypeKernelExtension:
+		return productName + ".kext", "wrapper.kernel-extension", true
+	case ProductTypeMetalLibrary:
+		return productName + ".metallib", "archive.metal-library", true
+	}
+	return "", "", false
+}
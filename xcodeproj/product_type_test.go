@@ -0,0 +1,59 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductType(t *testing.T) {
+	t.Log("classification")
+	{
+		require.Equal(t, true, ProductTypeUnitTestBundle.IsTest())
+		require.Equal(t, true, ProductTypeUITestBundle.IsTest())
+		require.Equal(t, true, ProductTypeUITestBundle.IsUITest())
+		require.Equal(t, false, ProductTypeUnitTestBundle.IsUITest())
+		require.Equal(t, false, ProductTypeApplication.IsTest())
+
+		require.Equal(t, true, ProductTypeApplication.IsApp())
+		require.Equal(t, true, ProductTypeAppClip.IsApp())
+		require.Equal(t, true, ProductTypeAppClip.IsAppClip())
+		require.Equal(t, true, ProductTypeWatch2App.IsApp())
+		require.Equal(t, false, ProductTypeAppExtension.IsApp())
+
+		require.Equal(t, true, ProductTypeAppExtension.IsExtension())
+		require.Equal(t, true, ProductTypeWatch2Extension.IsExtension())
+		require.Equal(t, true, ProductTypeMessagesExtension.IsExtension())
+		require.Equal(t, false, ProductTypeSystemExtension.IsExtension())
+		require.Equal(t, false, ProductTypeXPCService.IsExtension())
+
+		require.Equal(t, true, ProductTypeStaticFramework.IsFramework())
+		require.Equal(t, false, ProductTypeStaticLibrary.IsFramework())
+		require.Equal(t, true, ProductTypeStaticLibrary.IsLibrary())
+	}
+
+	t.Log("unknown product type")
+	{
+		productType := ProductType("com.apple.product-type.future")
+		require.Equal(t, false, productType.IsKnown())
+		require.Equal(t, true, ProductTypeXPCService.IsKnown())
+		require.Equal(t, "future", productType.Name())
+		require.Equal(t, false, productType.IsTest())
+		require.Equal(t, false, productType.IsApp())
+	}
+
+	t.Log("parsed targets")
+	{
+		project, err := ParseProject(kitPbxprojContent)
+		require.NoError(t, err)
+
+		kit, found := project.TargetByName("Kit")
+		require.Equal(t, true, found)
+		require.Equal(t, ProductTypeFramework, kit.(*PBXNativeTarget).ProductType)
+		require.Equal(t, true, kit.(*PBXNativeTarget).ProductType.IsFramework())
+
+		kitTests, found := project.TargetByName("KitTests")
+		require.Equal(t, true, found)
+		require.Equal(t, true, kitTests.(*PBXNativeTarget).ProductType.IsTest())
+	}
+}
@@ -0,0 +1,1120 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// Object is an item of the project.pbxproj objects dictionary.
+type Object interface {
+	ObjectID() string
+	ObjectIsa() string
+	decode(p *Project, base PBXObject, raw *PlistDict)
+}
+
+// PBXObject holds the properties shared by every project object.
+// Objects of an isa, which is not modelled by this package, are represented by a plain *PBXObject.
+type PBXObject struct {
+	ID  string
+	Isa string
+}
+
+// ObjectID ...
+func (o *PBXObject) ObjectID() string {
+	return o.ID
+}
+
+// ObjectIsa ...
+func (o *PBXObject) ObjectIsa() string {
+	return o.Isa
+}
+
+func (o *PBXObject) decode(p *Project, base PBXObject, raw *PlistDict) {
+	*o = base
+}
+
+// ------------------------------
+// Project
+
+// PBXProject is the root object of a project.
+type PBXProject struct {
+	PBXObject
+	BuildConfigurationList *XCConfigurationList
+	CompatibilityVersion   string
+	DevelopmentRegion      string
+	KnownRegions           []string
+	MainGroup              *PBXGroup
+	PackageReferences      []SwiftPackageReference
+	ProductRefGroup        *PBXGroup
+	ProjectDirPath         string
+	ProjectRoot            string
+	ProjectReferences      []ProjectReference
+	Targets                []Target
+}
+
+// ProjectReference is a sub-project reference of a PBXProject.
+type ProjectReference struct {
+	ProductGroup *PBXGroup
+	ProjectRef   *PBXFileReference
+}
+
+func (o *PBXProject) decode(p *Project, base PBXObject, raw *PlistDict) {
+	projectReferences := []ProjectReference{}
+	if array, found := raw.GetArray("projectReferences"); found {
+		for _, value := range array {
+			reference, ok := value.(*PlistDict)
+			if !ok {
+				continue
+			}
+			productGroup, _ := p.objectRef(reference, "ProductGroup").(*PBXGroup)
+			projectRef, _ := p.objectRef(reference, "ProjectRef").(*PBXFileReference)
+			projectReferences = append(projectReferences, ProjectReference{
+				ProductGroup: productGroup,
+				ProjectRef:   projectRef,
+			})
+		}
+	}
+
+	targets := []Target{}
+	for _, object := range p.objectRefs(raw, "targets") {
+		if target, ok := object.(Target); ok {
+			targets = append(targets, target)
+		}
+	}
+
+	packageReferences := []SwiftPackageReference{}
+	for _, object := range p.objectRefs(raw, "packageReferences") {
+		if reference, ok := object.(SwiftPackageReference); ok {
+			packageReferences = append(packageReferences, reference)
+		}
+	}
+
+	mainGroup, _ := p.objectRef(raw, "mainGroup").(*PBXGroup)
+	productRefGroup, _ := p.objectRef(raw, "productRefGroup").(*PBXGroup)
+	buildConfigurationList, _ := p.objectRef(raw, "buildConfigurationList").(*XCConfigurationList)
+	compatibilityVersion, _ := raw.GetString("compatibilityVersion")
+	developmentRegion, _ := raw.GetString("developmentRegion")
+	projectDirPath, _ := raw.GetString("projectDirPath")
+	projectRoot, _ := raw.GetString("projectRoot")
+
+	*o = PBXProject{
+		PBXObject:              base,
+		BuildConfigurationList: buildConfigurationList,
+		CompatibilityVersion:   compatibilityVersion,
+		DevelopmentRegion:      developmentRegion,
+		KnownRegions:           raw.GetStrings("knownRegions"),
+		MainGroup:              mainGroup,
+		PackageReferences:      packageReferences,
+		ProductRefGroup:        productRefGroup,
+		ProjectDirPath:         projectDirPath,
+		ProjectRoot:            projectRoot,
+		ProjectReferences:      projectReferences,
+		Targets:                targets,
+	}
+}
+
+// ------------------------------
+// Targets
+
+// Target is a PBXNativeTarget, PBXAggregateTarget or PBXLegacyTarget.
+type Target interface {
+	Object
+	AbstractTarget() *PBXTarget
+}
+
+// PBXTarget holds the properties shared by every target type.
+type PBXTarget struct {
+	PBXObject
+	Name                   string
+	ProductName            string
+	BuildConfigurationList *XCConfigurationList
+	BuildPhases            []BuildPhase
+	Dependencies           []*PBXTargetDependency
+}
+
+// AbstractTarget ...
+func (t *PBXTarget) AbstractTarget() *PBXTarget {
+	return t
+}
+
+func decodePBXTarget(p *Project, base PBXObject, raw *PlistDict) PBXTarget {
+	buildPhases := []BuildPhase{}
+	for _, object := range p.objectRefs(raw, "buildPhases") {
+		if buildPhase, ok := object.(BuildPhase); ok {
+			buildPhases = append(buildPhases, buildPhase)
+		}
+	}
+
+	dependencies := []*PBXTargetDependency{}
+	for _, object := range p.objectRefs(raw, "dependencies") {
+		if dependency, ok := object.(*PBXTargetDependency); ok {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	name, _ := raw.GetString("name")
+	productName, _ := raw.GetString("productName")
+	buildConfigurationList, _ := p.objectRef(raw, "buildConfigurationList").(*XCConfigurationList)
+
+	return PBXTarget{
+		PBXObject:              base,
+		Name:                   name,
+		ProductName:            productName,
+		BuildConfigurationList: buildConfigurationList,
+		BuildPhases:            buildPhases,
+		Dependencies:           dependencies,
+	}
+}
+
+// PBXNativeTarget ...
+type PBXNativeTarget struct {
+	PBXTarget
+	PackageProductDependencies []*XCSwiftPackageProductDependency
+	ProductInstallPath         string
+	ProductReference           *PBXFileReference
+	ProductType                ProductType
+}
+
+func (o *PBXNativeTarget) decode(p *Project, base PBXObject, raw *PlistDict) {
+	packageProductDependencies := []*XCSwiftPackageProductDependency{}
+	for _, object := range p.objectRefs(raw, "packageProductDependencies") {
+		if dependency, ok := object.(*XCSwiftPackageProductDependency); ok {
+			packageProductDependencies = append(packageProductDependencies, dependency)
+		}
+	}
+
+	productInstallPath, _ := raw.GetString("productInstallPath")
+	productReference, _ := p.objectRef(raw, "productReference").(*PBXFileReference)
+	productType, _ := raw.GetString("productType")
+
+	*o = PBXNativeTarget{
+		PBXTarget:                  decodePBXTarget(p, base, raw),
+		PackageProductDependencies: packageProductDependencies,
+		ProductInstallPath:         productInstallPath,
+		ProductReference:           productReference,
+		ProductType:                ProductType(productType),
+	}
+}
+
+// PBXAggregateTarget ...
+type PBXAggregateTarget struct {
+	PBXTarget
+}
+
+func (o *PBXAggregateTarget) decode(p *Project, base PBXObject, raw *PlistDict) {
+	*o = PBXAggregateTarget{
+		PBXTarget: decodePBXTarget(p, base, raw),
+	}
+}
+
+// PBXLegacyTarget ...
+type PBXLegacyTarget struct {
+	PBXTarget
+	BuildArgumentsString           string
+	BuildToolPath                  string
+	BuildWorkingDirectory          string
+	PassBuildSettingsInEnvironment bool
+}
+
+func (o *PBXLegacyTarget) decode(p *Project, base PBXObject, raw *PlistDict) {
+	buildArgumentsString, _ := raw.GetString("buildArgumentsString")
+	buildToolPath, _ := raw.GetString("buildToolPath")
+	buildWorkingDirectory, _ := raw.GetString("buildWorkingDirectory")
+	passBuildSettingsInEnvironment, _ := raw.GetString("passBuildSettingsInEnvironment")
+
+	*o = PBXLegacyTarget{
+		PBXTarget:                      decodePBXTarget(p, base, raw),
+		BuildArgumentsString:           buildArgumentsString,
+		BuildToolPath:                  buildToolPath,
+		BuildWorkingDirectory:          buildWorkingDirectory,
+		PassBuildSettingsInEnvironment: plistBool(passBuildSettingsInEnvironment),
+	}
+}
+
+// PBXTargetDependency ...
+type PBXTargetDependency struct {
+	PBXObject
+	Name        string
+	Target      Target
+	TargetProxy *PBXContainerItemProxy
+}
+
+func (o *PBXTargetDependency) decode(p *Project, base PBXObject, raw *PlistDict) {
+	name, _ := raw.GetString("name")
+	target, _ := p.objectRef(raw, "target").(Target)
+	targetProxy, _ := p.objectRef(raw, "targetProxy").(*PBXContainerItemProxy)
+
+	*o = PBXTargetDependency{
+		PBXObject:   base,
+		Name:        name,
+		Target:      target,
+		TargetProxy: targetProxy,
+	}
+}
+
+// PBXContainerItemProxy references an object of the project itself or of a referenced (sub-)project.
+// ContainerPortal is the PBXProject of this project or the PBXFileReference of the referenced project.
+type PBXContainerItemProxy struct {
+	PBXObject
+	ContainerPortal      Object
+	ProxyType            string
+	RemoteGlobalIDString string
+	RemoteInfo           string
+}
+
+func (o *PBXContainerItemProxy) decode(p *Project, base PBXObject, raw *PlistDict) {
+	proxyType, _ := raw.GetString("proxyType")
+	remoteGlobalIDString, _ := raw.GetString("remoteGlobalIDString")
+	remoteInfo, _ := raw.GetString("remoteInfo")
+
+	*o = PBXContainerItemProxy{
+		PBXObject:            base,
+		ContainerPortal:      p.objectRef(raw, "containerPortal"),
+		ProxyType:            proxyType,
+		RemoteGlobalIDString: remoteGlobalIDString,
+		RemoteInfo:           remoteInfo,
+	}
+}
+
+// ------------------------------
+// File elements
+
+// FileElement is a PBXFileReference, PBXGroup, PBXVariantGroup, XCVersionGroup or PBXReferenceProxy.
+type FileElement interface {
+	Object
+	AbstractFileElement() *PBXFileElement
+}
+
+// PBXFileElement holds the properties shared by every file element type.
+type PBXFileElement struct {
+	PBXObject
+	Name       string
+	Path       string
+	SourceTree string
+}
+
+// AbstractFileElement ...
+func (e *PBXFileElement) AbstractFileElement() *PBXFileElement {
+	return e
+}
+
+// DisplayName returns the name of the element, as shown by Xcode.
+func (e *PBXFileElement) DisplayName() string {
+	if e.Name != "" {
+		return e.Name
+	}
+	if e.Path != "" {
+		return filepath.Base(e.Path)
+	}
+	return ""
+}
+
+func decodePBXFileElement(base PBXObject, raw *PlistDict) PBXFileElement {
+	name, _ := raw.GetString("name")
+	pth, _ := raw.GetString("path")
+	sourceTree, _ := raw.GetString("sourceTree")
+
+	return PBXFileElement{
+		PBXObject:  base,
+		Name:       name,
+		Path:       pth,
+		SourceTree: sourceTree,
+	}
+}
+
+// PBXFileReference ...
+type PBXFileReference struct {
+	PBXFileElement
+	ExplicitFileType  string
+	FileEncoding      string
+	IncludeInIndex    string
+	LastKnownFileType string
+}
+
+func (o *PBXFileReference) decode(p *Project, base PBXObject, raw *PlistDict) {
+	explicitFileType, _ := raw.GetString("explicitFileType")
+	fileEncoding, _ := raw.GetString("fileEncoding")
+	includeInIndex, _ := raw.GetString("includeInIndex")
+	lastKnownFileType, _ := raw.GetString("lastKnownFileType")
+
+	*o = PBXFileReference{
+		PBXFileElement:    decodePBXFileElement(base, raw),
+		ExplicitFileType:  explicitFileType,
+		FileEncoding:      fileEncoding,
+		IncludeInIndex:    includeInIndex,
+		LastKnownFileType: lastKnownFileType,
+	}
+}
+
+// Group is a PBXGroup, PBXVariantGroup or XCVersionGroup.
+type Group interface {
+	FileElement
+	AbstractGroup() *PBXGroup
+}
+
+// PBXGroup ...
+type PBXGroup struct {
+	PBXFileElement
+	Children []FileElement
+}
+
+// AbstractGroup ...
+func (o *PBXGroup) AbstractGroup() *PBXGroup {
+	return o
+}
+
+func decodePBXGroup(p *Project, base PBXObject, raw *PlistDict) PBXGroup {
+	children := []FileElement{}
+	for _, object := range p.objectRefs(raw, "children") {
+		if child, ok := object.(FileElement); ok {
+			children = append(children, child)
+		}
+	}
+
+	return PBXGroup{
+		PBXFileElement: decodePBXFileElement(base, raw),
+		Children:       children,
+	}
+}
+
+func (o *PBXGroup) decode(p *Project, base PBXObject, raw *PlistDict) {
+	*o = decodePBXGroup(p, base, raw)
+}
+
+// PBXVariantGroup groups the localized variants of a file.
+type PBXVariantGroup struct {
+	PBXGroup
+}
+
+func (o *PBXVariantGroup) decode(p *Project, base PBXObject, raw *PlistDict) {
+	*o = PBXVariantGroup{
+		PBXGroup: decodePBXGroup(p, base, raw),
+	}
+}
+
+// XCVersionGroup groups the versions of a versioned file, like a .xcdatamodeld.
+type XCVersionGroup struct {
+	PBXGroup
+	CurrentVersion   *PBXFileReference
+	VersionGroupType string
+}
+
+func (o *XCVersionGroup) decode(p *Project, base PBXObject, raw *PlistDict) {
+	currentVersion, _ := p.objectRef(raw, "currentVersion").(*PBXFileReference)
+	versionGroupType, _ := raw.GetString("versionGroupType")
+
+	*o = XCVersionGroup{
+		PBXGroup:         decodePBXGroup(p, base, raw),
+		CurrentVersion:   currentVersion,
+		VersionGroupType: versionGroupType,
+	}
+}
+
+// PBXReferenceProxy is a product of a referenced (sub-)project.
+type PBXReferenceProxy struct {
+	PBXFileElement
+	FileType  string
+	RemoteRef *PBXContainerItemProxy
+}
+
+func (o *PBXReferenceProxy) decode(p *Project, base PBXObject, raw *PlistDict) {
+	fileType, _ := raw.GetString("fileType")
+	remoteRef, _ := p.objectRef(raw, "remoteRef").(*PBXContainerItemProxy)
+
+	*o = PBXReferenceProxy{
+		PBXFileElement: decodePBXFileElement(base, raw),
+		FileType:       fileType,
+		RemoteRef:      remoteRef,
+	}
+}
+
+// ------------------------------
+// Build configurations
+
+// BuildSettings maps build setting names to string or []string values.
+type BuildSettings map[string]interface{}
+
+// Value returns the build setting, if it is a string.
+func (settings BuildSettings) Value(key string) (string, bool) {
+	value, found := settings[key]
+	if !found {
+		return "", false
+	}
+	str, ok := value.(string)
+	return str, ok
+}
+
+func decodeBuildSettings(dict *PlistDict) BuildSettings {
+	settings := BuildSettings{}
+	if dict == nil {
+		return settings
+	}
+
+	for _, entry := range dict.Entries() {
+		switch value := entry.Value.(type) {
+		case PlistString:
+			settings[entry.Key.Value] = value.Value
+		case PlistArray:
+			values := []string{}
+			for _, item := range value {
+				if str, ok := item.(PlistString); ok {
+					values = append(values, str.Value)
+				}
+			}
+			settings[entry.Key.Value] = values
+		}
+	}
+	return settings
+}
+
+// XCBuildConfiguration ...
+type XCBuildConfiguration struct {
+	PBXObject
+	Name                       string
+	BaseConfigurationReference *PBXFileReference
+	BuildSettings              BuildSettings
+}
+
+func (o *XCBuildConfiguration) decode(p *Project, base PBXObject, raw *PlistDict) {
+	name, _ := raw.GetString("name")
+	baseConfigurationReference, _ := p.objectRef(raw, "baseConfigurationReference").(*PBXFileReference)
+	buildSettings, _ := raw.GetDict("buildSettings")
+
+	*o = XCBuildConfiguration{
+		PBXObject:                  base,
+		Name:                       name,
+		BaseConfigurationReference: baseConfigurationReference,
+		BuildSettings:              decodeBuildSettings(buildSettings),
+	}
+}
+
+// XCConfigurationList ...
+type XCConfigurationList struct {
+	PBXObject
+	BuildConfigurations           []*XCBuildConfiguration
+	DefaultConfigurationIsVisible bool
+	DefaultConfigurationName      string
+}
+
+// BuildConfiguration returns the build configuration with the given name.
+func (o *XCConfigurationList) BuildConfiguration(name string) (*XCBuildConfiguration, bool) {
+	for _, buildConfiguration := range o.BuildConfigurations {
+		if buildConfiguration.Name == name {
+			return buildConfiguration, true
+		}
+	}
+	return nil, false
+}
+
+func (o *XCConfigurationList) decode(p *Project, base PBXObject, raw *PlistDict) {
+	buildConfigurations := []*XCBuildConfiguration{}
+	for _, object := range p.objectRefs(raw, "buildConfigurations") {
+		if buildConfiguration, ok := object.(*XCBuildConfiguration); ok {
+			buildConfigurations = append(buildConfigurations, buildConfiguration)
+		}
+	}
+
+	defaultConfigurationIsVisible, _ := raw.GetString("defaultConfigurationIsVisible")
+	defaultConfigurationName, _ := raw.GetString("defaultConfigurationName")
+
+	*o = XCConfigurationList{
+		PBXObject:                     base,
+		BuildConfigurations:           buildConfigurations,
+		DefaultConfigurationIsVisible: plistBool(defaultConfigurationIsVisible),
+		DefaultConfigurationName:      defaultConfigurationName,
+	}
+}
+
+// ------------------------------
+// Build phases
+
+// BuildPhase is a PBXSourcesBuildPhase, PBXFrameworksBuildPhase, PBXResourcesBuildPhase, PBXHeadersBuildPhase,
+// PBXCopyFilesBuildPhase or PBXShellScriptBuildPhase.
+type BuildPhase interface {
+	Object
+	AbstractBuildPhase() *PBXBuildPhase
+}
+
+// PBXBuildPhase holds the properties shared by every build phase type.
+type PBXBuildPhase struct {
+	PBXObject
+	Name                               string
+	BuildActionMask                    string
+	Files                              []*PBXBuildFile
+	RunOnlyForDeploymentPostprocessing bool
+}
+
+// AbstractBuildPhase ...
+func (ph *PBXBuildPhase) AbstractBuildPhase() *PBXBuildPhase {
+	return ph
+}
+
+var defaultBuildPhaseNames = map[string]string{
+	"PBXSourcesBuildPhase":     "Sources",
+	"PBXFrameworksBuildPhase":  "Frameworks",
+	"PBXResourcesBuildPhase":   "Resources",
+	"PBXHeadersBuildPhase":     "Headers",
+	"PBXCopyFilesBuildPhase":   "CopyFiles",
+	"PBXShellScriptBuildPhase": "ShellScript",
+	"PBXRezBuildPhase":         "Rez",
+	"PBXAppleScriptBuildPhase": "AppleScript",
+}
+
+// DisplayName returns the name of the build phase, as shown by Xcode.
+func (ph *PBXBuildPhase) DisplayName() string {
+	if ph.Name != "" {
+		return ph.Name
+	}
+	return defaultBuildPhaseNames[ph.Isa]
+}
+
+func decodePBXBuildPhase(p *Project, base PBXObject, raw *PlistDict) PBXBuildPhase {
+	files := []*PBXBuildFile{}
+	for _, object := range p.objectRefs(raw, "files") {
+		if file, ok := object.(*PBXBuildFile); ok {
+			files = append(files, file)
+		}
+	}
+
+	name, _ := raw.GetString("name")
+	buildActionMask, _ := raw.GetString("buildActionMask")
+	runOnlyForDeploymentPostprocessing, _ := raw.GetString("runOnlyForDeploymentPostprocessing")
+
+	return PBXBuildPhase{
+		PBXObject:                          base,
+		Name:                               name,
+		BuildActionMask:                    buildActionMask,
+		Files:                              files,
+		RunOnlyForDeploymentPostprocessing: plistBool(runOnlyForDeploymentPostprocessing),
+	}
+}
+
+// PBXSourcesBuildPhase ...
+type PBXSourcesBuildPhase struct {
+	PBXBuildPhase
+}
+
+func (o *PBXSourcesBuildPhase) decode(p *Project, base PBXObject, raw *PlistDict) {
+	*o = PBXSourcesBuildPhase{PBXBuildPhase: decodePBXBuildPhase(p, base, raw)}
+}
+
+// PBXFrameworksBuildPhase ...
+type PBXFrameworksBuildPhase struct {
+	PBXBuildPhase
+}
+
+func (o *PBXFrameworksBuildPhase) decode(p *Project, base PBXObject, raw *PlistDict) {
+	*o = PBXFrameworksBuildPhase{PBXBuildPhase: decodePBXBuildPhase(p, base, raw)}
+}
+
+// PBXResourcesBuildPhase ...
+type PBXResourcesBuildPhase struct {
+	PBXBuildPhase
+}
+
+func (o *PBXResourcesBuildPhase) decode(p *Project, base PBXObject, raw *PlistDict) {
+	*o = PBXResourcesBuildPhase{PBXBuildPhase: decodePBXBuildPhase(p, base, raw)}
+}
+
+// PBXHeadersBuildPhase ...
+type PBXHeadersBuildPhase struct {
+	PBXBuildPhase
+}
+
+func (o *PBXHeadersBuildPhase) decode(p *Project, base PBXObject, raw *PlistDict) {
+	*o = PBXHeadersBuildPhase{PBXBuildPhase: decodePBXBuildPhase(p, base, raw)}
+}
+
+// PBXCopyFilesBuildPhase destinations (dstSubfolderSpec)
+const (
+	CopyFilesDestinationAbsolutePath     = "0"
+	CopyFilesDestinationWrapper          = "1"
+	CopyFilesDestinationExecutables      = "6"
+	CopyFilesDestinationResources        = "7"
+	CopyFilesDestinationFrameworks       = "10"
+	CopyFilesDestinationSharedFrameworks = "11"
+	CopyFilesDestinationSharedSupport    = "12"
+	CopyFilesDestinationPlugins          = "13"
+	CopyFilesDestinationProducts         = "16"
+)
+
+// PBXCopyFilesBuildPhase ...
+type PBXCopyFilesBuildPhase struct {
+	PBXBuildPhase
+	DstPath          string
+	DstSubfolderSpec string
+}
+
+func (o *PBXCopyFilesBuildPhase) decode(p *Project, base PBXObject, raw *PlistDict) {
+	dstPath, _ := raw.GetString("dstPath")
+	dstSubfolderSpec, _ := raw.GetString("dstSubfolderSpec")
+
+	*o = PBXCopyFilesBuildPhase{
+		PBXBuildPhase:    decodePBXBuildPhase(p, base, raw),
+		DstPath:          dstPath,
+		DstSubfolderSpec: dstSubfolderSpec,
+	}
+}
+
+// PBXShellScriptBuildPhase ...
+type PBXShellScriptBuildPhase struct {
+	PBXBuildPhase
+	AlwaysOutOfDate     bool
+	InputFileListPaths  []string
+	InputPaths          []string
+	OutputFileListPaths []string
+	OutputPaths         []string
+	ShellPath           string
+	ShellScript         string
+	ShowEnvVarsInLog    bool
+}
+
+func (o *PBXShellScriptBuildPhase) decode(p *Project, base PBXObject, raw *PlistDict) {
+	alwaysOutOfDate, _ := raw.GetString("alwaysOutOfDate")
+	shellPath, _ := raw.GetString("shellPath")
+	shellScript, _ := raw.GetString("shellScript")
+	showEnvVarsInLog, found := raw.GetString("showEnvVarsInLog")
+	if !found {
+		showEnvVarsInLog = "1"
+	}
+
+	*o = PBXShellScriptBuildPhase{
+		PBXBuildPhase:       decodePBXBuildPhase(p, base, raw),
+		AlwaysOutOfDate:     plistBool(alwaysOutOfDate),
+		InputFileListPaths:  raw.GetStrings("inputFileListPaths"),
+		InputPaths:          raw.GetStrings("inputPaths"),
+		OutputFileListPaths: raw.GetStrings("outputFileListPaths"),
+		OutputPaths:         raw.GetStrings("outputPaths"),
+		ShellPath:           shellPath,
+		ShellScript:         shellScript,
+		ShowEnvVarsInLog:    plistBool(showEnvVarsInLog),
+	}
+}
+
+// PBXBuildFile is a file element (FileRef) or a Swift package product (ProductRef) added to a build phase.
+// Settings holds the per file build settings, like COMPILER_FLAGS (string) or ATTRIBUTES ([]string).
+type PBXBuildFile struct {
+	PBXObject
+	FileRef    FileElement
+	ProductRef *XCSwiftPackageProductDependency
+	Settings   BuildSettings
+}
+
+func (o *PBXBuildFile) decode(p *Project, base PBXObject, raw *PlistDict) {
+	fileRef, _ := p.objectRef(raw, "fileRef").(FileElement)
+	productRef, _ := p.objectRef(raw, "productRef").(*XCSwiftPackageProductDependency)
+	settings, _ := raw.GetDict("settings")
+
+	*o = PBXBuildFile{
+		PBXObject:  base,
+		FileRef:    fileRef,
+		ProductRef: productRef,
+		Settings:   decodeBuildSettings(settings),
+	}
+}
+
+// ------------------------------
+// Swift packages
+
+// SwiftPackageReference is an XCRemoteSwiftPackageReference or XCLocalSwiftPackageReference.
+type SwiftPackageReference interface {
+	Object
+	// PackageName returns the name of the package, as Xcode shows it: the last component of the URL or path.
+	PackageName() string
+}
+
+// Swift package requirement kinds
+const (
+	SwiftPackageRequirementUpToNextMajorVersion = "upToNextMajorVersion"
+	SwiftPackageRequirementUpToNextMinorVersion = "upToNextMinorVersion"
+	SwiftPackageRequirementVersionRange         = "versionRange"
+	SwiftPackageRequirementExactVersion         = "exactVersion"
+	SwiftPackageRequirementBranch               = "branch"
+	SwiftPackageRequirementRevision             = "revision"
+)
+
+// SwiftPackageRequirement is the version requirement of a remote Swift package.
+// MinimumVersion is set for the upToNextMajorVersion, upToNextMinorVersion and versionRange kinds,
+// MaximumVersion (exclusive) for versionRange, Version for exactVersion, Branch and Revision for their kinds.
+type SwiftPackageRequirement struct {
+	Kind           string
+	MinimumVersion string
+	MaximumVersion string
+	Version        string
+	Branch         string
+	Revision       string
+}
+
+func decodeSwiftPackageRequirement(raw *PlistDict) SwiftPackageRequirement {
+	if raw == nil {
+		return SwiftPackageRequirement{}
+	}
+
+	kind, _ := raw.GetString("kind")
+	minimumVersion, _ := raw.GetString("minimumVersion")
+	maximumVersion, _ := raw.GetString("maximumVersion")
+	version, _ := raw.GetString("version")
+	branch, _ := raw.GetString("branch")
+	revision, _ := raw.GetString("revision")
+
+	return SwiftPackageRequirement{
+		Kind:           kind,
+		MinimumVersion: minimumVersion,
+		MaximumVersion: maximumVersion,
+		Version:        version,
+		Branch:         branch,
+		Revision:       revision,
+	}
+}
+
+// XCRemoteSwiftPackageReference is a Swift package of a git repository.
+type XCRemoteSwiftPackageReference struct {
+	PBXObject
+	RepositoryURL string
+	Requirement   SwiftPackageRequirement
+}
+
+// PackageName ...
+func (o *XCRemoteSwiftPackageReference) PackageName() string {
+	return strings.TrimSuffix(path.Base(o.RepositoryURL), ".git")
+}
+
+func (o *XCRemoteSwiftPackageReference) decode(p *Project, base PBXObject, raw *PlistDict) {
+	repositoryURL, _ := raw.GetString("repositoryURL")
+	requirement, _ := raw.GetDict("requirement")
+
+	*o = XCRemoteSwiftPackageReference{
+		PBXObject:     base,
+		RepositoryURL: repositoryURL,
+		Requirement:   decodeSwiftPackageRequirement(requirement),
+	}
+}
+
+// XCLocalSwiftPackageReference is a Swift package on the disk, RelativePath is relative to the project's directory.
+type XCLocalSwiftPackageReference struct {
+	PBXObject
+	RelativePath string
+}
+
+// PackageName ...
+func (o *XCLocalSwiftPackageReference) PackageName() string {
+	return path.Base(o.RelativePath)
+}
+
+func (o *XCLocalSwiftPackageReference) decode(p *Project, base PBXObject, raw *PlistDict) {
+	relativePath, _ := raw.GetString("relativePath")
+
+	*o = XCLocalSwiftPackageReference{
+		PBXObject:    base,
+		RelativePath: relativePath,
+	}
+}
+
+// XCSwiftPackageProductDependency is a product of a Swift package, which a target depends on.
+// Package is nil for packages of the workspace.
+type XCSwiftPackageProductDependency struct {
+	PBXObject
+	ProductName string
+	Package     SwiftPackageReference
+}
+
+func (o *XCSwiftPackageProductDependency) decode(p *Project, base PBXObject, raw *PlistDict) {
+	productName, _ := raw.GetString("productName")
+	pkg, _ := p.objectRef(raw, "package").(SwiftPackageReference)
+
+	*o = XCSwiftPackageProductDependency{
+		PBXObject:   base,
+		ProductName: productName,
+		Package:     pkg,
+	}
+}
+
+// ------------------------------
+// Project
+
+// Project is the object graph of an Xcode project's project.pbxproj file,
+// with object references resolved to the referenced objects.
+type Project struct {
+	// Path is the path of the .xcodeproj, empty if the project was parsed from content.
+	Path           string
+	ArchiveVersion string
+	ObjectVersion  string
+	RootObject     *PBXProject
+	Objects        map[string]Object
+
+	// IDGenerator generates the IDs of new objects, random IDs are generated if it is not set.
+	IDGenerator *ObjectIDGenerator
+
+	root    *PlistDict
+	objects *PlistDict
+}
+
+// OpenProject parses the project.pbxproj of the given .xcodeproj.
+func OpenProject(projectPth string) (*Project, error) {
+	pbxProjPth := filepath.Join(projectPth, "project.pbxproj")
+	if exist, err := pathutil.IsPathExists(pbxProjPth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, fmt.Errorf("project.pbxproj does not exist at: %s", pbxProjPth)
+	}
+
+	content, err := fileutil.ReadStringFromFile(pbxProjPth)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := ParseProject(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", pbxProjPth, err)
+	}
+	project.Path = projectPth
+
+	return project, nil
+}
+
+// ParseProject parses project.pbxproj content.
+// Partial content, like a list of `id = { ... };` object entries without the enclosing root dictionary, is also accepted,
+// the resulting project has no RootObject in this case.
+func ParseProject(content string) (*Project, error) {
+	root, err := ParsePlistDict(content)
+	if err != nil {
+		return nil, err
+	}
+	return newProject(root)
+}
+
+// parseProjectChunk parses a chunk of project.pbxproj content, which may end before its closing braces.
+func parseProjectChunk(content string) (*Project, error) {
+	root, err := parseTruncatedPlistDict(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// if the objects dictionary is not closed, the root entries following it (like rootObject) are parsed into it
+	if objects, found := root.GetDict("objects"); found {
+		for _, entry := range append([]PlistDictEntry{}, objects.Entries()...) {
+			if _, ok := entry.Value.(*PlistDict); !ok {
+				objects.Delete(entry.Key.Value)
+				root.SetEntry(entry)
+			}
+		}
+	}
+
+	return newProject(root)
+}
+
+func newProject(root *PlistDict) (*Project, error) {
+	objects, found := root.GetDict("objects")
+	if !found {
+		objects = root
+		root = nil
+	}
+
+	project := &Project{
+		Objects: map[string]Object{},
+		root:    root,
+		objects: objects,
+	}
+	if err := project.reload(); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// Name returns the name of the project, based on its path.
+func (p *Project) Name() string {
+	if p.Path == "" {
+		return ""
+	}
+	return strings.TrimSuffix(filepath.Base(p.Path), XCodeProjExt)
+}
+
+// Object returns the object with the given ID.
+func (p *Project) Object(id string) (Object, bool) {
+	object, found := p.Objects[id]
+	return object, found
+}
+
+// Targets returns the targets of the project.
+// If the project has no root object, every target object is returned in the order of the objects dictionary.
+func (p *Project) Targets() []Target {
+	if p.RootObject != nil {
+		return p.RootObject.Targets
+	}
+
+	targets := []Target{}
+	for _, id := range p.objects.Keys() {
+		if target, ok := p.Objects[id].(Target); ok {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// NativeTargets returns the PBXNativeTarget targets of the project.
+func (p *Project) NativeTargets() []*PBXNativeTarget {
+	nativeTargets := []*PBXNativeTarget{}
+	for _, target := range p.Targets() {
+		if nativeTarget, ok := target.(*PBXNativeTarget); ok {
+			nativeTargets = append(nativeTargets, nativeTarget)
+		}
+	}
+	return nativeTargets
+}
+
+// TargetByName returns the target with the given name.
+func (p *Project) TargetByName(name string) (Target, bool) {
+	for _, target := range p.Targets() {
+		if target.AbstractTarget().Name == name {
+			return target, true
+		}
+	}
+	return nil, false
+}
+
+func newObject(isa string) Object {
+	switch isa {
+	case "PBXProject":
+		return &PBXProject{}
+	case "PBXNativeTarget":
+		return &PBXNativeTarget{}
+	case "PBXAggregateTarget":
+		return &PBXAggregateTarget{}
+	case "PBXLegacyTarget":
+		return &PBXLegacyTarget{}
+	case "PBXTargetDependency":
+		return &PBXTargetDependency{}
+	case "PBXContainerItemProxy":
+		return &PBXContainerItemProxy{}
+	case "PBXFileReference":
+		return &PBXFileReference{}
+	case "PBXGroup":
+		return &PBXGroup{}
+	case "PBXVariantGroup":
+		return &PBXVariantGroup{}
+	case "XCVersionGroup":
+		return &XCVersionGroup{}
+	case "PBXReferenceProxy":
+		return &PBXReferenceProxy{}
+	case "XCBuildConfiguration":
+		return &XCBuildConfiguration{}
+	case "XCConfigurationList":
+		return &XCConfigurationList{}
+	case "PBXSourcesBuildPhase":
+		return &PBXSourcesBuildPhase{}
+	case "PBXFrameworksBuildPhase":
+		return &PBXFrameworksBuildPhase{}
+	case "PBXResourcesBuildPhase":
+		return &PBXResourcesBuildPhase{}
+	case "PBXHeadersBuildPhase":
+		return &PBXHeadersBuildPhase{}
+	case "PBXCopyFilesBuildPhase":
+		return &PBXCopyFilesBuildPhase{}
+	case "PBXShellScriptBuildPhase":
+		return &PBXShellScriptBuildPhase{}
+	case "PBXBuildFile":
+		return &PBXBuildFile{}
+	case "XCRemoteSwiftPackageReference":
+		return &XCRemoteSwiftPackageReference{}
+	case "XCLocalSwiftPackageReference":
+		return &XCLocalSwiftPackageReference{}
+	case "XCSwiftPackageProductDependency":
+		return &XCSwiftPackageProductDependency{}
+	default:
+		return &PBXObject{}
+	}
+}
+
+// reload (re)builds the typed object graph from the underlying property list.
+// Objects, which already existed with the same isa, keep their identity, so previously returned pointers stay valid.
+func (p *Project) reload() error {
+	objects := map[string]Object{}
+	raws := map[string]*PlistDict{}
+
+	for _, entry := range p.objects.Entries() {
+		id := entry.Key.Value
+
+		raw, ok := entry.Value.(*PlistDict)
+		if !ok {
+			return fmt.Errorf("invalid object (%s): not a dictionary", id)
+		}
+
+		isa, found := raw.GetString("isa")
+		if !found {
+			return fmt.Errorf("invalid object (%s): missing isa", id)
+		}
+
+		object, found := p.Objects[id]
+		if !found || object.ObjectIsa() != isa {
+			object = newObject(isa)
+		}
+
+		objects[id] = object
+		raws[id] = raw
+	}
+
+	p.Objects = objects
+	for id, object := range objects {
+		raw := raws[id]
+		isa, _ := raw.GetString("isa")
+		object.decode(p, PBXObject{ID: id, Isa: isa}, raw)
+	}
+
+	p.RootObject = nil
+	p.ArchiveVersion = ""
+	p.ObjectVersion = ""
+	if p.root != nil {
+		p.ArchiveVersion, _ = p.root.GetString("archiveVersion")
+		p.ObjectVersion, _ = p.root.GetString("objectVersion")
+
+		rootObject, found := p.root.GetString("rootObject")
+		if !found {
+			return fmt.Errorf("missing rootObject")
+		}
+		// partial project.pbxproj content may not contain the root object itself
+		if object, found := p.Objects[rootObject]; found {
+			project, ok := object.(*PBXProject)
+			if !ok {
+				return fmt.Errorf("rootObject (%s) is not a PBXProject", rootObject)
+			}
+			p.RootObject = project
+		}
+	}
+
+	return nil
+}
+
+// objectRef resolves the object referenced by the given key of the raw object.
+func (p *Project) objectRef(raw *PlistDict, key string) Object {
+	id, found := raw.GetString(key)
+	if !found {
+		return nil
+	}
+	return p.Objects[id]
+}
+
+// objectRefs resolves the objects referenced by the array value of the given key of the raw object.
+func (p *Project) objectRefs(raw *PlistDict, key string) []Object {
+	objects := []Object{}
+	for _, id := range raw.GetStrings(key) {
+		if object, found := p.Objects[id]; found {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+func plistBool(value string) bool {
+	switch strings.ToLower(value) {
+	case "1", "yes", "true":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,305 @@
+package xcodeproj
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PBXBuildFile settings
+const (
+	BuildFileSettingAttributes    = "ATTRIBUTES"
+	BuildFileSettingCompilerFlags = "COMPILER_FLAGS"
+)
+
+// PBXBuildFile ATTRIBUTES
+const (
+	BuildFileAttributeCodeSignOnCopy      = "CodeSignOnCopy"
+	BuildFileAttributeRemoveHeadersOnCopy = "RemoveHeadersOnCopy"
+	BuildFileAttributePublic              = "Public"
+	BuildFileAttributePrivate             = "Private"
+	BuildFileAttributeWeak                = "Weak"
+)
+
+// Attributes returns the ATTRIBUTES setting of the build file, like CodeSignOnCopy or Public.
+func (o *PBXBuildFile) Attributes() []string {
+	attributes, _ := o.Settings[BuildFileSettingAttributes].([]string)
+	return attributes
+}
+
+// CompilerFlags returns the COMPILER_FLAGS setting of the build file, like `-fno-objc-arc`.
+func (o *PBXBuildFile) CompilerFlags() string {
+	compilerFlags, _ := o.Settings[BuildFileSettingCompilerFlags].(string)
+	return compilerFlags
+}
+
+// BuildPhasesOfType returns the build phases of the target with the given isa, like PBXShellScriptBuildPhase.
+func (t *PBXTarget) BuildPhasesOfType(isa string) []BuildPhase {
+	buildPhases := []BuildPhase{}
+	for _, buildPhase := range t.BuildPhases {
+		if buildPhase.ObjectIsa() == isa {
+			buildPhases = append(buildPhases, buildPhase)
+		}
+	}
+	return buildPhases
+}
+
+// ShellScriptBuildPhases returns the Run Script build phases of the target.
+func (t *PBXTarget) ShellScriptBuildPhases() []*PBXShellScriptBuildPhase {
+	buildPhases := []*PBXShellScriptBuildPhase{}
+	for _, buildPhase := range t.BuildPhases {
+		if shellScript, ok := buildPhase.(*PBXShellScriptBuildPhase); ok {
+			buildPhases = append(buildPhases, shellScript)
+		}
+	}
+	return buildPhases
+}
+
+// BuildPhaseIndex returns the index of the build phase in the target's build phases, -1 if the target has no such phase.
+func (t *PBXTarget) BuildPhaseIndex(buildPhase BuildPhase) int {
+	for i, existing := range t.BuildPhases {
+		if existing == buildPhase {
+			return i
+		}
+	}
+	return -1
+}
+
+// singleBuildPhases are the build phase types, which a target has at most one of.
+var singleBuildPhases = map[string]bool{
+	"PBXSourcesBuildPhase":    true,
+	"PBXFrameworksBuildPhase": true,
+	"PBXResourcesBuildPhase":  true,
+	"PBXHeadersBuildPhase":    true,
+}
+
+// AddBuildPhase adds a new, empty PBXSourcesBuildPhase, PBXFrameworksBuildPhase, PBXResourcesBuildPhase
+// or PBXHeadersBuildPhase to the target, at the given index of its build phases.
+// A negative index (or an index past the last build phase) appends the build phase.
+func (p *Project) AddBuildPhase(target Target, isa string, index int) (BuildPhase, error) {
+	if !singleBuildPhases[isa] {
+		return nil, fmt.Errorf("failed to add build phase: unsupported build phase type: %s", isa)
+	}
+	if err := p.checkTarget(target); err != nil {
+		return nil, fmt.Errorf("failed to add build phase: %s", err)
+	}
+	if len(target.AbstractTarget().BuildPhasesOfType(isa)) > 0 {
+		return nil, fmt.Errorf("failed to add build phase: %s already has a %s build phase", target.AbstractTarget().Name, defaultBuildPhaseNames[isa])
+	}
+
+	return p.addBuildPhase(target, isa, target.AbstractTarget().Name, index, nil)
+}
+
+// AddCopyFilesBuildPhase adds a new, empty Copy Files build phase to the target, at the given index of its build phases.
+// The files are copied to the path (may be empty) relative to the destination, like CopyFilesDestinationFrameworks.
+func (p *Project) AddCopyFilesBuildPhase(target Target, name, destination, pth string, index int) (*PBXCopyFilesBuildPhase, error) {
+	if err := p.checkTarget(target); err != nil {
+		return nil, fmt.Errorf("failed to add build phase: %s", err)
+	}
+
+	buildPhase, err := p.addBuildPhase(target, "PBXCopyFilesBuildPhase", target.AbstractTarget().Name+"/"+name, index, map[string]interface{}{
+		"dstPath":          PlistString{Value: pth},
+		"dstSubfolderSpec": PlistString{Value: destination},
+		"name":             PlistString{Value: name},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buildPhase.(*PBXCopyFilesBuildPhase), nil
+}
+
+// ShellScript describes a new Run Script build phase.
+// ShellPath defaults to /bin/sh.
+type ShellScript struct {
+	Name                  string
+	ShellPath             string
+	Script                string
+	InputPaths            []string
+	InputFileListPaths    []string
+	OutputPaths           []string
+	OutputFileListPaths   []string
+	AlwaysOutOfDate       bool
+	HideEnvVarsInLog      bool
+	RunOnlyWhenInstalling bool
+}
+
+// AddShellScriptBuildPhase adds a new Run Script build phase to the target, at the given index of its build phases.
+// A negative index (or an index past the last build phase) appends the build phase.
+func (p *Project) AddShellScriptBuildPhase(target Target, script ShellScript, index int) (*PBXShellScriptBuildPhase, error) {
+	if err := p.checkTarget(target); err != nil {
+		return nil, fmt.Errorf("failed to add build phase: %s", err)
+	}
+
+	shellPath := script.ShellPath
+	if shellPath == "" {
+		shellPath = "/bin/sh"
+	}
+
+	fields := map[string]interface{}{
+		"inputFileListPaths":  plistStrings(script.InputFileListPaths...),
+		"inputPaths":          plistStrings(script.InputPaths...),
+		"outputFileListPaths": plistStrings(script.OutputFileListPaths...),
+		"outputPaths":         plistStrings(script.OutputPaths...),
+		"shellPath":           PlistString{Value: shellPath},
+		"shellScript":         PlistString{Value: script.Script},
+	}
+	if script.Name != "" {
+		fields["name"] = PlistString{Value: script.Name}
+	}
+	if script.AlwaysOutOfDate {
+		fields["alwaysOutOfDate"] = PlistString{Value: "1"}
+	}
+	if script.HideEnvVarsInLog {
+		fields["showEnvVarsInLog"] = PlistString{Value: "0"}
+	}
+	if script.RunOnlyWhenInstalling {
+		fields["buildActionMask"] = PlistString{Value: "8"}
+		fields["runOnlyForDeploymentPostprocessing"] = PlistString{Value: "1"}
+	}
+
+	buildPhase, err := p.addBuildPhase(target, "PBXShellScriptBuildPhase", target.AbstractTarget().Name+"/"+script.Name, index, fields)
+	if err != nil {
+		return nil, err
+	}
+	return buildPhase.(*PBXShellScriptBuildPhase), nil
+}
+
+// MoveBuildPhase moves the build phase of the target to the given index of its build phases.
+// A negative index (or an index past the last build phase) moves the build phase to the end.
+func (p *Project) MoveBuildPhase(target Target, buildPhase BuildPhase, index int) error {
+	if err := p.checkBuildPhase(target, buildPhase); err != nil {
+		return fmt.Errorf("failed to move build phase: %s", err)
+	}
+
+	rawTarget, _ := p.objects.GetDict(target.ObjectID())
+	removeReference(rawTarget, "buildPhases", buildPhase.ObjectID())
+	insertReference(rawTarget, "buildPhases", buildPhase.ObjectID(), index)
+
+	return p.reload()
+}
+
+// RemoveBuildPhase removes the build phase and its build files from the target.
+func (p *Project) RemoveBuildPhase(target Target, buildPhase BuildPhase) error {
+	if err := p.checkBuildPhase(target, buildPhase); err != nil {
+		return fmt.Errorf("failed to remove build phase: %s", err)
+	}
+
+	ids := []string{buildPhase.ObjectID()}
+	for _, buildFile := range buildPhase.AbstractBuildPhase().Files {
+		ids = append(ids, buildFile.ID)
+	}
+
+	p.removeObjects(ids...)
+	return p.reload()
+}
+
+// AddBuildFile adds the file to the build phase, with the given build file settings (may be nil).
+// If the file is already in the build phase, its existing build file is returned.
+func (p *Project) AddBuildFile(buildPhase BuildPhase, file FileElement, settings BuildSettings) (*PBXBuildFile, error) {
+	if err := p.checkObject(buildPhase); err != nil {
+		return nil, fmt.Errorf("failed to add build file: %s", err)
+	}
+	if err := p.checkObject(file); err != nil {
+		return nil, fmt.Errorf("failed to add build file: %s", err)
+	}
+
+	for _, buildFile := range buildPhase.AbstractBuildPhase().Files {
+		if buildFile.FileRef != nil && buildFile.FileRef.ObjectID() == file.ObjectID() {
+			return buildFile, nil
+		}
+	}
+
+	fields := map[string]interface{}{
+		"fileRef": PlistString{Value: file.ObjectID()},
+	}
+	if len(settings) > 0 {
+		fields["settings"] = encodeBuildSettings(settings)
+	}
+
+	buildFileID, err := p.generateObjectID("PBXBuildFile", buildPhase.ObjectID()+"/"+file.ObjectID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add build file: %s", err)
+	}
+	p.insertObject(buildFileID, newRawObject("PBXBuildFile", fields))
+	rawBuildPhase, _ := p.objects.GetDict(buildPhase.ObjectID())
+	appendReference(rawBuildPhase, "files", buildFileID)
+
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p.Objects[buildFileID].(*PBXBuildFile), nil
+}
+
+// SetBuildFileSettings replaces the settings of the build file, nil or empty settings remove them.
+func (p *Project) SetBuildFileSettings(buildFile *PBXBuildFile, settings BuildSettings) error {
+	if buildFile == nil {
+		return errors.New("failed to set build file settings: missing build file")
+	}
+	if err := p.checkObject(buildFile); err != nil {
+		return fmt.Errorf("failed to set build file settings: %s", err)
+	}
+
+	rawBuildFile, _ := p.objects.GetDict(buildFile.ID)
+	if len(settings) == 0 {
+		rawBuildFile.Delete("settings")
+	} else {
+		setField(rawBuildFile, "settings", encodeBuildSettings(settings))
+	}
+
+	return p.reload()
+}
+
+// RemoveBuildFile removes the build file from its build phase, the file element itself is kept.
+func (p *Project) RemoveBuildFile(buildFile *PBXBuildFile) error {
+	if buildFile == nil {
+		return errors.New("failed to remove build file: missing build file")
+	}
+	if err := p.checkObject(buildFile); err != nil {
+		return fmt.Errorf("failed to remove build file: %s", err)
+	}
+
+	p.removeObjects(buildFile.ID)
+	return p.reload()
+}
+
+// addBuildPhase adds a new build phase object of the isa, with the given fields, to the target.
+func (p *Project) addBuildPhase(target Target, isa, context string, index int, fields map[string]interface{}) (BuildPhase, error) {
+	buildPhaseID, err := p.generateObjectID(isa, context)
+	if err != nil {
+		return nil, err
+	}
+	p.insertObject(buildPhaseID, newBuildPhaseObject(isa, fields))
+	rawTarget, _ := p.objects.GetDict(target.ObjectID())
+	insertReference(rawTarget, "buildPhases", buildPhaseID, index)
+
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p.Objects[buildPhaseID].(BuildPhase), nil
+}
+
+// newBuildPhaseObject returns the raw dictionary of a new, empty build phase, the fields override the defaults.
+func newBuildPhaseObject(isa string, fields map[string]interface{}) *PlistDict {
+	all := map[string]interface{}{
+		"buildActionMask":                    PlistString{Value: "2147483647"},
+		"files":                              PlistArray{},
+		"runOnlyForDeploymentPostprocessing": PlistString{Value: "0"},
+	}
+	for key, value := range fields {
+		all[key] = value
+	}
+	return newRawObject(isa, all)
+}
+
+// checkBuildPhase returns an error if the build phase is not a build phase of the target.
+func (p *Project) checkBuildPhase(target Target, buildPhase BuildPhase) error {
+	if err := p.checkTarget(target); err != nil {
+		return err
+	}
+	if buildPhase == nil {
+		return errors.New("missing build phase")
+	}
+	if target.AbstractTarget().BuildPhaseIndex(buildPhase) == -1 {
+		return fmt.Errorf("build phase not found in target %s: %s", target.AbstractTarget().Name, buildPhase.ObjectID())
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package xcodeproj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func buildPhaseNames(target Target) []string {
+	names := []string{}
+	for _, buildPhase := range target.AbstractTarget().BuildPhases {
+		names = append(names, buildPhase.AbstractBuildPhase().DisplayName())
+	}
+	return names
+}
+
+func TestBuildFileSettings(t *testing.T) {
+	project := openTestKitProject(t)
+
+	headers := project.Objects["C4F1B30B2245E0A700D2C8F1"].(*PBXBuildFile)
+	require.Equal(t, []string{BuildFileAttributePublic}, headers.Attributes())
+	require.Equal(t, "", headers.CompilerFlags())
+
+	legacy := project.Objects["C4F1B30F2245E0A700D2C8F1"].(*PBXBuildFile)
+	require.Equal(t, []string(nil), legacy.Attributes())
+	require.Equal(t, "-fno-objc-arc", legacy.CompilerFlags())
+
+	t.Log("set settings")
+	{
+		require.NoError(t, project.SetBuildFileSettings(legacy, BuildSettings{BuildFileSettingCompilerFlags: "-fno-objc-arc -w"}))
+		require.Equal(t, "-fno-objc-arc -w", legacy.CompilerFlags())
+
+		kitSwift := project.Objects["C4F1B30D2245E0A700D2C8F1"].(*PBXBuildFile)
+		require.NoError(t, project.SetBuildFileSettings(kitSwift, BuildSettings{BuildFileSettingCompilerFlags: "-Onone"}))
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, `C4F1B30F2245E0A700D2C8F1 /* Legacy.m in Sources */ = {isa = PBXBuildFile; fileRef = C4F1B30E2245E0A700D2C8F1 /* Legacy.m */; settings = {COMPILER_FLAGS = "-fno-objc-arc -w"; }; };`))
+		require.Equal(t, true, strings.Contains(content, `C4F1B30D2245E0A700D2C8F1 /* Kit.swift in Sources */ = {isa = PBXBuildFile; fileRef = C4F1B30C2245E0A700D2C8F1 /* Kit.swift */; settings = {COMPILER_FLAGS = "-Onone"; }; };`))
+	}
+
+	t.Log("remove settings")
+	{
+		require.NoError(t, project.SetBuildFileSettings(legacy, nil))
+		require.Equal(t, BuildSettings{}, legacy.Settings)
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, `C4F1B30F2245E0A700D2C8F1 /* Legacy.m in Sources */ = {isa = PBXBuildFile; fileRef = C4F1B30E2245E0A700D2C8F1 /* Legacy.m */; };`))
+	}
+}
+
+func TestAddBuildFile(t *testing.T) {
+	project := openTestKitProject(t)
+	embedFrameworks := project.Objects["C4F1B3192245E0A700D2C8F1"].(*PBXCopyFilesBuildPhase)
+	kitFramework := project.Objects["C4F1B3022245E0A700D2C8F1"].(*PBXFileReference)
+
+	buildFile, err := project.AddBuildFile(embedFrameworks, kitFramework, BuildSettings{
+		BuildFileSettingAttributes: []string{BuildFileAttributeCodeSignOnCopy, BuildFileAttributeRemoveHeadersOnCopy},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{BuildFileAttributeCodeSignOnCopy, BuildFileAttributeRemoveHeadersOnCopy}, buildFile.Attributes())
+	require.Equal(t, 2, len(embedFrameworks.Files))
+
+	again, err := project.AddBuildFile(embedFrameworks, kitFramework, nil)
+	require.NoError(t, err)
+	require.Equal(t, buildFile, again)
+
+	content, err := project.Encode()
+	require.NoError(t, err)
+	require.Equal(t, true, strings.Contains(content, buildFile.ID+" /* Kit.framework in Embed Frameworks */ = {isa = PBXBuildFile; fileRef = C4F1B3022245E0A700D2C8F1 /* Kit.framework */; settings = {ATTRIBUTES = (CodeSignOnCopy, RemoveHeadersOnCopy, ); }; };"))
+
+	t.Log("remove build file")
+	{
+		require.NoError(t, project.RemoveBuildFile(buildFile))
+		require.Equal(t, 1, len(embedFrameworks.Files))
+		_, found := project.Object(buildFile.ID)
+		require.Equal(t, false, found)
+		_, found = project.Object(kitFramework.ID)
+		require.Equal(t, true, found)
+
+		require.EqualError(t, project.RemoveBuildFile(buildFile), "failed to remove build file: object not found in project: "+buildFile.ID)
+	}
+}
+
+func TestAddBuildPhase(t *testing.T) {
+	project := openTestKitProject(t)
+	kit, _ := project.TargetByName("Kit")
+	kitTests, _ := project.TargetByName("KitTests")
+
+	t.Log("build phases of type")
+	{
+		require.Equal(t, 1, len(kit.AbstractTarget().BuildPhasesOfType("PBXCopyFilesBuildPhase")))
+		require.Equal(t, 0, len(kit.AbstractTarget().ShellScriptBuildPhases()))
+	}
+
+	t.Log("resources build phase")
+	{
+		buildPhase, err := project.AddBuildPhase(kitTests, "PBXResourcesBuildPhase", 1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Sources", "Resources", "Frameworks"}, buildPhaseNames(kitTests))
+		require.Equal(t, 1, kitTests.AbstractTarget().BuildPhaseIndex(buildPhase))
+
+		_, err = project.AddBuildPhase(kit, "PBXResourcesBuildPhase", -1)
+		require.EqualError(t, err, "failed to add build phase: Kit already has a Resources build phase")
+
+		_, err = project.AddBuildPhase(kit, "PBXShellScriptBuildPhase", -1)
+		require.EqualError(t, err, "failed to add build phase: unsupported build phase type: PBXShellScriptBuildPhase")
+	}
+
+	t.Log("copy files build phase")
+	{
+		buildPhase, err := project.AddCopyFilesBuildPhase(kitTests, "Copy Fixtures", CopyFilesDestinationResources, "Fixtures", -1)
+		require.NoError(t, err)
+		require.Equal(t, "Fixtures", buildPhase.DstPath)
+		require.Equal(t, []string{"Sources", "Resources", "Frameworks", "Copy Fixtures"}, buildPhaseNames(kitTests))
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, "\t\t"+buildPhase.ID+` /* Copy Fixtures */ = {
+			isa = PBXCopyFilesBuildPhase;
+			buildActionMask = 2147483647;
+			dstPath = Fixtures;
+			dstSubfolderSpec = 7;
+			files = (
+			);
+			name = "Copy Fixtures";
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+`))
+	}
+
+	t.Log("shell script build phase")
+	{
+		buildPhase, err := project.AddShellScriptBuildPhase(kit, ShellScript{
+			Name:        "SwiftLint",
+			Script:      "set -e\nswiftlint lint --strict\n",
+			InputPaths:  []string{"$(SRCROOT)/.swiftlint.yml"},
+			OutputPaths: []string{"$(DERIVED_FILE_DIR)/swiftlint.stamp"},
+		}, 1)
+		require.NoError(t, err)
+		require.Equal(t, "/bin/sh", buildPhase.ShellPath)
+		require.Equal(t, true, buildPhase.ShowEnvVarsInLog)
+		require.Equal(t, []*PBXShellScriptBuildPhase{buildPhase}, kit.AbstractTarget().ShellScriptBuildPhases())
+		require.Equal(t, []string{"Headers", "SwiftLint", "Sources", "Frameworks", "Resources", "Embed Frameworks"}, buildPhaseNames(kit))
+
+		upload, err := project.AddShellScriptBuildPhase(kit, ShellScript{
+			Name:                  "Upload Symbols",
+			ShellPath:             "/bin/bash",
+			Script:                `"${BUILD_DIR%/Build/*}/SourcePackages/checkouts/firebase-ios-sdk/Crashlytics/run"`,
+			InputPaths:            []string{"${DWARF_DSYM_FOLDER_PATH}/${DWARF_DSYM_FILE_NAME}"},
+			HideEnvVarsInLog:      true,
+			RunOnlyWhenInstalling: true,
+		}, -1)
+		require.NoError(t, err)
+		require.Equal(t, false, upload.ShowEnvVarsInLog)
+		require.Equal(t, true, upload.RunOnlyForDeploymentPostprocessing)
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, "\t\t"+buildPhase.ID+` /* SwiftLint */ = {
+			isa = PBXShellScriptBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			inputFileListPaths = (
+			);
+			inputPaths = (
+				"$(SRCROOT)/.swiftlint.yml",
+			);
+			name = SwiftLint;
+			outputFileListPaths = (
+			);
+			outputPaths = (
+				"$(DERIVED_FILE_DIR)/swiftlint.stamp",
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+			shellPath = /bin/sh;
+			shellScript = "set -e\nswiftlint lint --strict\n";
+		};
+`))
+		require.Equal(t, true, strings.Contains(content, "\t\t\t\t"+upload.ID+" /* Upload Symbols */,\n\t\t\t);\n\t\t\tbuildRules = ("))
+		require.Equal(t, true, strings.Contains(content, "\t\t\tshellScript = \"\\\"${BUILD_DIR%/Build/*}/SourcePackages/checkouts/firebase-ios-sdk/Crashlytics/run\\\"\";\n\t\t\tshowEnvVarsInLog = 0;\n"))
+
+		reparsed, err := ParseProject(content)
+		require.NoError(t, err)
+		reparsedUpload := reparsed.Objects[upload.ID].(*PBXShellScriptBuildPhase)
+		require.Equal(t, upload.ShellScript, reparsedUpload.ShellScript)
+		require.Equal(t, "8", reparsedUpload.BuildActionMask)
+	}
+}
+
+func TestMoveAndRemoveBuildPhase(t *testing.T) {
+	project := openTestKitProject(t)
+	kit, _ := project.TargetByName("Kit")
+	kitTests, _ := project.TargetByName("KitTests")
+	headers := project.Objects["C4F1B3052245E0A700D2C8F1"].(BuildPhase)
+	embedFrameworks := project.Objects["C4F1B3192245E0A700D2C8F1"].(BuildPhase)
+
+	t.Log("move build phase")
+	{
+		require.NoError(t, project.MoveBuildPhase(kit, embedFrameworks, 0))
+		require.Equal(t, []string{"Embed Frameworks", "Headers", "Sources", "Frameworks", "Resources"}, buildPhaseNames(kit))
+
+		require.NoError(t, project.MoveBuildPhase(kit, embedFrameworks, -1))
+		require.Equal(t, []string{"Headers", "Sources", "Frameworks", "Resources", "Embed Frameworks"}, buildPhaseNames(kit))
+
+		require.NoError(t, project.MoveBuildPhase(kit, headers, 2))
+		require.Equal(t, []string{"Sources", "Frameworks", "Headers", "Resources", "Embed Frameworks"}, buildPhaseNames(kit))
+
+		require.EqualError(t, project.MoveBuildPhase(kitTests, headers, 0), "failed to move build phase: build phase not found in target KitTests: C4F1B3052245E0A700D2C8F1")
+	}
+
+	t.Log("remove build phase")
+	{
+		require.NoError(t, project.RemoveBuildPhase(kit, embedFrameworks))
+		require.Equal(t, []string{"Sources", "Frameworks", "Headers", "Resources"}, buildPhaseNames(kit))
+
+		for _, id := range []string{"C4F1B3192245E0A700D2C8F1", "C4F1B31A2245E0A700D2C8F1"} {
+			_, found := project.Object(id)
+			require.Equal(t, false, found, id)
+		}
+		_, found := project.Object("C4F1B3162245E0A700D2C8F1")
+		require.Equal(t, true, found)
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, false, strings.Contains(content, "PBXCopyFilesBuildPhase"))
+		require.Equal(t, false, strings.Contains(content, "Embed Frameworks"))
+
+		require.EqualError(t, project.RemoveBuildPhase(kit, embedFrameworks), "failed to remove build phase: build phase not found in target Kit: C4F1B3192245E0A700D2C8F1")
+	}
+}
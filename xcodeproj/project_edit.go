@@ -0,0 +1,300 @@
+package xcodeproj
+
+import "sort"
+
+// newRawObject returns the raw dictionary of a new object, with the isa first and the other keys in alphabetical order,
+// as Xcode writes them.
+func newRawObject(isa string, fields map[string]interface{}) *PlistDict {
+	raw := newSortedPlistDict(fields)
+	raw.Insert(0, PlistDictEntry{Key: PlistString{Value: "isa"}, Value: PlistString{Value: isa}})
+	return raw
+}
+
+// newSortedPlistDict returns a dictionary of the fields, in alphabetical order.
+func newSortedPlistDict(fields map[string]interface{}) *PlistDict {
+	keys := []string{}
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	dict := NewPlistDict()
+	for _, key := range keys {
+		dict.Set(key, fields[key])
+	}
+	return dict
+}
+
+func plistStrings(strs ...string) PlistArray {
+	array := PlistArray{}
+	for _, str := range strs {
+		array = append(array, PlistString{Value: str})
+	}
+	return array
+}
+
+// encodeBuildSettings returns the raw dictionary of the build settings, in alphabetical order.
+func encodeBuildSettings(settings BuildSettings) *PlistDict {
+	fields := map[string]interface{}{}
+	for key, value := range settings {
+		switch v := value.(type) {
+		case string:
+			fields[key] = PlistString{Value: v}
+		case []string:
+			fields[key] = plistStrings(v...)
+		}
+	}
+	return newSortedPlistDict(fields)
+}
+
+// generateObjectID returns a new object ID from the IDGenerator of the project.
+func (p *Project) generateObjectID(isa, context string) (string, error) {
+	if p.IDGenerator == nil {
+		p.IDGenerator = NewObjectIDGenerator(p)
+	}
+	return p.IDGenerator.Generate(isa, context)
+}
+
+// insertObject adds the raw object to the objects dictionary, keeping Xcode's order: objects are sorted by isa, then by ID.
+func (p *Project) insertObject(id string, raw *PlistDict) {
+	isa, _ := raw.GetString("isa")
+
+	idx := p.objects.Len()
+	for i, entry := range p.objects.Entries() {
+		other, ok := entry.Value.(*PlistDict)
+		if !ok {
+			continue
+		}
+		otherIsa, _ := other.GetString("isa")
+		if otherIsa > isa || (otherIsa == isa && entry.Key.Value > id) {
+			idx = i
+			break
+		}
+	}
+
+	p.objects.Insert(idx, PlistDictEntry{Key: PlistString{Value: id}, Value: raw})
+}
+
+// setField sets the value of the raw object's key, a new key is inserted in alphabetical order (after the isa).
+func setField(raw *PlistDict, key string, value interface{}) {
+	if _, found := raw.Get(key); found {
+		raw.Set(key, value)
+		return
+	}
+
+	idx := raw.Len()
+	for i, existing := range raw.Keys() {
+		if existing != "isa" && existing > key {
+			idx = i
+			break
+		}
+	}
+	raw.Insert(idx, PlistDictEntry{Key: PlistString{Value: key}, Value: value})
+}
+
+// appendReference appends the object ID to the array value of the given key of the raw object.
+func appendReference(raw *PlistDict, key, id string) {
+	array, _ := raw.GetArray(key)
+	setField(raw, key, append(append(PlistArray{}, array...), PlistString{Value: id}))
+}
+
+// insertReference inserts the object ID into the array value of the given key of the raw object at the index,
+// a negative index (or an index past the last element) appends the ID.
+func insertReference(raw *PlistDict, key, id string, index int) {
+	array, _ := raw.GetArray(key)
+	if index < 0 || index > len(array) {
+		index = len(array)
+	}
+
+	inserted := append(PlistArray{}, array[:index]...)
+	inserted = append(inserted, PlistString{Value: id})
+	setField(raw, key, append(inserted, array[index:]...))
+}
+
+// removeReference removes the object ID from the array value of the given key of the raw object.
+func removeReference(raw *PlistDict, key, id string) {
+	if array, found := raw.GetArray(key); found {
+		raw.Set(key, scrubArrayReferences(array, map[string]bool{id: true}))
+	}
+}
+
+// danglingObject reports whether the raw object only makes sense together with one of the removed objects:
+// a build file of a removed file, a dependency on a removed target or the proxies of removed objects.
+func (p *Project) danglingObject(raw *PlistDict, removed map[string]bool) bool {
+	isa, _ := raw.GetString("isa")
+
+	referencesRemoved := func(keys ...string) bool {
+		for _, key := range keys {
+			if id, found := raw.GetString(key); found && removed[id] {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch isa {
+	case "PBXBuildFile":
+		return referencesRemoved("fileRef", "productRef")
+	case "PBXTargetDependency":
+		return referencesRemoved("target", "targetProxy")
+	case "PBXContainerItemProxy":
+		if referencesRemoved("containerPortal") {
+			return true
+		}
+		// the remote object of a proxy is only an object of this project, if the portal is the project itself
+		rootObject := ""
+		if p.RootObject != nil {
+			rootObject = p.RootObject.ID
+		}
+		containerPortal, _ := raw.GetString("containerPortal")
+		return containerPortal == rootObject && referencesRemoved("remoteGlobalIDString")
+	case "PBXReferenceProxy":
+		return referencesRemoved("remoteRef")
+	}
+	return false
+}
+
+// unscrubbedKeys lists the object keys, which values are not (or not local) object references.
+var unscrubbedKeys = map[string]bool{
+	"buildSettings":        true,
+	"remoteGlobalIDString": true,
+	"settings":             true,
+}
+
+// scrubReferences removes the references of the removed objects from the raw dictionary:
+// array elements and entries with a removed object as their value or key (like TargetAttributes).
+// Dictionary array elements, which reference a removed object (like a projectReferences item), are removed entirely.
+func scrubReferences(dict *PlistDict, removed map[string]bool) {
+	for _, entry := range append([]PlistDictEntry{}, dict.Entries()...) {
+		key := entry.Key.Value
+		if removed[key] {
+			dict.Delete(key)
+			continue
+		}
+		if unscrubbedKeys[key] {
+			continue
+		}
+
+		switch value := entry.Value.(type) {
+		case PlistString:
+			if removed[value.Value] {
+				dict.Delete(key)
+			}
+		case PlistArray:
+			dict.Set(key, scrubArrayReferences(value, removed))
+		case *PlistDict:
+			scrubReferences(value, removed)
+		}
+	}
+}
+
+func scrubArrayReferences(array PlistArray, removed map[string]bool) PlistArray {
+	scrubbed := PlistArray{}
+	for _, value := range array {
+		switch v := value.(type) {
+		case PlistString:
+			if removed[v.Value] {
+				continue
+			}
+		case *PlistDict:
+			if referencesRemovedObject(v, removed) {
+				continue
+			}
+			scrubReferences(v, removed)
+		}
+		scrubbed = append(scrubbed, value)
+	}
+	return scrubbed
+}
+
+func referencesRemovedObject(dict *PlistDict, removed map[string]bool) bool {
+	for _, entry := range dict.Entries() {
+		if str, ok := entry.Value.(PlistString); ok && removed[str.Value] {
+			return true
+		}
+	}
+	return false
+}
+
+// removeObjects removes the objects with the given IDs, the objects, which become dangling without them,
+// and every reference to the removed objects. The typed object graph needs to be reloaded afterwards.
+func (p *Project) removeObjects(ids ...string) {
+	removed := map[string]bool{}
+	for _, id := range ids {
+		removed[id] = true
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, entry := range p.objects.Entries() {
+			raw, ok := entry.Value.(*PlistDict)
+			if !ok || removed[entry.Key.Value] {
+				continue
+			}
+			if p.danglingObject(raw, removed) {
+				removed[entry.Key.Value] = true
+				changed = true
+			}
+		}
+	}
+
+	for id := range removed {
+		p.objects.Delete(id)
+	}
+	for _, entry := range p.objects.Entries() {
+		if raw, ok := entry.Value.(*PlistDict); ok {
+			scrubReferences(raw, removed)
+		}
+	}
+}
+
+// clearAnnotations drops the parsed `/* name */` annotations of the given objects,
+// so the encoder computes them again from the (modified) objects.
+func (p *Project) clearAnnotations(ids map[string]bool) {
+	clearDictAnnotations(p.objects, ids)
+}
+
+func clearDictAnnotations(dict *PlistDict, ids map[string]bool) {
+	for _, entry := range dict.Entries() {
+		changed := false
+		if ids[entry.Key.Value] && entry.Key.Comment != "" {
+			entry.Key.Comment = ""
+			changed = true
+		}
+
+		switch value := entry.Value.(type) {
+		case PlistString:
+			if ids[value.Value] && value.Comment != "" {
+				entry.Value = PlistString{Value: value.Value}
+				changed = true
+			}
+		case PlistArray:
+			entry.Value = clearArrayAnnotations(value, ids)
+			changed = true
+		case *PlistDict:
+			clearDictAnnotations(value, ids)
+		}
+
+		if changed {
+			dict.SetEntry(entry)
+		}
+	}
+}
+
+func clearArrayAnnotations(array PlistArray, ids map[string]bool) PlistArray {
+	cleared := PlistArray{}
+	for _, value := range array {
+		switch v := value.(type) {
+		case PlistString:
+			if ids[v.Value] {
+				value = PlistString{Value: v.Value}
+			}
+		case PlistArray:
+			value = clearArrayAnnotations(v, ids)
+		case *PlistDict:
+			clearDictAnnotations(v, ids)
+		}
+		cleared = append(cleared, value)
+	}
+	return cleared
+}
@@ -0,0 +1,317 @@
+package xcodeproj
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+// Encode serializes the project into project.pbxproj content, in the format Xcode writes it:
+// objects grouped into `/* Begin <isa> section */` blocks, inline `/* name */` annotations for object references,
+// tab indentation and Xcode's string quoting rules.
+// Parsing and encoding an unmodified project.pbxproj results in the original content.
+func (p *Project) Encode() (string, error) {
+	if p.root == nil {
+		return "", errors.New("failed to encode project: partial project content, missing root dictionary")
+	}
+
+	e := newProjectEncoder(p)
+
+	var buffer bytes.Buffer
+	buffer.WriteString("// !$*UTF8*$!\n")
+	e.writeDict(&buffer, p.root, 0, false, true)
+	buffer.WriteString("\n")
+
+	return buffer.String(), nil
+}
+
+// Save writes the project into the project.pbxproj of its .xcodeproj.
+func (p *Project) Save() error {
+	if p.Path == "" {
+		return errors.New("failed to save project: project path not set")
+	}
+
+	content, err := p.Encode()
+	if err != nil {
+		return err
+	}
+
+	return fileutil.WriteStringToFile(filepath.Join(p.Path, "project.pbxproj"), content)
+}
+
+// singleLineIsas lists the object types, which Xcode writes in a single line.
+var singleLineIsas = map[string]bool{
+	"PBXBuildFile":     true,
+	"PBXFileReference": true,
+}
+
+// unannotatedKeys lists the object keys, which values Xcode does not annotate with the referenced object's name,
+// even if the value is an object ID.
+var unannotatedKeys = map[string]bool{
+	"attributes":           true,
+	"buildSettings":        true,
+	"remoteGlobalIDString": true,
+	"settings":             true,
+}
+
+type projectEncoder struct {
+	project *Project
+
+	// buildFilePhases maps PBXBuildFile IDs to the ID of the build phase, which contains them.
+	buildFilePhases map[string]string
+	// configurationListOwners maps XCConfigurationList IDs to the ID of the object, which uses them.
+	configurationListOwners map[string]string
+}
+
+func newProjectEncoder(p *Project) projectEncoder {
+	e := projectEncoder{
+		project:                 p,
+		buildFilePhases:         map[string]string{},
+		configurationListOwners: map[string]string{},
+	}
+
+	for _, entry := range p.objects.Entries() {
+		raw, ok := entry.Value.(*PlistDict)
+		if !ok {
+			continue
+		}
+
+		if _, ok := p.Objects[entry.Key.Value].(BuildPhase); ok {
+			for _, buildFileID := range raw.GetStrings("files") {
+				e.buildFilePhases[buildFileID] = entry.Key.Value
+			}
+		}
+
+		if configurationListID, found := raw.GetString("buildConfigurationList"); found {
+			e.configurationListOwners[configurationListID] = entry.Key.Value
+		}
+	}
+
+	return e
+}
+
+// objectName returns the annotation of the object with the given ID, as Xcode computes it.
+func (e projectEncoder) objectName(id string) string {
+	raw, found := e.project.objects.GetDict(id)
+	if !found {
+		return ""
+	}
+	isa, _ := raw.GetString("isa")
+
+	switch isa {
+	case "PBXProject":
+		return "Project object"
+	case "PBXBuildFile":
+		fileName := ""
+		if fileRef, found := raw.GetString("fileRef"); found {
+			fileName = e.objectName(fileRef)
+		} else if productRef, found := raw.GetString("productRef"); found {
+			fileName = e.objectName(productRef)
+		}
+		if phaseID, found := e.buildFilePhases[id]; found {
+			return fmt.Sprintf("%s in %s", fileName, e.objectName(phaseID))
+		}
+		return fileName
+	case "PBXFileReference", "PBXGroup", "PBXVariantGroup", "XCVersionGroup", "PBXReferenceProxy":
+		if name, found := raw.GetString("name"); found {
+			return name
+		}
+		pth, _ := raw.GetString("path")
+		return pth
+	case "PBXNativeTarget", "PBXAggregateTarget", "PBXLegacyTarget", "XCBuildConfiguration":
+		name, _ := raw.GetString("name")
+		return name
+	case "XCConfigurationList":
+		ownerID, found := e.configurationListOwners[id]
+		if !found {
+			return ""
+		}
+		owner, found := e.project.objects.GetDict(ownerID)
+		if !found {
+			return ""
+		}
+		ownerIsa, _ := owner.GetString("isa")
+
+		ownerName, _ := owner.GetString("name")
+		if ownerIsa == "PBXProject" {
+			ownerName = e.project.Name()
+		}
+		if ownerName == "" {
+			return ""
+		}
+		return fmt.Sprintf("Build configuration list for %s \"%s\"", ownerIsa, ownerName)
+	case "PBXContainerItemProxy", "PBXTargetDependency", "PBXBuildRule":
+		return isa
+	case "XCRemoteSwiftPackageReference":
+		repositoryURL, _ := raw.GetString("repositoryURL")
+		return fmt.Sprintf("XCRemoteSwiftPackageReference \"%s\"", strings.TrimSuffix(path.Base(repositoryURL), ".git"))
+	case "XCLocalSwiftPackageReference":
+		relativePath, _ := raw.GetString("relativePath")
+		return fmt.Sprintf("XCLocalSwiftPackageReference \"%s\"", relativePath)
+	case "XCSwiftPackageProductDependency":
+		productName, _ := raw.GetString("productName")
+		return productName
+	}
+
+	if name, found := raw.GetString("name"); found {
+		return name
+	}
+	return defaultBuildPhaseNames[isa]
+}
+
+func (e projectEncoder) writeComment(buffer *bytes.Buffer, comment string) {
+	if comment != "" {
+		buffer.WriteString(" /* " + comment + " */")
+	}
+}
+
+// writeString writes the string and its annotation.
+// Annotations parsed from the original content are kept, missing ones are computed for object references.
+func (e projectEncoder) writeString(buffer *bytes.Buffer, str PlistString, annotate bool) {
+	buffer.WriteString(quotePlistString(str.Value))
+
+	comment := str.Comment
+	if comment == "" && annotate {
+		comment = e.objectName(str.Value)
+	}
+	e.writeComment(buffer, comment)
+}
+
+func (e projectEncoder) writeValue(buffer *bytes.Buffer, value interface{}, indent int, singleLine, annotate bool) {
+	switch v := value.(type) {
+	case PlistString:
+		e.writeString(buffer, v, annotate)
+	case PlistData:
+		buffer.WriteString("<" + hex.EncodeToString(v) + ">")
+	case PlistArray:
+		e.writeArray(buffer, v, indent, singleLine, annotate)
+	case *PlistDict:
+		e.writeDict(buffer, v, indent, singleLine, annotate)
+	}
+}
+
+func (e projectEncoder) writeArray(buffer *bytes.Buffer, array PlistArray, indent int, singleLine, annotate bool) {
+	buffer.WriteString("(")
+	for _, value := range array {
+		if !singleLine {
+			buffer.WriteString("\n" + strings.Repeat("\t", indent+1))
+		}
+		e.writeValue(buffer, value, indent+1, singleLine, annotate)
+		buffer.WriteString(",")
+		if singleLine {
+			buffer.WriteString(" ")
+		}
+	}
+	if !singleLine {
+		buffer.WriteString("\n" + strings.Repeat("\t", indent))
+	}
+	buffer.WriteString(")")
+}
+
+func (e projectEncoder) writeDict(buffer *bytes.Buffer, dict *PlistDict, indent int, singleLine, annotate bool) {
+	buffer.WriteString("{")
+	for _, entry := range dict.Entries() {
+		if !singleLine {
+			buffer.WriteString("\n" + strings.Repeat("\t", indent+1))
+		}
+
+		buffer.WriteString(quotePlistString(entry.Key.Value))
+		e.writeComment(buffer, entry.Key.Comment)
+		buffer.WriteString(" = ")
+
+		if objects, ok := entry.Value.(*PlistDict); ok && indent == 0 && dict == e.project.root && entry.Key.Value == "objects" {
+			e.writeObjects(buffer, objects)
+		} else {
+			e.writeValue(buffer, entry.Value, indent+1, singleLine, annotate && !unannotatedKeys[entry.Key.Value])
+		}
+
+		buffer.WriteString(";")
+		if singleLine {
+			buffer.WriteString(" ")
+		}
+	}
+	if !singleLine {
+		buffer.WriteString("\n" + strings.Repeat("\t", indent))
+	}
+	buffer.WriteString("}")
+}
+
+// writeObjects writes the objects dictionary, grouping the objects of the same isa into sections.
+func (e projectEncoder) writeObjects(buffer *bytes.Buffer, objects *PlistDict) {
+	buffer.WriteString("{\n")
+
+	section := ""
+	for _, entry := range objects.Entries() {
+		raw, ok := entry.Value.(*PlistDict)
+		if !ok {
+			continue
+		}
+		isa, _ := raw.GetString("isa")
+
+		if isa != section {
+			if section != "" {
+				buffer.WriteString("/* End " + section + " section */\n")
+			}
+			buffer.WriteString("\n/* Begin " + isa + " section */\n")
+			section = isa
+		}
+
+		buffer.WriteString("\t\t" + quotePlistString(entry.Key.Value))
+		comment := entry.Key.Comment
+		if comment == "" {
+			comment = e.objectName(entry.Key.Value)
+		}
+		e.writeComment(buffer, comment)
+		buffer.WriteString(" = ")
+		e.writeDict(buffer, raw, 2, singleLineIsas[isa], true)
+		buffer.WriteString(";\n")
+	}
+	if section != "" {
+		buffer.WriteString("/* End " + section + " section */\n")
+	}
+
+	buffer.WriteString("\t}")
+}
+
+func isPlistUnquotedOutputChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c == '_' || c == '$' || c == '/' || c == '.'
+}
+
+// quotePlistString applies Xcode's quoting rules to the string.
+func quotePlistString(str string) string {
+	needsQuotes := str == "" || strings.Contains(str, "___") || strings.Contains(str, "//")
+	for i := 0; i < len(str) && !needsQuotes; i++ {
+		if !isPlistUnquotedOutputChar(str[i]) {
+			needsQuotes = true
+		}
+	}
+	if !needsQuotes {
+		return str
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString(`"`)
+	for _, r := range str {
+		switch r {
+		case '\\':
+			buffer.WriteString(`\\`)
+		case '"':
+			buffer.WriteString(`\"`)
+		case '\n':
+			buffer.WriteString(`\n`)
+		case '\t':
+			buffer.WriteString(`\t`)
+		default:
+			buffer.WriteRune(r)
+		}
+	}
+	buffer.WriteString(`"`)
+	return buffer.String()
+}
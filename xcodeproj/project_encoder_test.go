@@ -0,0 +1,114 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func stripPlistComments(value interface{}) interface{} {
+	switch v := value.(type) {
+	case PlistString:
+		return PlistString{Value: v.Value}
+	case PlistArray:
+		array := PlistArray{}
+		for _, item := range v {
+			array = append(array, stripPlistComments(item))
+		}
+		return array
+	case *PlistDict:
+		for _, entry := range v.Entries() {
+			v.SetEntry(PlistDictEntry{
+				Key:   PlistString{Value: entry.Key.Value},
+				Value: stripPlistComments(entry.Value),
+			})
+		}
+		return v
+	}
+	return value
+}
+
+func TestProjectEncodeRoundTrip(t *testing.T) {
+	for _, content := range []string{sampleAppPbxprojContent, kitPbxprojContent, legacyPbxprojContent, weatherPbxprojContent, notesPbxprojContent} {
+		project, err := ParseProject(content)
+		require.NoError(t, err)
+
+		encoded, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, content, encoded)
+	}
+}
+
+func TestProjectEncodeComputedAnnotations(t *testing.T) {
+	t.Log("annotations are computed like Xcode does, if missing")
+	{
+		for name, content := range map[string]string{
+			"SampleApp": sampleAppPbxprojContent,
+			"Kit":       kitPbxprojContent,
+			"Legacy":    legacyPbxprojContent,
+			"Weather":   weatherPbxprojContent,
+			"Notes":     notesPbxprojContent,
+		} {
+			project, err := ParseProject(content)
+			require.NoError(t, err)
+			project.Path = "/Users/bitrise/" + name + ".xcodeproj"
+
+			stripPlistComments(project.root)
+
+			encoded, err := project.Encode()
+			require.NoError(t, err)
+			require.Equal(t, content, encoded)
+		}
+	}
+}
+
+func TestProjectEncodeErrors(t *testing.T) {
+	project, err := ParseProject(pbxNativeTargetSectionWithSpace)
+	require.NoError(t, err)
+
+	_, err = project.Encode()
+	require.EqualError(t, err, "failed to encode project: partial project content, missing root dictionary")
+
+	project, err = ParseProject(sampleAppPbxprojContent)
+	require.NoError(t, err)
+	require.EqualError(t, project.Save(), "failed to save project: project path not set")
+}
+
+func TestProjectSave(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	projectPth := filepath.Join(tmpDir, "SampleApp.xcodeproj")
+	require.NoError(t, os.MkdirAll(projectPth, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(projectPth, "project.pbxproj"), []byte(sampleAppPbxprojContent), 0644))
+
+	project, err := OpenProject(projectPth)
+	require.NoError(t, err)
+	require.Equal(t, "SampleApp", project.Name())
+	require.NoError(t, project.Save())
+
+	content, err := ioutil.ReadFile(filepath.Join(projectPth, "project.pbxproj"))
+	require.NoError(t, err)
+	require.Equal(t, sampleAppPbxprojContent, string(content))
+}
+
+func TestQuotePlistString(t *testing.T) {
+	require.Equal(t, `""`, quotePlistString(""))
+	require.Equal(t, `SampleApp`, quotePlistString("SampleApp"))
+	require.Equal(t, `/bin/sh`, quotePlistString("/bin/sh"))
+	require.Equal(t, `io.bitrise.SampleApp`, quotePlistString("io.bitrise.SampleApp"))
+	require.Equal(t, `"<group>"`, quotePlistString("<group>"))
+	require.Equal(t, `"$(inherited)"`, quotePlistString("$(inherited)"))
+	require.Equal(t, `"com.apple.product-type.application"`, quotePlistString("com.apple.product-type.application"))
+	require.Equal(t, `"Xcode 9.3"`, quotePlistString("Xcode 9.3"))
+	require.Equal(t, `"___PROJECTNAME___"`, quotePlistString("___PROJECTNAME___"))
+	require.Equal(t, `"http://github.com"`, quotePlistString("http://github.com"))
+	require.Equal(t, `"echo \"a\"\n\tb\\c"`, quotePlistString("echo \"a\"\n\tb\\c"))
+	require.Equal(t, `"Árvíztűrő"`, quotePlistString("Árvíztűrő"))
+}
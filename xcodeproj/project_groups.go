@@ -0,0 +1,318 @@
+package xcodeproj
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// lastKnownFileTypes maps file extensions to the lastKnownFileType Xcode sets for new file references.
+var lastKnownFileTypes = map[string]string{
+	".a":                "archive.ar",
+	".app":              "wrapper.application",
+	".bundle":           "wrapper.plug-in",
+	".c":                "sourcecode.c.c",
+	".cc":               "sourcecode.cpp.cpp",
+	".cpp":              "sourcecode.cpp.cpp",
+	".dylib":            "compiled.mach-o.dylib",
+	".entitlements":     "text.plist.entitlements",
+	".framework":        "wrapper.framework",
+	".gif":              "image.gif",
+	".h":                "sourcecode.c.h",
+	".hpp":              "sourcecode.cpp.h",
+	".intentdefinition": "file.intentdefinition",
+	".jpeg":             "image.jpeg",
+	".jpg":              "image.jpeg",
+	".js":               "sourcecode.javascript",
+	".json":             "text.json",
+	".m":                "sourcecode.c.objc",
+	".md":               "net.daringfireball.markdown",
+	".metal":            "sourcecode.metal",
+	".mm":               "sourcecode.cpp.objcpp",
+	".modulemap":        "sourcecode.module-map",
+	".pdf":              "image.pdf",
+	".plist":            "text.plist.xml",
+	".png":              "image.png",
+	".sh":               "text.script.sh",
+	".storyboard":       "file.storyboard",
+	".strings":          "text.plist.strings",
+	".stringsdict":      "text.plist.stringsdict",
+	".swift":            "sourcecode.swift",
+	".tbd":              "sourcecode.text-based-dylib-definition",
+	".txt":              "text",
+	".xcassets":         "folder.assetcatalog",
+	".xcconfig":         "text.xcconfig",
+	".xcdatamodel":      "wrapper.xcdatamodel",
+	".xcfilelist":       "text.xcfilelist",
+	".xcframework":      "wrapper.xcframework",
+	".xcodeproj":        "wrapper.pb-project",
+	".xcstrings":        "text.json.xcstrings",
+	".xctestplan":       "text",
+	".xib":              "file.xib",
+	".yaml":             "text.yaml",
+	".yml":              "text.yaml",
+}
+
+// versionGroupTypes maps the extensions of versioned files to the versionGroupType of their XCVersionGroup.
+var versionGroupTypes = map[string]string{
+	".xcdatamodeld":    "wrapper.xcdatamodel",
+	".xcmappingmodeld": "wrapper.xcmappingmodel",
+}
+
+// LastKnownFileType returns the file type, which Xcode assigns to a file based on its extension, like `sourcecode.swift`.
+// Files of unknown types are `file`, folders `folder`.
+func LastKnownFileType(pth string) string {
+	if fileType, found := lastKnownFileTypes[strings.ToLower(path.Ext(pth))]; found {
+		return fileType
+	}
+	if strings.HasSuffix(pth, "/") {
+		return "folder"
+	}
+	return "file"
+}
+
+// AddGroup adds a new group to the parent group. A group with an empty path only organizes its children,
+// otherwise its path is relative to the parent group's path.
+func (p *Project) AddGroup(parent Group, name, pth string) (*PBXGroup, error) {
+	if name == "" && pth == "" {
+		return nil, errors.New("failed to add group: empty name and path")
+	}
+
+	fields := groupFields(name, pth)
+	fields["children"] = PlistArray{}
+	id, err := p.addFileElement(parent, "PBXGroup", name+"/"+pth, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add group: %s", err)
+	}
+	return p.Objects[id].(*PBXGroup), nil
+}
+
+// AddVariantGroup adds a new group of localized variants, like `Main.storyboard`, to the parent group.
+// Add the variants with AddFileReference, like `Base.lproj/Main.storyboard` and `en.lproj/Main.strings`.
+func (p *Project) AddVariantGroup(parent Group, name string) (*PBXVariantGroup, error) {
+	if name == "" {
+		return nil, errors.New("failed to add variant group: empty name")
+	}
+
+	id, err := p.addFileElement(parent, "PBXVariantGroup", name, map[string]interface{}{
+		"children":   PlistArray{},
+		"name":       PlistString{Value: name},
+		"sourceTree": PlistString{Value: SourceTreeGroup},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add variant group: %s", err)
+	}
+	return p.Objects[id].(*PBXVariantGroup), nil
+}
+
+// AddVersionGroup adds a new group of a versioned file, like `Model.xcdatamodeld`, to the parent group.
+// The first version added with AddFileReference becomes the current version, see SetCurrentVersion.
+func (p *Project) AddVersionGroup(parent Group, pth string) (*XCVersionGroup, error) {
+	versionGroupType, found := versionGroupTypes[path.Ext(pth)]
+	if !found {
+		return nil, fmt.Errorf("failed to add version group: unsupported versioned file: %s", pth)
+	}
+
+	id, err := p.addFileElement(parent, "XCVersionGroup", pth, map[string]interface{}{
+		"children":         PlistArray{},
+		"path":             PlistString{Value: pth},
+		"sourceTree":       PlistString{Value: SourceTreeGroup},
+		"versionGroupType": PlistString{Value: versionGroupType},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add version group: %s", err)
+	}
+	return p.Objects[id].(*XCVersionGroup), nil
+}
+
+// AddFileReference adds a new file reference to the parent group.
+// The path is relative to the source tree (SourceTreeGroup, SourceTreeSourceRoot, SourceTreeAbsolute or a build setting),
+// the file type is based on the path's extension (see LastKnownFileType).
+// Variants of a PBXVariantGroup are named after their language, like `Base` for `Base.lproj/Main.storyboard`.
+func (p *Project) AddFileReference(parent Group, pth, sourceTree string) (*PBXFileReference, error) {
+	if pth == "" {
+		return nil, errors.New("failed to add file reference: empty path")
+	}
+	if sourceTree == "" {
+		sourceTree = SourceTreeGroup
+	}
+
+	fields := map[string]interface{}{
+		"lastKnownFileType": PlistString{Value: LastKnownFileType(pth)},
+		"path":              PlistString{Value: pth},
+		"sourceTree":        PlistString{Value: sourceTree},
+	}
+	if _, ok := parent.(*PBXVariantGroup); ok {
+		fields["name"] = PlistString{Value: strings.TrimSuffix(path.Base(path.Dir(pth)), ".lproj")}
+	} else if name := path.Base(pth); name != pth {
+		fields["name"] = PlistString{Value: name}
+	}
+
+	id, err := p.addFileElement(parent, "PBXFileReference", pth, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add file reference: %s", err)
+	}
+
+	if versionGroup, ok := parent.(*XCVersionGroup); ok && versionGroup.CurrentVersion == nil {
+		rawGroup, _ := p.objects.GetDict(versionGroup.ID)
+		setField(rawGroup, "currentVersion", PlistString{Value: id})
+		if err := p.reload(); err != nil {
+			return nil, err
+		}
+	}
+	return p.Objects[id].(*PBXFileReference), nil
+}
+
+// SetCurrentVersion sets the current version of the versioned file, the version has to be a child of the group.
+func (p *Project) SetCurrentVersion(group *XCVersionGroup, version *PBXFileReference) error {
+	if err := p.checkObject(group); err != nil {
+		return fmt.Errorf("failed to set current version: %s", err)
+	}
+	if parent, found := p.ParentGroup(version); !found || parent != Group(group) {
+		return fmt.Errorf("failed to set current version: %s is not a version of %s", version.DisplayName(), group.DisplayName())
+	}
+
+	rawGroup, _ := p.objects.GetDict(group.ID)
+	setField(rawGroup, "currentVersion", PlistString{Value: version.ID})
+	return p.reload()
+}
+
+// MoveFileElement moves the file element to the parent group. Its path stays the same,
+// so a group relative element refers to a different file if the groups have different paths; files on disk are not moved.
+func (p *Project) MoveFileElement(element FileElement, parent Group) error {
+	if err := p.checkObject(element); err != nil {
+		return fmt.Errorf("failed to move file element: %s", err)
+	}
+	if err := p.checkObject(parent); err != nil {
+		return fmt.Errorf("failed to move file element: %s", err)
+	}
+	if p.isMainGroup(element) {
+		return errors.New("failed to move file element: the main group can not be moved")
+	}
+	visited := map[string]bool{}
+	for group, found := parent, true; found && !visited[group.ObjectID()]; group, found = p.ParentGroup(group) {
+		if group.ObjectID() == element.ObjectID() {
+			return fmt.Errorf("failed to move file element: %s can not be moved into itself", element.AbstractFileElement().DisplayName())
+		}
+		visited[group.ObjectID()] = true
+	}
+
+	if currentParent, found := p.ParentGroup(element); found {
+		rawCurrentParent, _ := p.objects.GetDict(currentParent.ObjectID())
+		removeReference(rawCurrentParent, "children", element.ObjectID())
+	}
+	rawParent, _ := p.objects.GetDict(parent.ObjectID())
+	appendReference(rawParent, "children", element.ObjectID())
+
+	return p.reload()
+}
+
+// RemoveFileElement removes the file element, the children of a group (recursively) and every reference to them:
+// build files of the removed files and, for a sub-project reference, the project reference with its products.
+func (p *Project) RemoveFileElement(element FileElement) error {
+	if err := p.checkObject(element); err != nil {
+		return fmt.Errorf("failed to remove file element: %s", err)
+	}
+	if p.isMainGroup(element) {
+		return errors.New("failed to remove file element: the main group can not be removed")
+	}
+
+	ids := fileElementTree(element)
+	if p.RootObject != nil {
+		for _, reference := range p.RootObject.ProjectReferences {
+			if reference.ProjectRef != nil && reference.ProjectRef.ID == element.ObjectID() && reference.ProductGroup != nil {
+				ids = append(ids, fileElementTree(reference.ProductGroup)...)
+			}
+		}
+	}
+
+	p.removeObjects(ids...)
+	return p.reload()
+}
+
+// AddSourceFile adds the file to the target's Sources build phase, the phase is created if the target has none.
+func (p *Project) AddSourceFile(target Target, file FileElement) (*PBXBuildFile, error) {
+	return p.addBuildFile(target, "PBXSourcesBuildPhase", file)
+}
+
+// AddResourceFile adds the file to the target's Resources build phase, the phase is created if the target has none.
+func (p *Project) AddResourceFile(target Target, file FileElement) (*PBXBuildFile, error) {
+	return p.addBuildFile(target, "PBXResourcesBuildPhase", file)
+}
+
+func (p *Project) addBuildFile(target Target, buildPhaseIsa string, file FileElement) (*PBXBuildFile, error) {
+	if err := p.checkTarget(target); err != nil {
+		return nil, fmt.Errorf("failed to add build file: %s", err)
+	}
+	if err := p.checkObject(file); err != nil {
+		return nil, fmt.Errorf("failed to add build file: %s", err)
+	}
+
+	buildPhases := target.AbstractTarget().BuildPhasesOfType(buildPhaseIsa)
+	if len(buildPhases) == 0 {
+		buildPhase, err := p.addBuildPhase(target, buildPhaseIsa, target.AbstractTarget().Name, -1, nil)
+		if err != nil {
+			return nil, err
+		}
+		buildPhases = append(buildPhases, buildPhase)
+	}
+
+	return p.AddBuildFile(buildPhases[0], file, nil)
+}
+
+// addFileElement adds a new file element object to the parent group and returns its ID.
+func (p *Project) addFileElement(parent Group, isa, context string, fields map[string]interface{}) (string, error) {
+	if err := p.checkObject(parent); err != nil {
+		return "", err
+	}
+
+	id, err := p.generateObjectID(isa, parent.ObjectID()+"/"+context)
+	if err != nil {
+		return "", err
+	}
+	p.insertObject(id, newRawObject(isa, fields))
+	rawParent, _ := p.objects.GetDict(parent.ObjectID())
+	appendReference(rawParent, "children", id)
+
+	return id, p.reload()
+}
+
+func groupFields(name, pth string) map[string]interface{} {
+	fields := map[string]interface{}{
+		"sourceTree": PlistString{Value: SourceTreeGroup},
+	}
+	if pth != "" {
+		fields["path"] = PlistString{Value: pth}
+	}
+	if name != "" && name != pth {
+		fields["name"] = PlistString{Value: name}
+	}
+	return fields
+}
+
+// checkObject returns an error if the object is not an object of the project.
+func (p *Project) checkObject(object Object) error {
+	if object == nil {
+		return errors.New("missing object")
+	}
+	if existing, found := p.Objects[object.ObjectID()]; !found || existing != object {
+		return fmt.Errorf("object not found in project: %s", object.ObjectID())
+	}
+	return nil
+}
+
+func (p *Project) isMainGroup(element FileElement) bool {
+	return p.RootObject != nil && p.RootObject.MainGroup != nil && p.RootObject.MainGroup.ID == element.ObjectID()
+}
+
+// fileElementTree returns the IDs of the file element and of its (recursive) children.
+func fileElementTree(element FileElement) []string {
+	ids := []string{element.ObjectID()}
+	if group, ok := element.(Group); ok {
+		for _, child := range group.AbstractGroup().Children {
+			ids = append(ids, fileElementTree(child)...)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,205 @@
+package xcodeproj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func openTestKitProject(t *testing.T) *Project {
+	project, err := ParseProject(kitPbxprojContent)
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/Kit/Kit.xcodeproj"
+	return project
+}
+
+func TestLastKnownFileType(t *testing.T) {
+	require.Equal(t, "sourcecode.swift", LastKnownFileType("API.graphql.swift"))
+	require.Equal(t, "file.storyboard", LastKnownFileType("Base.lproj/Main.storyboard"))
+	require.Equal(t, "image.png", LastKnownFileType("Icon.PNG"))
+	require.Equal(t, "folder", LastKnownFileType("Fixtures/"))
+	require.Equal(t, "file", LastKnownFileType("schema.graphql"))
+}
+
+func TestAddGroupAndFileReference(t *testing.T) {
+	project := openTestKitProject(t)
+	kitGroup := project.Objects["C4F1B3092245E0A700D2C8F1"].(*PBXGroup)
+	kit, _ := project.TargetByName("Kit")
+
+	t.Log("generated source file")
+	{
+		group, err := project.AddGroup(kitGroup, "", "Generated")
+		require.NoError(t, err)
+		require.Equal(t, Group(group), kitGroup.Children[len(kitGroup.Children)-1])
+
+		file, err := project.AddFileReference(group, "API.graphql.swift", "")
+		require.NoError(t, err)
+		require.Equal(t, "sourcecode.swift", file.LastKnownFileType)
+
+		pth, err := project.FileElementPath(file)
+		require.NoError(t, err)
+		require.Equal(t, "/Users/bitrise/Kit/Kit/Generated/API.graphql.swift", pth)
+
+		buildFile, err := project.AddSourceFile(kit, file)
+		require.NoError(t, err)
+		sources := kit.AbstractTarget().BuildPhases[1].AbstractBuildPhase()
+		require.Equal(t, buildFile, sources.Files[len(sources.Files)-1])
+
+		again, err := project.AddSourceFile(kit, file)
+		require.NoError(t, err)
+		require.Equal(t, buildFile, again)
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, file.ID+" /* API.graphql.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = API.graphql.swift; sourceTree = \"<group>\"; };"))
+		require.Equal(t, true, strings.Contains(content, buildFile.ID+" /* API.graphql.swift in Sources */ = {isa = PBXBuildFile; fileRef = "+file.ID+" /* API.graphql.swift */; };"))
+		require.Equal(t, true, strings.Contains(content, group.ID+" /* Generated */ = {\n\t\t\tisa = PBXGroup;\n\t\t\tchildren = (\n\t\t\t\t"+file.ID+" /* API.graphql.swift */,\n\t\t\t);\n\t\t\tpath = Generated;\n"))
+	}
+
+	t.Log("file reference relative to a build setting")
+	{
+		file, err := project.AddFileReference(kitGroup, "System/Library/Frameworks/UIKit.framework", SourceTreeSDKRoot)
+		require.NoError(t, err)
+		require.Equal(t, "UIKit.framework", file.Name)
+		require.Equal(t, "wrapper.framework", file.LastKnownFileType)
+
+		pth, err := project.ResolveFileElementPath(file, map[string]string{SourceTreeSDKRoot: "/SDKs/iPhoneOS.sdk"})
+		require.NoError(t, err)
+		require.Equal(t, "/SDKs/iPhoneOS.sdk/System/Library/Frameworks/UIKit.framework", pth)
+	}
+
+	t.Log("localized resource")
+	{
+		variantGroup, err := project.AddVariantGroup(kitGroup, "Localizable.strings")
+		require.NoError(t, err)
+
+		variant, err := project.AddFileReference(variantGroup, "en.lproj/Localizable.strings", "")
+		require.NoError(t, err)
+		require.Equal(t, "en", variant.Name)
+
+		buildFile, err := project.AddResourceFile(kit, variantGroup)
+		require.NoError(t, err)
+		require.Equal(t, []*PBXBuildFile{buildFile}, kit.AbstractTarget().BuildPhases[3].AbstractBuildPhase().Files)
+
+		// KitTests has no Resources build phase
+		kitTests, _ := project.TargetByName("KitTests")
+		_, err = project.AddResourceFile(kitTests, variantGroup)
+		require.NoError(t, err)
+		buildPhases := kitTests.AbstractTarget().BuildPhases
+		require.Equal(t, 3, len(buildPhases))
+		require.Equal(t, "PBXResourcesBuildPhase", buildPhases[2].ObjectIsa())
+	}
+
+	t.Log("versioned file")
+	{
+		versionGroup, err := project.AddVersionGroup(kitGroup, "Store.xcdatamodeld")
+		require.NoError(t, err)
+		require.Equal(t, "wrapper.xcdatamodel", versionGroup.VersionGroupType)
+
+		first, err := project.AddFileReference(versionGroup, "Store.xcdatamodel", "")
+		require.NoError(t, err)
+		require.Equal(t, first, versionGroup.CurrentVersion)
+
+		second, err := project.AddFileReference(versionGroup, "Store 2.xcdatamodel", "")
+		require.NoError(t, err)
+		require.Equal(t, first, versionGroup.CurrentVersion)
+
+		require.NoError(t, project.SetCurrentVersion(versionGroup, second))
+		require.Equal(t, second, versionGroup.CurrentVersion)
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, "\t\t\t);\n\t\t\tcurrentVersion = "+second.ID+" /* Store 2.xcdatamodel */;\n\t\t\tpath = Store.xcdatamodeld;\n"))
+
+		kitSwift := project.Objects["C4F1B30C2245E0A700D2C8F1"].(*PBXFileReference)
+		require.EqualError(t, project.SetCurrentVersion(versionGroup, kitSwift), "failed to set current version: Kit.swift is not a version of Store.xcdatamodeld")
+
+		_, err = project.AddVersionGroup(kitGroup, "Store.xcdatamodel")
+		require.EqualError(t, err, "failed to add version group: unsupported versioned file: Store.xcdatamodel")
+	}
+}
+
+func TestMoveFileElement(t *testing.T) {
+	project := openTestKitProject(t)
+	kitGroup := project.Objects["C4F1B3092245E0A700D2C8F1"].(*PBXGroup)
+	kitTestsGroup := project.Objects["C4F1B31F2245E0A700D2C8F1"].(*PBXGroup)
+	kitSwift := project.Objects["C4F1B30C2245E0A700D2C8F1"].(*PBXFileReference)
+
+	require.NoError(t, project.MoveFileElement(kitSwift, kitTestsGroup))
+	parent, found := project.ParentGroup(kitSwift)
+	require.Equal(t, true, found)
+	require.Equal(t, Group(kitTestsGroup), parent)
+	require.Equal(t, 4, len(kitGroup.Children))
+
+	pth, err := project.FileElementPath(kitSwift)
+	require.NoError(t, err)
+	require.Equal(t, "/Users/bitrise/Kit/KitTests/Kit.swift", pth)
+
+	subGroup, err := project.AddGroup(kitGroup, "Sub", "")
+	require.NoError(t, err)
+	require.EqualError(t, project.MoveFileElement(kitGroup, subGroup), "failed to move file element: Kit can not be moved into itself")
+	require.EqualError(t, project.MoveFileElement(project.RootObject.MainGroup, kitGroup), "failed to move file element: the main group can not be moved")
+}
+
+func TestRemoveFileElement(t *testing.T) {
+	t.Log("group with source files")
+	{
+		project := openTestKitProject(t)
+		kitGroup := project.Objects["C4F1B3092245E0A700D2C8F1"].(*PBXGroup)
+		require.NoError(t, project.RemoveFileElement(kitGroup))
+
+		for _, id := range []string{
+			"C4F1B3092245E0A700D2C8F1", // Kit group
+			"C4F1B30C2245E0A700D2C8F1", // Kit.swift
+			"C4F1B30D2245E0A700D2C8F1", // Kit.swift in Sources
+			"C4F1B3102245E0A700D2C8F1", // Model.xcdatamodeld
+			"C4F1B3112245E0A700D2C8F1", // Model.xcdatamodel
+			"C4F1B30B2245E0A700D2C8F1", // Kit.h in Headers
+		} {
+			_, found := project.Object(id)
+			require.Equal(t, false, found, id)
+		}
+
+		kit, _ := project.TargetByName("Kit")
+		require.Equal(t, 0, len(kit.AbstractTarget().BuildPhases[1].AbstractBuildPhase().Files))
+		require.Equal(t, 3, len(project.RootObject.MainGroup.Children))
+
+		require.EqualError(t, project.RemoveFileElement(project.RootObject.MainGroup), "failed to remove file element: the main group can not be removed")
+	}
+
+	t.Log("sub-project reference")
+	{
+		project := openTestKitProject(t)
+		subProject := project.Objects["C4F1B3142245E0A700D2C8F1"].(*PBXFileReference)
+		require.NoError(t, project.RemoveFileElement(subProject))
+
+		require.Equal(t, 0, len(project.RootObject.ProjectReferences))
+		subProjects, err := project.SubProjectPaths()
+		require.NoError(t, err)
+		require.Equal(t, []string{}, subProjects)
+
+		for _, id := range []string{
+			"C4F1B3152245E0A700D2C8F1", // Products group of Sub.xcodeproj
+			"C4F1B3162245E0A700D2C8F1", // Sub.framework reference proxy
+			"C4F1B3172245E0A700D2C8F1", // reference proxy container item proxy
+			"C4F1B3182245E0A700D2C8F1", // Sub.framework in Frameworks
+			"C4F1B31A2245E0A700D2C8F1", // Sub.framework in Embed Frameworks
+			"C4F1B3252245E0A700D2C8F1", // target dependency container item proxy
+			"C4F1B3262245E0A700D2C8F1", // target dependency
+		} {
+			_, found := project.Object(id)
+			require.Equal(t, false, found, id)
+		}
+
+		kitTests, _ := project.TargetByName("KitTests")
+		require.Equal(t, 1, len(kitTests.AbstractTarget().Dependencies))
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, "\t\t\tprojectReferences = (\n\t\t\t);\n"))
+
+		_, err = ParseProject(content)
+		require.NoError(t, err)
+	}
+}
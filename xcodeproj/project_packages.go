@@ -0,0 +1,355 @@
+package xcodeproj
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SwiftPackageByName returns the Swift package reference of the project with the given name, like `Alamofire`.
+func (p *Project) SwiftPackageByName(name string) (SwiftPackageReference, bool) {
+	if p.RootObject == nil {
+		return nil, false
+	}
+	for _, reference := range p.RootObject.PackageReferences {
+		if reference.PackageName() == name {
+			return reference, true
+		}
+	}
+	return nil, false
+}
+
+// SwiftPackageProducts returns the products of the package, which the targets of the project depend on, in target order.
+func (p *Project) SwiftPackageProducts(reference SwiftPackageReference) []*XCSwiftPackageProductDependency {
+	products := []*XCSwiftPackageProductDependency{}
+	visited := map[*XCSwiftPackageProductDependency]bool{}
+	for _, target := range p.NativeTargets() {
+		for _, product := range target.PackageProductDependencies {
+			if product.Package == reference && !visited[product] {
+				visited[product] = true
+				products = append(products, product)
+			}
+		}
+	}
+	return products
+}
+
+// SwiftPackageProductTargets returns the targets, which depend on the Swift package product.
+func (p *Project) SwiftPackageProductTargets(product *XCSwiftPackageProductDependency) []*PBXNativeTarget {
+	targets := []*PBXNativeTarget{}
+	for _, target := range p.NativeTargets() {
+		for _, dependency := range target.PackageProductDependencies {
+			if dependency == product {
+				targets = append(targets, target)
+				break
+			}
+		}
+	}
+	return targets
+}
+
+// AddRemoteSwiftPackage adds a Swift package of a git repository to the project.
+func (p *Project) AddRemoteSwiftPackage(repositoryURL string, requirement SwiftPackageRequirement) (*XCRemoteSwiftPackageReference, error) {
+	if p.RootObject == nil {
+		return nil, errors.New("failed to add Swift package: missing root object")
+	}
+	if repositoryURL == "" {
+		return nil, errors.New("failed to add Swift package: empty repository URL")
+	}
+	if err := requirement.validate(); err != nil {
+		return nil, fmt.Errorf("failed to add Swift package: %s", err)
+	}
+	for _, reference := range p.RootObject.PackageReferences {
+		if remote, ok := reference.(*XCRemoteSwiftPackageReference); ok && sameRepositoryURL(remote.RepositoryURL, repositoryURL) {
+			return nil, fmt.Errorf("failed to add Swift package: package already exists: %s", repositoryURL)
+		}
+	}
+
+	id, err := p.generateObjectID("XCRemoteSwiftPackageReference", repositoryURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add Swift package: %s", err)
+	}
+	p.insertObject(id, newRawObject("XCRemoteSwiftPackageReference", map[string]interface{}{
+		"repositoryURL": PlistString{Value: repositoryURL},
+		"requirement":   requirement.encode(),
+	}))
+	if err := p.addPackageReference(id); err != nil {
+		return nil, err
+	}
+	return p.Objects[id].(*XCRemoteSwiftPackageReference), nil
+}
+
+// AddLocalSwiftPackage adds a Swift package on the disk to the project, the path is relative to the project's directory.
+func (p *Project) AddLocalSwiftPackage(relativePath string) (*XCLocalSwiftPackageReference, error) {
+	if p.RootObject == nil {
+		return nil, errors.New("failed to add Swift package: missing root object")
+	}
+	if relativePath == "" {
+		return nil, errors.New("failed to add Swift package: empty path")
+	}
+	for _, reference := range p.RootObject.PackageReferences {
+		if local, ok := reference.(*XCLocalSwiftPackageReference); ok && local.RelativePath == relativePath {
+			return nil, fmt.Errorf("failed to add Swift package: package already exists: %s", relativePath)
+		}
+	}
+
+	id, err := p.generateObjectID("XCLocalSwiftPackageReference", relativePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add Swift package: %s", err)
+	}
+	p.insertObject(id, newRawObject("XCLocalSwiftPackageReference", map[string]interface{}{
+		"relativePath": PlistString{Value: relativePath},
+	}))
+	if err := p.addPackageReference(id); err != nil {
+		return nil, err
+	}
+	return p.Objects[id].(*XCLocalSwiftPackageReference), nil
+}
+
+func (p *Project) addPackageReference(id string) error {
+	if rawProject, found := p.objects.GetDict(p.RootObject.ID); found {
+		appendReference(rawProject, "packageReferences", id)
+	}
+	return p.reload()
+}
+
+// AddSwiftPackageProduct makes the target depend on the product of the Swift package:
+// the product is added to the target's package product dependencies and to its Frameworks build phase.
+// If the target already depends on the product, its existing dependency is returned.
+func (p *Project) AddSwiftPackageProduct(target Target, reference SwiftPackageReference, productName string) (*XCSwiftPackageProductDependency, error) {
+	if err := p.checkTarget(target); err != nil {
+		return nil, fmt.Errorf("failed to add Swift package product: %s", err)
+	}
+	nativeTarget, ok := target.(*PBXNativeTarget)
+	if !ok {
+		return nil, fmt.Errorf("failed to add Swift package product: not a native target: %s", target.AbstractTarget().Name)
+	}
+	if err := p.checkObject(reference); err != nil {
+		return nil, fmt.Errorf("failed to add Swift package product: %s", err)
+	}
+	if productName == "" {
+		return nil, errors.New("failed to add Swift package product: empty product name")
+	}
+	for _, product := range nativeTarget.PackageProductDependencies {
+		if product.Package == reference && product.ProductName == productName {
+			return product, nil
+		}
+	}
+
+	productID, err := p.generateObjectID("XCSwiftPackageProductDependency", nativeTarget.Name+"/"+productName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add Swift package product: %s", err)
+	}
+	p.insertObject(productID, newRawObject("XCSwiftPackageProductDependency", map[string]interface{}{
+		"package":     PlistString{Value: reference.ObjectID()},
+		"productName": PlistString{Value: productName},
+	}))
+	rawTarget, _ := p.objects.GetDict(nativeTarget.ID)
+	appendReference(rawTarget, "packageProductDependencies", productID)
+
+	frameworks := nativeTarget.BuildPhasesOfType("PBXFrameworksBuildPhase")
+	if len(frameworks) == 0 {
+		buildPhase, err := p.addBuildPhase(nativeTarget, "PBXFrameworksBuildPhase", nativeTarget.Name, -1, nil)
+		if err != nil {
+			return nil, err
+		}
+		frameworks = append(frameworks, buildPhase)
+	}
+
+	buildFileID, err := p.generateObjectID("PBXBuildFile", frameworks[0].ObjectID()+"/"+productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add Swift package product: %s", err)
+	}
+	p.insertObject(buildFileID, newRawObject("PBXBuildFile", map[string]interface{}{
+		"productRef": PlistString{Value: productID},
+	}))
+	rawBuildPhase, _ := p.objects.GetDict(frameworks[0].ObjectID())
+	appendReference(rawBuildPhase, "files", buildFileID)
+
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p.Objects[productID].(*XCSwiftPackageProductDependency), nil
+}
+
+// RemoveSwiftPackage removes the Swift package from the project, together with its products and their build files.
+func (p *Project) RemoveSwiftPackage(reference SwiftPackageReference) error {
+	if reference == nil {
+		return errors.New("failed to remove Swift package: missing package")
+	}
+	if err := p.checkObject(reference); err != nil {
+		return fmt.Errorf("failed to remove Swift package: %s", err)
+	}
+
+	ids := []string{reference.ObjectID()}
+	for _, entry := range p.objects.Entries() {
+		if product, ok := p.Objects[entry.Key.Value].(*XCSwiftPackageProductDependency); ok && product.Package == reference {
+			ids = append(ids, product.ID)
+		}
+	}
+
+	p.removeObjects(ids...)
+	return p.reload()
+}
+
+// SetSwiftPackageRequirement replaces the version requirement of the Swift package.
+func (p *Project) SetSwiftPackageRequirement(reference *XCRemoteSwiftPackageReference, requirement SwiftPackageRequirement) error {
+	if reference == nil {
+		return errors.New("failed to set Swift package requirement: missing package")
+	}
+	if err := p.checkObject(reference); err != nil {
+		return fmt.Errorf("failed to set Swift package requirement: %s", err)
+	}
+	if err := requirement.validate(); err != nil {
+		return fmt.Errorf("failed to set Swift package requirement: %s", err)
+	}
+
+	rawReference, _ := p.objects.GetDict(reference.ID)
+	setField(rawReference, "requirement", requirement.encode())
+	return p.reload()
+}
+
+// BumpSwiftPackage sets the version of the Swift package's requirement, keeping its kind:
+// the minimum version of a version range (or an up to next major/minor version requirement), or the exact version.
+// Branch and revision requirements can not be bumped.
+func (p *Project) BumpSwiftPackage(reference *XCRemoteSwiftPackageReference, version string) error {
+	if reference == nil {
+		return errors.New("failed to bump Swift package: missing package")
+	}
+
+	requirement := reference.Requirement
+	switch requirement.Kind {
+	case SwiftPackageRequirementUpToNextMajorVersion, SwiftPackageRequirementUpToNextMinorVersion:
+		requirement.MinimumVersion = version
+	case SwiftPackageRequirementVersionRange:
+		if compareVersions(version, requirement.MaximumVersion) >= 0 {
+			return fmt.Errorf("failed to bump Swift package: version %s is not below the maximum version %s", version, requirement.MaximumVersion)
+		}
+		requirement.MinimumVersion = version
+	case SwiftPackageRequirementExactVersion:
+		requirement.Version = version
+	default:
+		return fmt.Errorf("failed to bump Swift package: %s requirement of %s has no version", requirement.Kind, reference.PackageName())
+	}
+
+	return p.SetSwiftPackageRequirement(reference, requirement)
+}
+
+// String returns the requirement, as shown by Xcode, like `Up to Next Major Version: 5.0.0`.
+func (r SwiftPackageRequirement) String() string {
+	switch r.Kind {
+	case SwiftPackageRequirementUpToNextMajorVersion:
+		return "Up to Next Major Version: " + r.MinimumVersion
+	case SwiftPackageRequirementUpToNextMinorVersion:
+		return "Up to Next Minor Version: " + r.MinimumVersion
+	case SwiftPackageRequirementVersionRange:
+		return fmt.Sprintf("Range: %s - %s", r.MinimumVersion, r.MaximumVersion)
+	case SwiftPackageRequirementExactVersion:
+		return "Exact Version: " + r.Version
+	case SwiftPackageRequirementBranch:
+		return "Branch: " + r.Branch
+	case SwiftPackageRequirementRevision:
+		return "Commit: " + r.Revision
+	}
+	return r.Kind
+}
+
+func (r SwiftPackageRequirement) validate() error {
+	missing := ""
+	switch r.Kind {
+	case SwiftPackageRequirementUpToNextMajorVersion, SwiftPackageRequirementUpToNextMinorVersion:
+		if r.MinimumVersion == "" {
+			missing = "minimum version"
+		}
+	case SwiftPackageRequirementVersionRange:
+		if r.MinimumVersion == "" {
+			missing = "minimum version"
+		} else if r.MaximumVersion == "" {
+			missing = "maximum version"
+		}
+	case SwiftPackageRequirementExactVersion:
+		if r.Version == "" {
+			missing = "version"
+		}
+	case SwiftPackageRequirementBranch:
+		if r.Branch == "" {
+			missing = "branch"
+		}
+	case SwiftPackageRequirementRevision:
+		if r.Revision == "" {
+			missing = "revision"
+		}
+	default:
+		return fmt.Errorf("unsupported requirement kind: %s", r.Kind)
+	}
+
+	if missing != "" {
+		return fmt.Errorf("%s requirement without %s", r.Kind, missing)
+	}
+	return nil
+}
+
+// encode returns the raw requirement dictionary, with the fields of the requirement's kind.
+func (r SwiftPackageRequirement) encode() *PlistDict {
+	fields := map[string]interface{}{
+		"kind": PlistString{Value: r.Kind},
+	}
+	switch r.Kind {
+	case SwiftPackageRequirementUpToNextMajorVersion, SwiftPackageRequirementUpToNextMinorVersion:
+		fields["minimumVersion"] = PlistString{Value: r.MinimumVersion}
+	case SwiftPackageRequirementVersionRange:
+		fields["minimumVersion"] = PlistString{Value: r.MinimumVersion}
+		fields["maximumVersion"] = PlistString{Value: r.MaximumVersion}
+	case SwiftPackageRequirementExactVersion:
+		fields["version"] = PlistString{Value: r.Version}
+	case SwiftPackageRequirementBranch:
+		fields["branch"] = PlistString{Value: r.Branch}
+	case SwiftPackageRequirementRevision:
+		fields["revision"] = PlistString{Value: r.Revision}
+	}
+	return newSortedPlistDict(fields)
+}
+
+// sameRepositoryURL reports whether the URLs refer to the same repository, ignoring case, a trailing slash and `.git`.
+func sameRepositoryURL(a, b string) bool {
+	normalize := func(url string) string {
+		return strings.TrimSuffix(strings.TrimSuffix(strings.ToLower(url), "/"), ".git")
+	}
+	return normalize(a) == normalize(b)
+}
+
+// compareVersions compares dot separated numeric versions, like 5.10.1, missing components count as 0.
+func compareVersions(a, b string) int {
+	aComponents, bComponents := versionComponents(a), versionComponents(b)
+	for i := 0; i < len(aComponents) || i < len(bComponents); i++ {
+		aNumber, bNumber := 0, 0
+		if i < len(aComponents) {
+			aNumber = aComponents[i]
+		}
+		if i < len(bComponents) {
+			bNumber = bComponents[i]
+		}
+		if aNumber != bNumber {
+			if aNumber < bNumber {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// versionComponents returns the numeric components of the version,
+// pre-release and build metadata suffixes (`-beta.1`, `+build`) are ignored.
+func versionComponents(version string) []int {
+	if idx := strings.IndexAny(version, "-+"); idx != -1 {
+		version = version[:idx]
+	}
+	numbers := []int{}
+	for _, component := range strings.Split(version, ".") {
+		number, _ := strconv.Atoi(component)
+		numbers = append(numbers, number)
+	}
+	return numbers
+}
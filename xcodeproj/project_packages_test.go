@@ -0,0 +1,225 @@
+package xcodeproj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// kitPackageReferencesPbxprojContent is the Kit project with the Alamofire (up to next major version)
+// and the swift-collections (branch) remote Swift packages.
+var kitPackageReferencesPbxprojContent = strings.Replace(strings.Replace(kitPackagesPbxprojContent, `/* End XCRemoteSwiftPackageReference section */
+`, `		C4F1B33C2245E0A700D2C8F1 /* XCRemoteSwiftPackageReference "swift-collections" */ = {
+			isa = XCRemoteSwiftPackageReference;
+			repositoryURL = "https://github.com/apple/swift-collections";
+			requirement = {
+				branch = main;
+				kind = branch;
+			};
+		};
+/* End XCRemoteSwiftPackageReference section */
+`, 1), `			mainGroup = C4F1B3002245E0A700D2C8F1;
+`, `			mainGroup = C4F1B3002245E0A700D2C8F1;
+			packageReferences = (
+				C4F1B3352245E0A700D2C8F1 /* XCRemoteSwiftPackageReference "Alamofire" */,
+				C4F1B33C2245E0A700D2C8F1 /* XCRemoteSwiftPackageReference "swift-collections" */,
+			);
+`, 1)
+
+func TestSwiftPackageReferences(t *testing.T) {
+	project, err := ParseProject(kitPackageReferencesPbxprojContent)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, len(project.RootObject.PackageReferences))
+
+	alamofire, found := project.SwiftPackageByName("Alamofire")
+	require.Equal(t, true, found)
+	remote := alamofire.(*XCRemoteSwiftPackageReference)
+	require.Equal(t, "https://github.com/Alamofire/Alamofire.git", remote.RepositoryURL)
+	require.Equal(t, SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: "5.0.0"}, remote.Requirement)
+	require.Equal(t, "Up to Next Major Version: 5.0.0", remote.Requirement.String())
+
+	collections, found := project.SwiftPackageByName("swift-collections")
+	require.Equal(t, true, found)
+	require.Equal(t, SwiftPackageRequirement{Kind: SwiftPackageRequirementBranch, Branch: "main"}, collections.(*XCRemoteSwiftPackageReference).Requirement)
+
+	products := project.SwiftPackageProducts(alamofire)
+	require.Equal(t, 1, len(products))
+	require.Equal(t, "Alamofire", products[0].ProductName)
+	require.Equal(t, alamofire, products[0].Package)
+
+	targets := project.SwiftPackageProductTargets(products[0])
+	require.Equal(t, 1, len(targets))
+	require.Equal(t, "Kit", targets[0].Name)
+
+	require.Equal(t, 0, len(project.SwiftPackageProducts(collections)))
+}
+
+func TestAddSwiftPackage(t *testing.T) {
+	project, err := ParseProject(kitPbxprojContent)
+	require.NoError(t, err)
+	kitTests, _ := project.TargetByName("KitTests")
+
+	t.Log("remote package")
+	{
+		pkg, err := project.AddRemoteSwiftPackage("https://github.com/Quick/Nimble.git", SwiftPackageRequirement{
+			Kind:           SwiftPackageRequirementVersionRange,
+			MinimumVersion: "12.0.0",
+			MaximumVersion: "13.0.0",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []SwiftPackageReference{pkg}, project.RootObject.PackageReferences)
+
+		product, err := project.AddSwiftPackageProduct(kitTests, pkg, "Nimble")
+		require.NoError(t, err)
+		require.Equal(t, []*XCSwiftPackageProductDependency{product}, kitTests.(*PBXNativeTarget).PackageProductDependencies)
+
+		again, err := project.AddSwiftPackageProduct(kitTests, pkg, "Nimble")
+		require.NoError(t, err)
+		require.Equal(t, product, again)
+
+		frameworks := kitTests.AbstractTarget().BuildPhases[1].AbstractBuildPhase().Files
+		buildFile := frameworks[len(frameworks)-1]
+		require.Equal(t, product, buildFile.ProductRef)
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, "\t\t\tmainGroup = C4F1B3002245E0A700D2C8F1;\n\t\t\tpackageReferences = (\n\t\t\t\t"+pkg.ID+" /* XCRemoteSwiftPackageReference \"Nimble\" */,\n\t\t\t);\n\t\t\tproductRefGroup = "))
+		require.Equal(t, true, strings.Contains(content, `/* Begin XCRemoteSwiftPackageReference section */
+		`+pkg.ID+` /* XCRemoteSwiftPackageReference "Nimble" */ = {
+			isa = XCRemoteSwiftPackageReference;
+			repositoryURL = "https://github.com/Quick/Nimble.git";
+			requirement = {
+				kind = versionRange;
+				maximumVersion = 13.0.0;
+				minimumVersion = 12.0.0;
+			};
+		};
+/* End XCRemoteSwiftPackageReference section */
+
+/* Begin XCSwiftPackageProductDependency section */
+		`+product.ID+` /* Nimble */ = {
+			isa = XCSwiftPackageProductDependency;
+			package = `+pkg.ID+` /* XCRemoteSwiftPackageReference "Nimble" */;
+			productName = Nimble;
+		};
+/* End XCSwiftPackageProductDependency section */
+`))
+		require.Equal(t, true, strings.Contains(content, buildFile.ID+" /* Nimble in Frameworks */ = {isa = PBXBuildFile; productRef = "+product.ID+" /* Nimble */; };"))
+
+		_, err = project.AddRemoteSwiftPackage("https://github.com/quick/nimble", SwiftPackageRequirement{Kind: SwiftPackageRequirementBranch, Branch: "main"})
+		require.EqualError(t, err, "failed to add Swift package: package already exists: https://github.com/quick/nimble")
+
+		_, err = project.AddRemoteSwiftPackage("https://github.com/Quick/Quick.git", SwiftPackageRequirement{Kind: SwiftPackageRequirementExactVersion})
+		require.EqualError(t, err, "failed to add Swift package: exactVersion requirement without version")
+
+		_, err = project.AddRemoteSwiftPackage("https://github.com/Quick/Quick.git", SwiftPackageRequirement{Kind: "from"})
+		require.EqualError(t, err, "failed to add Swift package: unsupported requirement kind: from")
+	}
+
+	t.Log("local package")
+	{
+		pkg, err := project.AddLocalSwiftPackage("../Packages/Networking")
+		require.NoError(t, err)
+		require.Equal(t, "Networking", pkg.PackageName())
+		require.Equal(t, 2, len(project.RootObject.PackageReferences))
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, pkg.ID+` /* XCLocalSwiftPackageReference "../Packages/Networking" */ = {
+			isa = XCLocalSwiftPackageReference;
+			relativePath = ../Packages/Networking;
+		};`))
+
+		_, err = project.AddLocalSwiftPackage("../Packages/Networking")
+		require.EqualError(t, err, "failed to add Swift package: package already exists: ../Packages/Networking")
+	}
+
+	t.Log("product of an aggregate target")
+	{
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+		pkg, err := project.AddLocalSwiftPackage("Packages/Lint")
+		require.NoError(t, err)
+		lint, _ := project.TargetByName("Lint")
+		_, err = project.AddSwiftPackageProduct(lint, pkg, "SwiftLintPlugin")
+		require.EqualError(t, err, "failed to add Swift package product: not a native target: Lint")
+	}
+}
+
+func TestSwiftPackageRequirementEditing(t *testing.T) {
+	project, err := ParseProject(kitPackagesPbxprojContent)
+	require.NoError(t, err)
+	pkg := project.Objects["C4F1B3352245E0A700D2C8F1"].(*XCRemoteSwiftPackageReference)
+
+	t.Log("bump")
+	{
+		require.NoError(t, project.BumpSwiftPackage(pkg, "5.9.1"))
+		require.Equal(t, SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: "5.9.1"}, pkg.Requirement)
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, "\t\t\trequirement = {\n\t\t\t\tkind = upToNextMajorVersion;\n\t\t\t\tminimumVersion = 5.9.1;\n\t\t\t};\n"))
+	}
+
+	t.Log("set requirement")
+	{
+		require.NoError(t, project.SetSwiftPackageRequirement(pkg, SwiftPackageRequirement{
+			Kind:           SwiftPackageRequirementVersionRange,
+			MinimumVersion: "5.6.0",
+			MaximumVersion: "5.10.0",
+		}))
+		require.Equal(t, "Range: 5.6.0 - 5.10.0", pkg.Requirement.String())
+
+		require.NoError(t, project.BumpSwiftPackage(pkg, "5.9.0"))
+		require.Equal(t, "5.9.0", pkg.Requirement.MinimumVersion)
+		require.EqualError(t, project.BumpSwiftPackage(pkg, "5.10.0"), "failed to bump Swift package: version 5.10.0 is not below the maximum version 5.10.0")
+
+		require.NoError(t, project.SetSwiftPackageRequirement(pkg, SwiftPackageRequirement{
+			Kind:     SwiftPackageRequirementRevision,
+			Revision: "5c2bfc9ae2a9ba6dd5fdcc1e4e1a2c7a1b6b3a90",
+		}))
+		require.EqualError(t, project.BumpSwiftPackage(pkg, "5.10.0"), "failed to bump Swift package: revision requirement of Alamofire has no version")
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, true, strings.Contains(content, "\t\t\trequirement = {\n\t\t\t\tkind = revision;\n\t\t\t\trevision = 5c2bfc9ae2a9ba6dd5fdcc1e4e1a2c7a1b6b3a90;\n\t\t\t};\n"))
+	}
+}
+
+func TestRemoveSwiftPackage(t *testing.T) {
+	content := strings.Replace(kitPackagesPbxprojContent, `			mainGroup = C4F1B3002245E0A700D2C8F1;
+`, `			mainGroup = C4F1B3002245E0A700D2C8F1;
+			packageReferences = (
+				C4F1B3352245E0A700D2C8F1 /* XCRemoteSwiftPackageReference "Alamofire" */,
+			);
+`, 1)
+	project, err := ParseProject(content)
+	require.NoError(t, err)
+	pkg, _ := project.SwiftPackageByName("Alamofire")
+
+	require.NoError(t, project.RemoveSwiftPackage(pkg))
+	require.Equal(t, 0, len(project.RootObject.PackageReferences))
+	for _, id := range []string{"C4F1B3352245E0A700D2C8F1", "C4F1B3362245E0A700D2C8F1", "C4F1B3372245E0A700D2C8F1"} {
+		_, found := project.Object(id)
+		require.Equal(t, false, found, id)
+	}
+
+	kit, _ := project.TargetByName("Kit")
+	require.Equal(t, 0, len(kit.(*PBXNativeTarget).PackageProductDependencies))
+
+	encoded, err := project.Encode()
+	require.NoError(t, err)
+	require.Equal(t, false, strings.Contains(encoded, "Alamofire"))
+	require.Equal(t, false, strings.Contains(encoded, "XCSwiftPackageProductDependency"))
+
+	require.EqualError(t, project.RemoveSwiftPackage(pkg), "failed to remove Swift package: object not found in project: C4F1B3352245E0A700D2C8F1")
+}
+
+func TestCompareVersions(t *testing.T) {
+	require.Equal(t, 0, compareVersions("5.0.0", "5.0"))
+	require.Equal(t, -1, compareVersions("5.9.1", "5.10.0"))
+	require.Equal(t, 1, compareVersions("6.0.0", "5.10.0"))
+	require.Equal(t, 0, compareVersions("1.2.3-beta.1", "1.2.3"))
+}
@@ -0,0 +1,110 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// Source trees of file elements
+const (
+	SourceTreeGroup            = "<group>"
+	SourceTreeAbsolute         = "<absolute>"
+	SourceTreeSourceRoot       = "SOURCE_ROOT"
+	SourceTreeSDKRoot          = "SDKROOT"
+	SourceTreeDeveloper        = "DEVELOPER_DIR"
+	SourceTreeBuiltProductsDir = "BUILT_PRODUCTS_DIR"
+)
+
+// SourceRoot returns the directory, which the project's SOURCE_ROOT relative paths are resolved against:
+// the directory of the .xcodeproj, joined with the projectDirPath of the root object.
+func (p *Project) SourceRoot() string {
+	sourceRoot := filepath.Dir(p.Path)
+	if p.RootObject != nil && p.RootObject.ProjectDirPath != "" {
+		sourceRoot = filepath.Join(sourceRoot, p.RootObject.ProjectDirPath)
+	}
+	return sourceRoot
+}
+
+// ParentGroup returns the group, which lists the given file element as its child.
+func (p *Project) ParentGroup(element FileElement) (Group, bool) {
+	for _, id := range p.objects.Keys() {
+		group, ok := p.Objects[id].(Group)
+		if !ok {
+			continue
+		}
+
+		for _, child := range group.AbstractGroup().Children {
+			if child.ObjectID() == element.ObjectID() {
+				return group, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// FileElementPath returns the path of the file element, resolved against its parent groups and the project's source root.
+// Paths relative to build settings, like BUILT_PRODUCTS_DIR or SDKROOT, can not be resolved, see ResolveFileElementPath.
+func (p *Project) FileElementPath(element FileElement) (string, error) {
+	return p.ResolveFileElementPath(element, nil)
+}
+
+// ResolveFileElementPath is FileElementPath, which also resolves paths relative to build settings:
+// sourceTrees maps source trees, like BUILT_PRODUCTS_DIR, SDKROOT or DEVELOPER_DIR, to their directories.
+func (p *Project) ResolveFileElementPath(element FileElement, sourceTrees map[string]string) (string, error) {
+	return p.fileElementPath(element, sourceTrees, map[string]bool{})
+}
+
+func (p *Project) fileElementPath(element FileElement, sourceTrees map[string]string, visited map[string]bool) (string, error) {
+	if visited[element.ObjectID()] {
+		return "", fmt.Errorf("failed to resolve path of %s: group cycle", element.ObjectID())
+	}
+	visited[element.ObjectID()] = true
+
+	fileElement := element.AbstractFileElement()
+
+	switch fileElement.SourceTree {
+	case SourceTreeAbsolute:
+		return filepath.Clean(fileElement.Path), nil
+	case SourceTreeSourceRoot:
+		return filepath.Join(p.SourceRoot(), fileElement.Path), nil
+	case SourceTreeGroup:
+		parent, found := p.ParentGroup(element)
+		if !found {
+			// the main group is relative to the source root
+			return filepath.Join(p.SourceRoot(), fileElement.Path), nil
+		}
+
+		parentPth, err := p.fileElementPath(parent, sourceTrees, visited)
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(parentPth, fileElement.Path), nil
+	}
+
+	if dir, found := sourceTrees[fileElement.SourceTree]; found {
+		return filepath.Join(dir, fileElement.Path), nil
+	}
+
+	return "", fmt.Errorf("failed to resolve path of %s: unsupported source tree: %s", element.ObjectID(), fileElement.SourceTree)
+}
+
+// SubProjectPaths returns the paths of the projects, referenced by the project's projectReferences.
+func (p *Project) SubProjectPaths() ([]string, error) {
+	if p.RootObject == nil {
+		return []string{}, nil
+	}
+
+	subProjects := []string{}
+	for _, reference := range p.RootObject.ProjectReferences {
+		if reference.ProjectRef == nil {
+			continue
+		}
+
+		pth, err := p.FileElementPath(reference.ProjectRef)
+		if err != nil {
+			return []string{}, err
+		}
+		subProjects = append(subProjects, pth)
+	}
+	return subProjects, nil
+}
@@ -0,0 +1,70 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileElementPath(t *testing.T) {
+	project, err := ParseProject(kitPbxprojContent)
+	require.NoError(t, err)
+	project.Path = "/Users/bitrise/Kit/Kit.xcodeproj"
+
+	require.Equal(t, "/Users/bitrise/Kit", project.SourceRoot())
+
+	t.Log("group relative paths")
+	{
+		object, found := project.Object("C4F1B30A2245E0A700D2C8F1")
+		require.Equal(t, true, found)
+
+		pth, err := project.FileElementPath(object.(FileElement))
+		require.NoError(t, err)
+		require.Equal(t, "/Users/bitrise/Kit/Kit/Kit.h", pth)
+	}
+
+	t.Log("version group child")
+	{
+		object, found := project.Object("C4F1B3112245E0A700D2C8F1")
+		require.Equal(t, true, found)
+
+		parent, found := project.ParentGroup(object.(FileElement))
+		require.Equal(t, true, found)
+		require.Equal(t, "XCVersionGroup", parent.ObjectIsa())
+
+		pth, err := project.FileElementPath(object.(FileElement))
+		require.NoError(t, err)
+		require.Equal(t, "/Users/bitrise/Kit/Kit/Model.xcdatamodeld/Model.xcdatamodel", pth)
+	}
+
+	t.Log("build setting relative paths are not supported")
+	{
+		object, found := project.Object("C4F1B3022245E0A700D2C8F1")
+		require.Equal(t, true, found)
+
+		_, err := project.FileElementPath(object.(FileElement))
+		require.EqualError(t, err, "failed to resolve path of C4F1B3022245E0A700D2C8F1: unsupported source tree: BUILT_PRODUCTS_DIR")
+	}
+
+	t.Log("build setting relative paths with source trees")
+	{
+		object, found := project.Object("C4F1B3022245E0A700D2C8F1")
+		require.Equal(t, true, found)
+
+		pth, err := project.ResolveFileElementPath(object.(FileElement), map[string]string{
+			SourceTreeBuiltProductsDir: "/Users/bitrise/DerivedData/Build/Products/Debug-iphoneos",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "/Users/bitrise/DerivedData/Build/Products/Debug-iphoneos/Kit.framework", pth)
+
+		_, err = project.ResolveFileElementPath(object.(FileElement), map[string]string{SourceTreeSDKRoot: "/SDKs/iPhoneOS.sdk"})
+		require.EqualError(t, err, "failed to resolve path of C4F1B3022245E0A700D2C8F1: unsupported source tree: BUILT_PRODUCTS_DIR")
+	}
+
+	t.Log("sub-projects")
+	{
+		subProjects, err := project.SubProjectPaths()
+		require.NoError(t, err)
+		require.Equal(t, []string{"/Users/bitrise/Sub/Sub.xcodeproj"}, subProjects)
+	}
+}
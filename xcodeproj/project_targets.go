@@ -0,0 +1,437 @@
+package xcodeproj
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+// defaultBuildConfigurationNames are the build configurations of a new target, if the project has none.
+var defaultBuildConfigurationNames = []string{"Debug", "Release"}
+
+// AddNativeTarget adds a new native target to the project, with a product reference in the Products group,
+// a build configuration for each build configuration of the project and the default build phases of the product type.
+// The build settings are set for every configuration, PRODUCT_NAME defaults to `$(TARGET_NAME)`.
+// Call Save (or Encode) to write the modified project.
+func (p *Project) AddNativeTarget(name string, productType ProductType, buildSettings BuildSettings) (*PBXNativeTarget, error) {
+	if p.RootObject == nil {
+		return nil, errors.New("failed to add target: missing root object")
+	}
+	if name == "" {
+		return nil, errors.New("failed to add target: empty target name")
+	}
+	if _, found := p.TargetByName(name); found {
+		return nil, fmt.Errorf("failed to add target: target already exists: %s", name)
+	}
+	productPth, explicitFileType, ok := productType.productFile(name)
+	if !ok {
+		return nil, fmt.Errorf("failed to add target: unsupported product type: %s", productType)
+	}
+
+	productID, err := p.generateObjectID("PBXFileReference", name+"/"+productPth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add target: %s", err)
+	}
+	p.insertObject(productID, newRawObject("PBXFileReference", map[string]interface{}{
+		"explicitFileType": PlistString{Value: explicitFileType},
+		"includeInIndex":   PlistString{Value: "0"},
+		"path":             PlistString{Value: productPth},
+		"sourceTree":       PlistString{Value: SourceTreeBuiltProductsDir},
+	}))
+	if productRefGroup := p.RootObject.ProductRefGroup; productRefGroup != nil {
+		if rawGroup, found := p.objects.GetDict(productRefGroup.ID); found {
+			appendReference(rawGroup, "children", productID)
+		}
+	}
+
+	settings := BuildSettings{"PRODUCT_NAME": "$(TARGET_NAME)"}
+	for key, value := range buildSettings {
+		settings[key] = value
+	}
+
+	configurationNames := defaultBuildConfigurationNames
+	defaultConfigurationName := "Release"
+	if projectConfigurationList := p.RootObject.BuildConfigurationList; projectConfigurationList != nil && len(projectConfigurationList.BuildConfigurations) > 0 {
+		configurationNames = []string{}
+		for _, configuration := range projectConfigurationList.BuildConfigurations {
+			configurationNames = append(configurationNames, configuration.Name)
+		}
+		if projectConfigurationList.DefaultConfigurationName != "" {
+			defaultConfigurationName = projectConfigurationList.DefaultConfigurationName
+		}
+	}
+
+	configurationIDs := []string{}
+	for _, configurationName := range configurationNames {
+		configurationID, err := p.generateObjectID("XCBuildConfiguration", name+"/"+configurationName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add target: %s", err)
+		}
+		p.insertObject(configurationID, newRawObject("XCBuildConfiguration", map[string]interface{}{
+			"buildSettings": encodeBuildSettings(settings),
+			"name":          PlistString{Value: configurationName},
+		}))
+		configurationIDs = append(configurationIDs, configurationID)
+	}
+
+	configurationListID, err := p.generateObjectID("XCConfigurationList", name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add target: %s", err)
+	}
+	p.insertObject(configurationListID, newRawObject("XCConfigurationList", map[string]interface{}{
+		"buildConfigurations":           plistStrings(configurationIDs...),
+		"defaultConfigurationIsVisible": PlistString{Value: "0"},
+		"defaultConfigurationName":      PlistString{Value: defaultConfigurationName},
+	}))
+
+	buildPhaseIDs := []string{}
+	for _, isa := range productType.defaultBuildPhases() {
+		buildPhaseID, err := p.generateObjectID(isa, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add target: %s", err)
+		}
+		p.insertObject(buildPhaseID, newBuildPhaseObject(isa, nil))
+		buildPhaseIDs = append(buildPhaseIDs, buildPhaseID)
+	}
+
+	targetID, err := p.generateObjectID("PBXNativeTarget", name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add target: %s", err)
+	}
+	p.insertObject(targetID, newRawObject("PBXNativeTarget", map[string]interface{}{
+		"buildConfigurationList": PlistString{Value: configurationListID},
+		"buildPhases":            plistStrings(buildPhaseIDs...),
+		"buildRules":             PlistArray{},
+		"dependencies":           PlistArray{},
+		"name":                   PlistString{Value: name},
+		"productName":            PlistString{Value: name},
+		"productReference":       PlistString{Value: productID},
+		"productType":            PlistString{Value: string(productType)},
+	}))
+	if rawProject, found := p.objects.GetDict(p.RootObject.ID); found {
+		appendReference(rawProject, "targets", targetID)
+	}
+
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+	target, ok := p.Objects[targetID].(*PBXNativeTarget)
+	if !ok {
+		return nil, fmt.Errorf("failed to add target: target not found after adding: %s", name)
+	}
+	return target, nil
+}
+
+// defaultBuildPhases returns the build phases of a new target of the product type, as Xcode creates them.
+func (t ProductType) defaultBuildPhases() []string {
+	switch {
+	case t.IsFramework():
+		return []string{"PBXHeadersBuildPhase", "PBXSourcesBuildPhase", "PBXFrameworksBuildPhase", "PBXResourcesBuildPhase"}
+	case t.IsLibrary(), t == ProductTypeCommandLineTool:
+		return []string{"PBXSourcesBuildPhase", "PBXFrameworksBuildPhase"}
+	}
+	return []string{"PBXSourcesBuildPhase", "PBXFrameworksBuildPhase", "PBXResourcesBuildPhase"}
+}
+
+// AddTargetDependency makes the target depend on an other target of the project.
+func (p *Project) AddTargetDependency(target, dependency Target) error {
+	if p.RootObject == nil {
+		return errors.New("failed to add target dependency: missing root object")
+	}
+	for _, t := range []Target{target, dependency} {
+		if err := p.checkTarget(t); err != nil {
+			return fmt.Errorf("failed to add target dependency: %s", err)
+		}
+	}
+	for _, existing := range target.AbstractTarget().Dependencies {
+		if existing.Target == dependency {
+			return nil
+		}
+	}
+
+	context := target.AbstractTarget().Name + "/" + dependency.AbstractTarget().Name
+	proxyID, err := p.generateObjectID("PBXContainerItemProxy", context)
+	if err != nil {
+		return fmt.Errorf("failed to add target dependency: %s", err)
+	}
+	p.insertObject(proxyID, newRawObject("PBXContainerItemProxy", map[string]interface{}{
+		"containerPortal":      PlistString{Value: p.RootObject.ID},
+		"proxyType":            PlistString{Value: "1"},
+		"remoteGlobalIDString": PlistString{Value: dependency.ObjectID()},
+		"remoteInfo":           PlistString{Value: dependency.AbstractTarget().Name},
+	}))
+
+	dependencyID, err := p.generateObjectID("PBXTargetDependency", context)
+	if err != nil {
+		return fmt.Errorf("failed to add target dependency: %s", err)
+	}
+	p.insertObject(dependencyID, newRawObject("PBXTargetDependency", map[string]interface{}{
+		"target":      PlistString{Value: dependency.ObjectID()},
+		"targetProxy": PlistString{Value: proxyID},
+	}))
+
+	rawTarget, _ := p.objects.GetDict(target.ObjectID())
+	appendReference(rawTarget, "dependencies", dependencyID)
+
+	return p.reload()
+}
+
+// RemoveTarget removes the target with the objects it owns (build phases, build files, build configurations and product reference),
+// and cleans up every reference to them: dependencies on the target, embedded or linked copies of its product
+// and its target attributes.
+func (p *Project) RemoveTarget(target Target) error {
+	if err := p.checkTarget(target); err != nil {
+		return fmt.Errorf("failed to remove target: %s", err)
+	}
+
+	abstractTarget := target.AbstractTarget()
+	ids := []string{target.ObjectID()}
+	for _, buildPhase := range abstractTarget.BuildPhases {
+		ids = append(ids, buildPhase.ObjectID())
+		for _, buildFile := range buildPhase.AbstractBuildPhase().Files {
+			ids = append(ids, buildFile.ID)
+		}
+	}
+	if configurationList := abstractTarget.BuildConfigurationList; configurationList != nil {
+		ids = append(ids, configurationList.ID)
+		for _, configuration := range configurationList.BuildConfigurations {
+			ids = append(ids, configuration.ID)
+		}
+	}
+	for _, dependency := range abstractTarget.Dependencies {
+		ids = append(ids, dependency.ID)
+		if dependency.TargetProxy != nil {
+			ids = append(ids, dependency.TargetProxy.ID)
+		}
+	}
+
+	rawTarget, _ := p.objects.GetDict(target.ObjectID())
+	ids = append(ids, rawTarget.GetStrings("buildRules")...)
+
+	if nativeTarget, ok := target.(*PBXNativeTarget); ok {
+		if nativeTarget.ProductReference != nil {
+			ids = append(ids, nativeTarget.ProductReference.ID)
+		}
+		for _, productDependency := range nativeTarget.PackageProductDependencies {
+			ids = append(ids, productDependency.ID)
+		}
+	}
+
+	p.removeObjects(ids...)
+	return p.reload()
+}
+
+// RenameTarget renames the target and everything named after it: the product name and product reference,
+// a literal PRODUCT_NAME, the TEST_TARGET_NAME and TEST_HOST build settings of the targets testing it
+// and the remote info of the proxies referencing it. Schemes are not part of the project, see RenameProjectTarget.
+func (p *Project) RenameTarget(target Target, name string) error {
+	if err := p.checkTarget(target); err != nil {
+		return fmt.Errorf("failed to rename target: %s", err)
+	}
+	if name == "" {
+		return errors.New("failed to rename target: empty target name")
+	}
+
+	oldName := target.AbstractTarget().Name
+	if name == oldName {
+		return nil
+	}
+	if _, found := p.TargetByName(name); found {
+		return fmt.Errorf("failed to rename target: target already exists: %s", name)
+	}
+
+	// the annotations of these objects contain the target name
+	renamed := map[string]bool{target.ObjectID(): true}
+
+	rawTarget, _ := p.objects.GetDict(target.ObjectID())
+	rawTarget.Set("name", PlistString{Value: name})
+	if productName, _ := rawTarget.GetString("productName"); productName == oldName {
+		rawTarget.Set("productName", PlistString{Value: name})
+	}
+
+	if configurationList := target.AbstractTarget().BuildConfigurationList; configurationList != nil {
+		renamed[configurationList.ID] = true
+		for _, configuration := range configurationList.BuildConfigurations {
+			if productName, _ := configuration.BuildSettings.Value("PRODUCT_NAME"); productName == oldName {
+				p.setBuildSetting(configuration, "PRODUCT_NAME", name)
+			}
+		}
+	}
+
+	oldProduct, newProduct := "", ""
+	var productReference *PBXFileReference
+	if nativeTarget, ok := target.(*PBXNativeTarget); ok && nativeTarget.ProductReference != nil {
+		productReference = nativeTarget.ProductReference
+		renamed[productReference.ID] = true
+
+		rawProduct, _ := p.objects.GetDict(productReference.ID)
+		for _, key := range []string{"name", "path"} {
+			if value, found := rawProduct.GetString(key); found {
+				rawProduct.Set(key, PlistString{Value: renamedProductPath(value, oldName, name)})
+			}
+		}
+		oldProduct = path.Base(productReference.Path)
+		newProduct = path.Base(renamedProductPath(productReference.Path, oldName, name))
+	}
+
+	for _, object := range p.Objects {
+		switch object := object.(type) {
+		case *PBXBuildFile:
+			if productReference != nil && object.FileRef == productReference {
+				renamed[object.ID] = true
+			}
+		case *PBXTargetDependency:
+			if object.Target == target && object.Name == oldName {
+				rawDependency, _ := p.objects.GetDict(object.ID)
+				rawDependency.Set("name", PlistString{Value: name})
+			}
+		case *PBXContainerItemProxy:
+			if object.ContainerPortal == p.RootObject && object.RemoteGlobalIDString == target.ObjectID() {
+				rawProxy, _ := p.objects.GetDict(object.ID)
+				rawProxy.Set("remoteInfo", PlistString{Value: name})
+			}
+		case *XCBuildConfiguration:
+			if testTargetName, _ := object.BuildSettings.Value("TEST_TARGET_NAME"); testTargetName == oldName {
+				p.setBuildSetting(object, "TEST_TARGET_NAME", name)
+			}
+			if testHost, found := object.BuildSettings.Value("TEST_HOST"); found && oldProduct != newProduct {
+				if renamedTestHost := renamedTestHost(testHost, oldProduct, newProduct, oldName, name); renamedTestHost != testHost {
+					p.setBuildSetting(object, "TEST_HOST", renamedTestHost)
+				}
+			}
+		}
+	}
+
+	p.clearAnnotations(renamed)
+	return p.reload()
+}
+
+// RenameProjectTarget renames the target of the project (see Project.RenameTarget), saves the project
+// and updates the buildable references of the project's shared and user schemes in place.
+func RenameProjectTarget(projectPth, oldName, newName string) error {
+	project, err := OpenProject(projectPth)
+	if err != nil {
+		return err
+	}
+
+	target, found := project.TargetByName(oldName)
+	if !found {
+		return fmt.Errorf("failed to rename target: target not found: %s", oldName)
+	}
+	if err := project.RenameTarget(target, newName); err != nil {
+		return err
+	}
+	if err := project.Save(); err != nil {
+		return err
+	}
+
+	buildableName := ""
+	if nativeTarget, ok := target.(*PBXNativeTarget); ok {
+		buildableName = path.Base(project.productPath(nativeTarget))
+	}
+
+	sharedSchemePths, err := ProjectSharedSchemeFilePaths(projectPth)
+	if err != nil {
+		return err
+	}
+	userSchemePths, err := ProjectUserSchemeFilePaths(projectPth)
+	if err != nil {
+		return err
+	}
+
+	match := func(reference BuildableReference) bool {
+		if reference.BlueprintIdentifier != target.ObjectID() {
+			return false
+		}
+		referencedProjectPth, err := referencedContainerPath(reference.ReferencedContainer, projectPth)
+		return err == nil && referencedProjectPth == filepath.Clean(projectPth)
+	}
+
+	for _, schemePth := range append(sharedSchemePths, userSchemePths...) {
+		content, err := fileutil.ReadStringFromFile(schemePth)
+		if err != nil {
+			return err
+		}
+
+		// the scheme is updated in place, re-encoding it would reformat the whole file
+		content, changed, err := updateBuildableReferenceNames(content, match, newName, buildableName)
+		if err != nil {
+			return fmt.Errorf("failed to update %s: %s", schemePth, err)
+		}
+		if changed {
+			if err := fileutil.WriteStringToFile(schemePth, content); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkTarget returns an error if the target is not a target of the project.
+func (p *Project) checkTarget(target Target) error {
+	if target == nil {
+		return errors.New("missing target")
+	}
+	if object, found := p.Objects[target.ObjectID()]; !found || object != target {
+		return fmt.Errorf("target not found in project: %s", target.AbstractTarget().Name)
+	}
+	return nil
+}
+
+// setBuildSetting sets the build setting in the raw build configuration.
+func (p *Project) setBuildSetting(configuration *XCBuildConfiguration, key, value string) {
+	rawConfiguration, found := p.objects.GetDict(configuration.ID)
+	if !found {
+		return
+	}
+	buildSettings, found := rawConfiguration.GetDict("buildSettings")
+	if !found {
+		buildSettings = NewPlistDict()
+		rawConfiguration.Set("buildSettings", buildSettings)
+	}
+	buildSettings.Set(key, PlistString{Value: value})
+}
+
+// renamedProductPath returns the product path with the product name replaced, like `libKit.a` to `libCore.a`.
+// Paths of products, which are not named after the target, are returned unchanged.
+func renamedProductPath(pth, oldName, newName string) string {
+	dir, base := path.Split(pth)
+	ext := path.Ext(base)
+	if base == oldName {
+		ext = ""
+	}
+
+	switch strings.TrimSuffix(base, ext) {
+	case oldName:
+		return dir + newName + ext
+	case "lib" + oldName:
+		return dir + "lib" + newName + ext
+	}
+	return pth
+}
+
+// renamedTestHost returns the TEST_HOST with the renamed application, like
+// `$(BUILT_PRODUCTS_DIR)/SampleApp.app/SampleApp` to `$(BUILT_PRODUCTS_DIR)/Sample.app/Sample`.
+func renamedTestHost(testHost, oldProduct, newProduct, oldName, newName string) string {
+	components := strings.Split(testHost, "/")
+	found := false
+	for i, component := range components {
+		if component == oldProduct {
+			components[i] = newProduct
+			found = true
+		}
+	}
+	if !found {
+		return testHost
+	}
+
+	if last := len(components) - 1; components[last] == oldName {
+		components[last] = newName
+	}
+	return strings.Join(components, "/")
+}
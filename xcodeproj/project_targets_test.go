@@ -0,0 +1,276 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNativeTarget(t *testing.T) {
+	project, err := ParseProject(sampleAppPbxprojContent)
+	require.NoError(t, err)
+
+	t.Log("app extension target")
+	{
+		target, err := project.AddNativeTarget("ShareExtension", ProductTypeAppExtension, BuildSettings{
+			"INFOPLIST_FILE":            "ShareExtension/Info.plist",
+			"LD_RUNPATH_SEARCH_PATHS":   []string{"$(inherited)", "@executable_path/../../Frameworks"},
+			"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.SampleApp.ShareExtension",
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 24, len(target.ID))
+		require.Equal(t, "ShareExtension", target.Name)
+		require.Equal(t, "ShareExtension", target.ProductName)
+		require.Equal(t, ProductTypeAppExtension, target.ProductType)
+		require.Equal(t, "ShareExtension.appex", target.ProductReference.Path)
+		require.Equal(t, SourceTreeBuiltProductsDir, target.ProductReference.SourceTree)
+		require.Equal(t, target.ProductReference, project.RootObject.ProductRefGroup.Children[len(project.RootObject.ProductRefGroup.Children)-1])
+
+		found, ok := project.TargetByName("ShareExtension")
+		require.Equal(t, true, ok)
+		require.Equal(t, target, found)
+		require.Equal(t, 5, len(project.Targets()))
+
+		buildPhaseNames := []string{}
+		for _, buildPhase := range target.BuildPhases {
+			buildPhaseNames = append(buildPhaseNames, buildPhase.AbstractBuildPhase().DisplayName())
+		}
+		require.Equal(t, []string{"Sources", "Frameworks", "Resources"}, buildPhaseNames)
+
+		configurationList := target.BuildConfigurationList
+		require.Equal(t, "Release", configurationList.DefaultConfigurationName)
+		require.Equal(t, 2, len(configurationList.BuildConfigurations))
+		require.Equal(t, "Debug", configurationList.BuildConfigurations[0].Name)
+		require.Equal(t, "Release", configurationList.BuildConfigurations[1].Name)
+		require.Equal(t, BuildSettings{
+			"INFOPLIST_FILE":            "ShareExtension/Info.plist",
+			"LD_RUNPATH_SEARCH_PATHS":   []string{"$(inherited)", "@executable_path/../../Frameworks"},
+			"PRODUCT_BUNDLE_IDENTIFIER": "io.bitrise.SampleApp.ShareExtension",
+			"PRODUCT_NAME":              "$(TARGET_NAME)",
+		}, configurationList.BuildConfigurations[1].BuildSettings)
+
+		sampleApp, _ := project.TargetByName("SampleApp")
+		require.NoError(t, project.AddTargetDependency(sampleApp, target))
+		dependencies := sampleApp.AbstractTarget().Dependencies
+		require.Equal(t, 1, len(dependencies))
+		require.Equal(t, Target(target), dependencies[0].Target)
+		require.Equal(t, "ShareExtension", dependencies[0].TargetProxy.RemoteInfo)
+		require.Equal(t, Object(project.RootObject), dependencies[0].TargetProxy.ContainerPortal)
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		require.Equal(t, 1, strings.Count(content, "/* Begin PBXNativeTarget section */"))
+		require.Equal(t, 1, strings.Count(content, "/* Begin PBXContainerItemProxy section */"))
+		require.Equal(t, true, strings.Contains(content, "/* ShareExtension.appex */ = {isa = PBXFileReference; explicitFileType = \"wrapper.app-extension\"; includeInIndex = 0; path = ShareExtension.appex; sourceTree = BUILT_PRODUCTS_DIR; };"))
+		require.Equal(t, true, strings.Contains(content, target.ID+" /* ShareExtension */ = {\n\t\t\tisa = PBXNativeTarget;\n\t\t\tbuildConfigurationList = "+configurationList.ID+" /* Build configuration list for PBXNativeTarget \"ShareExtension\" */;"))
+		require.Equal(t, true, strings.Contains(content, "\t\t\t\tPRODUCT_NAME = \"$(TARGET_NAME)\";\n"))
+
+		reparsed, err := ParseProject(content)
+		require.NoError(t, err)
+		reparsedTarget, ok := reparsed.Objects[target.ID].(*PBXNativeTarget)
+		require.Equal(t, true, ok)
+		require.Equal(t, "ShareExtension", reparsedTarget.Name)
+		require.Equal(t, 3, len(reparsedTarget.BuildPhases))
+
+		reencoded, err := reparsed.Encode()
+		require.NoError(t, err)
+		require.Equal(t, content, reencoded)
+	}
+
+	t.Log("framework and library targets")
+	{
+		framework, err := project.AddNativeTarget("Kit", ProductTypeFramework, nil)
+		require.NoError(t, err)
+		require.Equal(t, "Kit.framework", framework.ProductReference.Path)
+		require.Equal(t, "PBXHeadersBuildPhase", framework.BuildPhases[0].ObjectIsa())
+		require.Equal(t, 4, len(framework.BuildPhases))
+
+		library, err := project.AddNativeTarget("Core", ProductTypeStaticLibrary, nil)
+		require.NoError(t, err)
+		require.Equal(t, "libCore.a", library.ProductReference.Path)
+		require.Equal(t, 2, len(library.BuildPhases))
+	}
+
+	t.Log("errors")
+	{
+		_, err := project.AddNativeTarget("SampleApp", ProductTypeApplication, nil)
+		require.EqualError(t, err, "failed to add target: target already exists: SampleApp")
+
+		_, err = project.AddNativeTarget("Content", ProductTypeInAppPurchaseContent, nil)
+		require.EqualError(t, err, "failed to add target: unsupported product type: com.apple.product-type.in-app-purchase-content")
+
+		partial, err := ParseProject(strings.Replace(sampleAppPbxprojContent, "rootObject = 8D3E2A012176C1D300A4F1B2", "rootObject = 000000000000000000000000", 1))
+		require.NoError(t, err)
+		_, err = partial.AddNativeTarget("ShareExtension", ProductTypeAppExtension, nil)
+		require.EqualError(t, err, "failed to add target: missing root object")
+	}
+}
+
+func TestRemoveTarget(t *testing.T) {
+	t.Log("build target with dependent test targets")
+	{
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+
+		target, _ := project.TargetByName("SampleApp")
+		require.NoError(t, project.RemoveTarget(target))
+
+		targetNames := []string{}
+		for _, target := range project.Targets() {
+			targetNames = append(targetNames, target.AbstractTarget().Name)
+			require.Equal(t, 0, len(target.AbstractTarget().Dependencies))
+		}
+		require.Equal(t, []string{"SampleAppTests", "SampleAppUITests", "Lint"}, targetNames)
+
+		for _, object := range project.Objects {
+			require.NotEqual(t, "PBXContainerItemProxy", object.ObjectIsa())
+			require.NotEqual(t, "PBXTargetDependency", object.ObjectIsa())
+		}
+		require.Equal(t, 2, len(project.RootObject.ProductRefGroup.Children))
+
+		content, err := project.Encode()
+		require.NoError(t, err)
+		for _, id := range []string{
+			"8D3E2A042176C1D300A4F1B2", // target
+			"8D3E2A022176C1D300A4F1B2", // product
+			"8D3E2A2F2176C1D300A4F1B2", // build configuration list
+			"8D3E2A302176C1D300A4F1B2", // Debug build configuration
+		} {
+			require.Equal(t, false, strings.Contains(content, id), id)
+		}
+		require.Equal(t, false, strings.Contains(content, "TestTargetID"))
+
+		reparsed, err := ParseProject(content)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(reparsed.Targets()))
+	}
+
+	t.Log("test target")
+	{
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+
+		target, _ := project.TargetByName("SampleAppUITests")
+		buildFiles := target.AbstractTarget().BuildPhases[0].AbstractBuildPhase().Files
+		require.Equal(t, 1, len(buildFiles))
+		require.NoError(t, project.RemoveTarget(target))
+
+		require.Equal(t, 3, len(project.Targets()))
+		_, found := project.Object(buildFiles[0].ID)
+		require.Equal(t, false, found)
+		// the source file stays in the project, only its build file is removed
+		_, found = project.Object(buildFiles[0].FileRef.ObjectID())
+		require.Equal(t, true, found)
+
+		testTarget, _ := project.TargetByName("SampleAppTests")
+		require.Equal(t, 1, len(testTarget.AbstractTarget().Dependencies))
+	}
+
+	t.Log("target of an other project")
+	{
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+		other, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+
+		target, _ := other.TargetByName("SampleApp")
+		require.EqualError(t, project.RemoveTarget(target), "failed to remove target: target not found in project: SampleApp")
+	}
+}
+
+func TestRenameTarget(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	project, err := OpenProject(writeSampleAppProject(t, tmpDir, sampleAppPbxprojContent))
+	require.NoError(t, err)
+
+	target, _ := project.TargetByName("SampleApp")
+	require.EqualError(t, project.RenameTarget(target, "SampleAppTests"), "failed to rename target: target already exists: SampleAppTests")
+
+	require.NoError(t, project.RenameTarget(target, "Sample"))
+
+	nativeTarget := target.(*PBXNativeTarget)
+	require.Equal(t, "Sample", nativeTarget.Name)
+	require.Equal(t, "Sample", nativeTarget.ProductName)
+	require.Equal(t, "Sample.app", nativeTarget.ProductReference.Path)
+
+	testTarget, _ := project.TargetByName("SampleAppTests")
+	testHost, _ := testTarget.AbstractTarget().BuildConfigurationList.BuildConfigurations[0].BuildSettings.Value("TEST_HOST")
+	require.Equal(t, "$(BUILT_PRODUCTS_DIR)/Sample.app/Sample", testHost)
+	require.Equal(t, "Sample", testTarget.AbstractTarget().Dependencies[0].TargetProxy.RemoteInfo)
+
+	uiTestTarget, _ := project.TargetByName("SampleAppUITests")
+	testTargetName, _ := uiTestTarget.AbstractTarget().BuildConfigurationList.BuildConfigurations[1].BuildSettings.Value("TEST_TARGET_NAME")
+	require.Equal(t, "Sample", testTargetName)
+
+	require.Equal(t, map[string][]string{
+		"Sample": []string{"SampleAppTests", "SampleAppUITests"},
+	}, project.BuildTargetTestTargets())
+
+	content, err := project.Encode()
+	require.NoError(t, err)
+	require.Equal(t, true, strings.Contains(content, "8D3E2A042176C1D300A4F1B2 /* Sample */ = {"))
+	require.Equal(t, true, strings.Contains(content, "8D3E2A022176C1D300A4F1B2 /* Sample.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = Sample.app; sourceTree = BUILT_PRODUCTS_DIR; };"))
+	require.Equal(t, true, strings.Contains(content, "/* Build configuration list for PBXNativeTarget \"Sample\" */"))
+	require.Equal(t, false, strings.Contains(content, "SampleApp.app"))
+	require.Equal(t, false, strings.Contains(content, "remoteInfo = SampleApp;"))
+}
+
+func TestRenameProjectTarget(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	projectPth := writeSampleAppProject(t, tmpDir, sampleAppPbxprojContent)
+	project, err := OpenProject(projectPth)
+	require.NoError(t, err)
+
+	buildTargets, testTargetsByBuildTarget := projectBuildTargetTestTargets(project)
+	scheme := targetScheme(project, buildTargets[0], testTargetsByBuildTarget[buildTargets[0].ID])
+	scheme.Path = filepath.Join(projectPth, "xcshareddata", "xcschemes", "SampleApp.xcscheme")
+	require.NoError(t, os.MkdirAll(filepath.Dir(scheme.Path), 0755))
+	require.NoError(t, scheme.Save())
+	watchSchemePth := filepath.Join(projectPth, "xcshareddata", "xcschemes", "SampleApp WatchKit App.xcscheme")
+	require.NoError(t, ioutil.WriteFile(watchSchemePth, []byte(watchAppSchemeContent), 0644))
+
+	require.EqualError(t, RenameProjectTarget(projectPth, "Missing", "Sample"), "failed to rename target: target not found: Missing")
+	require.NoError(t, RenameProjectTarget(projectPth, "SampleApp", "Sample"))
+
+	targets, err := ProjectTargets(projectPth)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"Sample": true}, targets)
+
+	scheme, err = OpenScheme(scheme.Path)
+	require.NoError(t, err)
+	for _, reference := range scheme.BuildableReferences() {
+		if reference.BlueprintIdentifier == "8D3E2A042176C1D300A4F1B2" {
+			require.Equal(t, "Sample", reference.BlueprintName)
+			require.Equal(t, "Sample.app", reference.BuildableName)
+		} else {
+			require.Equal(t, true, strings.HasPrefix(reference.BlueprintName, "SampleAppTests") || strings.HasPrefix(reference.BlueprintName, "SampleAppUITests"))
+		}
+	}
+	require.Equal(t, "Sample", scheme.LaunchAction.BuildableProductRunnable.BuildableReference.BlueprintName)
+
+	t.Log("schemes are updated in place")
+	{
+		expected := strings.Replace(watchAppSchemeContent, `BuildableName = "SampleApp.app"`, `BuildableName = "Sample.app"`, -1)
+		expected = strings.Replace(expected, "BlueprintName = \"SampleApp\"\n", "BlueprintName = \"Sample\"\n", -1)
+		require.NotEqual(t, watchAppSchemeContent, expected)
+
+		content, err := ioutil.ReadFile(watchSchemePth)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(content))
+	}
+}
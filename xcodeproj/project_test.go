@@ -0,0 +1,206 @@
+package xcodeproj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProjectTargetDependencies(t *testing.T) {
+	project, err := ParseProject(pbxTargetDependencies)
+	require.NoError(t, err)
+	require.Nil(t, project.RootObject)
+
+	object, found := project.Object("BAAFFEEF19EE788800F3AC91")
+	require.Equal(t, true, found)
+
+	dependency, ok := object.(*PBXTargetDependency)
+	require.Equal(t, true, ok)
+	require.Equal(t, "BAAFFEEF19EE788800F3AC91", dependency.ID)
+	require.Equal(t, "PBXTargetDependency", dependency.Isa)
+
+	// neither the target, nor the proxy is part of the content
+	require.Nil(t, dependency.Target)
+	require.Nil(t, dependency.TargetProxy)
+}
+
+func TestParseProjectNativeTargets(t *testing.T) {
+	project, err := ParseProject(pbxNativeTargetSectionWithSpace)
+	require.NoError(t, err)
+
+	targets := project.NativeTargets()
+	require.Equal(t, 2, len(targets))
+
+	{
+		target := targets[0]
+		require.Equal(t, "BADDF9E61A703F87004C3526", target.ID)
+		require.Equal(t, "PBXNativeTarget", target.Isa)
+		require.Equal(t, "BitriseSampleAppsiOS With Spaces", target.Name)
+		require.Equal(t, "BitriseSampleAppsiOS With Spaces", target.ProductName)
+		require.Equal(t, ProductTypeApplication, target.ProductType)
+		require.Equal(t, 0, len(target.Dependencies))
+		require.Equal(t, "BitriseSampleAppsiOS With Spaces.app", project.productPath(target))
+	}
+
+	{
+		target := targets[1]
+		require.Equal(t, "BADDFA021A703F87004C3526", target.ID)
+		require.Equal(t, "PBXNativeTarget", target.Isa)
+		require.Equal(t, "BitriseSampleAppsiOS With SpacesTests", target.Name)
+		require.Equal(t, ProductTypeUnitTestBundle, target.ProductType)
+		require.Equal(t, "BitriseSampleAppsiOS With SpacesTests.xctest", project.productPath(target))
+
+		require.Equal(t, 1, len(target.Dependencies))
+		dependency := target.Dependencies[0]
+		require.Equal(t, "BADDFA051A703F87004C3526", dependency.ID)
+		require.Equal(t, Target(targets[0]), dependency.Target)
+	}
+}
+
+func TestParseProject(t *testing.T) {
+	project, err := ParseProject(sampleAppPbxprojContent)
+	require.NoError(t, err)
+
+	require.Equal(t, "1", project.ArchiveVersion)
+	require.Equal(t, "50", project.ObjectVersion)
+
+	t.Log("root object")
+	{
+		root := project.RootObject
+		require.NotNil(t, root)
+		require.Equal(t, "8D3E2A012176C1D300A4F1B2", root.ID)
+		require.Equal(t, "Xcode 9.3", root.CompatibilityVersion)
+		require.Equal(t, []string{"en", "Base"}, root.KnownRegions)
+		require.Equal(t, "8D3E2A002176C1D300A4F1B2", root.MainGroup.ID)
+		require.Equal(t, "Products", root.ProductRefGroup.Name)
+		require.Equal(t, 2, len(root.BuildConfigurationList.BuildConfigurations))
+		require.Equal(t, "Release", root.BuildConfigurationList.DefaultConfigurationName)
+		require.Equal(t, 4, len(root.Targets))
+	}
+
+	t.Log("targets")
+	{
+		names := []string{}
+		for _, target := range project.Targets() {
+			names = append(names, target.AbstractTarget().Name)
+		}
+		require.Equal(t, []string{"SampleApp", "SampleAppTests", "SampleAppUITests", "Lint"}, names)
+
+		target, found := project.TargetByName("Lint")
+		require.Equal(t, true, found)
+		aggregateTarget, ok := target.(*PBXAggregateTarget)
+		require.Equal(t, true, ok)
+		require.Equal(t, 1, len(aggregateTarget.BuildPhases))
+
+		_, found = project.TargetByName("Missing")
+		require.Equal(t, false, found)
+	}
+
+	t.Log("native target")
+	{
+		target, found := project.TargetByName("SampleAppUITests")
+		require.Equal(t, true, found)
+
+		nativeTarget, ok := target.(*PBXNativeTarget)
+		require.Equal(t, true, ok)
+		require.Equal(t, ProductTypeUITestBundle, nativeTarget.ProductType)
+		require.Equal(t, "SampleAppUITests.xctest", nativeTarget.ProductReference.Path)
+		require.Equal(t, "BUILT_PRODUCTS_DIR", nativeTarget.ProductReference.SourceTree)
+
+		require.Equal(t, 1, len(nativeTarget.Dependencies))
+		dependency := nativeTarget.Dependencies[0]
+		require.Equal(t, "SampleApp", dependency.Target.AbstractTarget().Name)
+		require.Equal(t, Object(project.RootObject), dependency.TargetProxy.ContainerPortal)
+		require.Equal(t, "1", dependency.TargetProxy.ProxyType)
+		require.Equal(t, "8D3E2A042176C1D300A4F1B2", dependency.TargetProxy.RemoteGlobalIDString)
+
+		buildConfiguration, found := nativeTarget.BuildConfigurationList.BuildConfiguration("Debug")
+		require.Equal(t, true, found)
+		testTargetName, found := buildConfiguration.BuildSettings.Value("TEST_TARGET_NAME")
+		require.Equal(t, true, found)
+		require.Equal(t, "SampleApp", testTargetName)
+		require.Equal(t, []string{"$(inherited)", "@executable_path/Frameworks", "@loader_path/Frameworks"}, buildConfiguration.BuildSettings["LD_RUNPATH_SEARCH_PATHS"])
+	}
+
+	t.Log("build phases")
+	{
+		target, found := project.TargetByName("SampleApp")
+		require.Equal(t, true, found)
+
+		buildPhases := target.AbstractTarget().BuildPhases
+		require.Equal(t, 4, len(buildPhases))
+
+		names := []string{}
+		for _, buildPhase := range buildPhases {
+			names = append(names, buildPhase.AbstractBuildPhase().DisplayName())
+		}
+		require.Equal(t, []string{"Generate Version", "Sources", "Frameworks", "Resources"}, names)
+
+		scriptPhase, ok := buildPhases[0].(*PBXShellScriptBuildPhase)
+		require.Equal(t, true, ok)
+		require.Equal(t, "/bin/bash", scriptPhase.ShellPath)
+		require.Equal(t, []string{"$(SRCROOT)/SampleApp/Info.plist"}, scriptPhase.InputPaths)
+		require.Equal(t, []string{"$(DERIVED_FILE_DIR)/Version.swift"}, scriptPhase.OutputPaths)
+		require.Equal(t, true, scriptPhase.ShowEnvVarsInLog)
+
+		sourcesPhase, ok := buildPhases[1].(*PBXSourcesBuildPhase)
+		require.Equal(t, true, ok)
+		require.Equal(t, 2, len(sourcesPhase.Files))
+		require.Equal(t, "ViewController.swift", sourcesPhase.Files[0].FileRef.AbstractFileElement().DisplayName())
+
+		resourcesPhase, ok := buildPhases[3].(*PBXResourcesBuildPhase)
+		require.Equal(t, true, ok)
+		_, ok = resourcesPhase.Files[0].FileRef.(*PBXVariantGroup)
+		require.Equal(t, true, ok)
+	}
+
+	t.Log("groups")
+	{
+		mainGroup := project.RootObject.MainGroup
+		require.Equal(t, 5, len(mainGroup.Children))
+		require.Equal(t, "", mainGroup.DisplayName())
+
+		group, ok := mainGroup.Children[1].(*PBXGroup)
+		require.Equal(t, true, ok)
+		require.Equal(t, "SampleApp", group.Path)
+
+		variantGroup, ok := group.Children[2].(*PBXVariantGroup)
+		require.Equal(t, true, ok)
+		require.Equal(t, "Main.storyboard", variantGroup.DisplayName())
+		require.Equal(t, "Base.lproj/Main.storyboard", variantGroup.Children[0].AbstractFileElement().Path)
+	}
+
+	t.Log("build configurations")
+	{
+		buildConfiguration, found := project.RootObject.BuildConfigurationList.BuildConfiguration("Debug")
+		require.Equal(t, true, found)
+		require.Equal(t, "Base.xcconfig", buildConfiguration.BaseConfigurationReference.Path)
+
+		sdkroot, found := buildConfiguration.BuildSettings.Value("SDKROOT")
+		require.Equal(t, true, found)
+		require.Equal(t, "iphoneos", sdkroot)
+
+		_, found = buildConfiguration.BuildSettings.Value("GCC_PREPROCESSOR_DEFINITIONS")
+		require.Equal(t, false, found)
+	}
+}
+
+func TestParseProjectErrors(t *testing.T) {
+	t.Log("syntax error")
+	{
+		_, err := ParseProject("{\n\tobjects = {\n\t};\n\trootObject = ;\n}")
+		require.EqualError(t, err, "plist syntax error at line 4, column 15: unexpected ';', expected a value")
+	}
+
+	t.Log("missing isa")
+	{
+		_, err := ParseProject("{ objects = { 8D3E2A012176C1D300A4F1B2 = { name = a; }; }; }")
+		require.EqualError(t, err, "invalid object (8D3E2A012176C1D300A4F1B2): missing isa")
+	}
+
+	t.Log("invalid root object")
+	{
+		_, err := ParseProject("{ objects = { 8D3E2A012176C1D300A4F1B2 = { isa = PBXGroup; }; }; rootObject = 8D3E2A012176C1D300A4F1B2; }")
+		require.EqualError(t, err, "rootObject (8D3E2A012176C1D300A4F1B2) is not a PBXProject")
+	}
+}
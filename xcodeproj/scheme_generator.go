@@ -0,0 +1,205 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+)
+
+const defaultSchemeLastUpgradeVersion = "0830"
+
+// projectBuildTargetTestTargets returns the non test native targets of the project
+// and maps their IDs to the test targets, which depend on them.
+func projectBuildTargetTestTargets(project *Project) ([]*PBXNativeTarget, map[string][]*PBXNativeTarget) {
+	buildTargets := []*PBXNativeTarget{}
+	testTargets := []*PBXNativeTarget{}
+	for _, target := range project.NativeTargets() {
+		if target.ProductType.IsTest() {
+			testTargets = append(testTargets, target)
+		} else {
+			buildTargets = append(buildTargets, target)
+		}
+	}
+
+	testTargetsByBuildTarget := map[string][]*PBXNativeTarget{}
+	for _, buildTarget := range buildTargets {
+		testTargetsByBuildTarget[buildTarget.ID] = []*PBXNativeTarget{}
+	}
+
+	for _, testTarget := range testTargets {
+		for _, dependency := range testTarget.Dependencies {
+			dependentTargetID := dependencyTargetID(project, dependency)
+			if _, isBuildTarget := testTargetsByBuildTarget[dependentTargetID]; isBuildTarget {
+				testTargetsByBuildTarget[dependentTargetID] = appendTargetIfMissing(testTargetsByBuildTarget[dependentTargetID], testTarget)
+			}
+		}
+	}
+
+	return buildTargets, testTargetsByBuildTarget
+}
+
+// dependencyTargetID returns the ID of the project's target, which the dependency refers to,
+// falling back to the target proxy if the dependency has no target.
+func dependencyTargetID(project *Project, dependency *PBXTargetDependency) string {
+	if dependency.Target != nil {
+		return dependency.Target.ObjectID()
+	}
+
+	proxy := dependency.TargetProxy
+	if proxy == nil || proxy.ContainerPortal == nil || project.RootObject == nil {
+		return ""
+	}
+	if proxy.ContainerPortal.ObjectID() != project.RootObject.ID {
+		// the target belongs to an other project
+		return ""
+	}
+	return proxy.RemoteGlobalIDString
+}
+
+func schemeBuildableReference(project *Project, target *PBXNativeTarget) BuildableReference {
+	return BuildableReference{
+		BuildableIdentifier: "primary",
+		BlueprintIdentifier: target.ID,
+		BuildableName:       project.productPath(target),
+		BlueprintName:       target.Name,
+		ReferencedContainer: "container:" + filepath.Base(project.Path),
+	}
+}
+
+func projectLastUpgradeCheck(project *Project) string {
+	if project.RootObject != nil {
+		if raw, found := project.objects.GetDict(project.RootObject.ID); found {
+			if attributes, found := raw.GetDict("attributes"); found {
+				if lastUpgradeCheck, found := attributes.GetString("LastUpgradeCheck"); found {
+					return lastUpgradeCheck
+				}
+			}
+		}
+	}
+	return defaultSchemeLastUpgradeVersion
+}
+
+// targetScheme returns the scheme, which builds and launches the build target and runs the test targets.
+func targetScheme(project *Project, buildTarget *PBXNativeTarget, testTargets []*PBXNativeTarget) *XCScheme {
+	buildableReference := schemeBuildableReference(project, buildTarget)
+
+	testables := []TestableReference{}
+	for _, testTarget := range testTargets {
+		testables = append(testables, TestableReference{
+			Skipped:            "NO",
+			BuildableReference: schemeBuildableReference(project, testTarget),
+		})
+	}
+
+	return &XCScheme{
+		LastUpgradeVersion: projectLastUpgradeCheck(project),
+		Version:            "1.3",
+		BuildAction: &BuildAction{
+			ParallelizeBuildables:     "YES",
+			BuildImplicitDependencies: "YES",
+			BuildActionEntries: []BuildActionEntry{
+				{
+					BuildForTesting:    "YES",
+					BuildForRunning:    "YES",
+					BuildForProfiling:  "YES",
+					BuildForArchiving:  "YES",
+					BuildForAnalyzing:  "YES",
+					BuildableReference: buildableReference,
+				},
+			},
+		},
+		TestAction: &TestAction{
+			BuildConfiguration:           "Debug",
+			SelectedDebuggerIdentifier:   "Xcode.DebuggerFoundation.Debugger.LLDB",
+			SelectedLauncherIdentifier:   "Xcode.DebuggerFoundation.Launcher.LLDB",
+			ShouldUseLaunchSchemeArgsEnv: "YES",
+			Testables:                    testables,
+			MacroExpansion:               &MacroExpansion{BuildableReference: buildableReference},
+			AdditionalOptions:            &AdditionalOptions{},
+		},
+		LaunchAction: &LaunchAction{
+			BuildConfiguration:             "Debug",
+			SelectedDebuggerIdentifier:     "Xcode.DebuggerFoundation.Debugger.LLDB",
+			SelectedLauncherIdentifier:     "Xcode.DebuggerFoundation.Launcher.LLDB",
+			LaunchStyle:                    "0",
+			UseCustomWorkingDirectory:      "NO",
+			IgnoresPersistentStateOnLaunch: "NO",
+			DebugDocumentVersioning:        "YES",
+			DebugServiceExtension:          "internal",
+			AllowLocationSimulation:        "YES",
+			BuildableProductRunnable:       &BuildableProductRunnable{RunnableDebuggingMode: "0", BuildableReference: buildableReference},
+			AdditionalOptions:              &AdditionalOptions{},
+		},
+		ProfileAction: &ProfileAction{
+			BuildConfiguration:           "Release",
+			ShouldUseLaunchSchemeArgsEnv: "YES",
+			UseCustomWorkingDirectory:    "NO",
+			DebugDocumentVersioning:      "YES",
+			BuildableProductRunnable:     &BuildableProductRunnable{RunnableDebuggingMode: "0", BuildableReference: buildableReference},
+		},
+		AnalyzeAction: &AnalyzeAction{
+			BuildConfiguration: "Debug",
+		},
+		ArchiveAction: &ArchiveAction{
+			BuildConfiguration:       "Release",
+			RevealArchiveInOrganizer: "YES",
+		},
+	}
+}
+
+// projectUserSchemes returns a scheme for every build target of the project, mapped by the scheme (target) name.
+// The schemes launch and build the target, and run its test targets.
+// A project without build targets (only test or aggregate targets) has no schemes.
+func projectUserSchemes(project *Project) map[string]*XCScheme {
+	buildTargets, testTargetsByBuildTarget := projectBuildTargetTestTargets(project)
+
+	schemes := map[string]*XCScheme{}
+	for _, buildTarget := range buildTargets {
+		schemes[buildTarget.Name] = targetScheme(project, buildTarget, testTargetsByBuildTarget[buildTarget.ID])
+	}
+	return schemes
+}
+
+func currentUserName() (string, error) {
+	if userName := os.Getenv("USER"); userName != "" {
+		return userName, nil
+	}
+
+	currentUser, err := user.Current()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine current user: %s", err)
+	}
+	return currentUser.Username, nil
+}
+
+// ReCreateProjectUserSchemes generates a user scheme for every build target of the project
+// into the project's xcuserdata/<USER>.xcuserdatad/xcschemes directory.
+// The scheme launches and builds the target, and runs the test targets, which depend on it.
+func ReCreateProjectUserSchemes(projectPth string) error {
+	project, err := OpenProject(projectPth)
+	if err != nil {
+		return err
+	}
+
+	schemes := projectUserSchemes(project)
+
+	userName, err := currentUserName()
+	if err != nil {
+		return err
+	}
+
+	schemesDir := filepath.Join(projectPth, "xcuserdata", userName+".xcuserdatad", "xcschemes")
+	if err := os.MkdirAll(schemesDir, 0755); err != nil {
+		return err
+	}
+
+	for name, scheme := range schemes {
+		scheme.Path = filepath.Join(schemesDir, name+XCSchemeExt)
+		if err := scheme.Save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectBuildTargetTestTargets(t *testing.T) {
+	project, err := ParseProject(sampleAppPbxprojContent)
+	require.NoError(t, err)
+
+	buildTargets, testTargetsByBuildTarget := projectBuildTargetTestTargets(project)
+	require.Equal(t, 1, len(buildTargets))
+	require.Equal(t, "SampleApp", buildTargets[0].Name)
+
+	testTargets := testTargetsByBuildTarget[buildTargets[0].ID]
+	require.Equal(t, 2, len(testTargets))
+	require.Equal(t, "SampleAppTests", testTargets[0].Name)
+	require.Equal(t, "SampleAppUITests", testTargets[1].Name)
+}
+
+func TestProjectUserSchemes(t *testing.T) {
+	t.Log("scheme per build target")
+	{
+		project, err := ParseProject(sampleAppPbxprojContent)
+		require.NoError(t, err)
+		project.Path = "/Users/bitrise/SampleApp.xcodeproj"
+
+		schemes := projectUserSchemes(project)
+		require.Equal(t, 1, len(schemes))
+		require.Equal(t, sampleAppSchemeContent, schemes["SampleApp"].Encode())
+	}
+
+	t.Log("no build target")
+	{
+		project, err := ParseProject(pbxTargetDependencies)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]*XCScheme{}, projectUserSchemes(project))
+	}
+}
+
+func TestReCreateProjectUserSchemes(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	projectPth := filepath.Join(tmpDir, "SampleApp.xcodeproj")
+	require.NoError(t, os.MkdirAll(projectPth, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(projectPth, "project.pbxproj"), []byte(sampleAppPbxprojContent), 0644))
+
+	origUser := os.Getenv("USER")
+	require.NoError(t, os.Setenv("USER", "bitrise"))
+	defer func() {
+		require.NoError(t, os.Setenv("USER", origUser))
+	}()
+
+	require.NoError(t, ReCreateProjectUserSchemes(projectPth))
+
+	content, err := ioutil.ReadFile(filepath.Join(projectPth, "xcuserdata", "bitrise.xcuserdatad", "xcschemes", "SampleApp.xcscheme"))
+	require.NoError(t, err)
+	require.Equal(t, sampleAppSchemeContent, string(content))
+}
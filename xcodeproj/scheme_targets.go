@@ -0,0 +1,227 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// SchemeTarget is a native target referenced by a scheme, with the project, which contains it.
+type SchemeTarget struct {
+	Project *Project
+	Target  *PBXNativeTarget
+}
+
+// SchemeTargets is a scheme with the targets of its actions resolved.
+type SchemeTargets struct {
+	Scheme *XCScheme
+	// Container is the path of the .xcodeproj or .xcworkspace, which contains the scheme.
+	Container string
+	IsShared  bool
+
+	// Build lists the targets of the BuildAction.
+	Build []SchemeTarget
+	// Test lists the targets of the not skipped testables of the TestAction.
+	Test []SchemeTarget
+	// Launch is the runnable target of the LaunchAction.
+	Launch *SchemeTarget
+	// Profile is the runnable target of the ProfileAction.
+	Profile *SchemeTarget
+	// Archive lists the targets of the BuildAction, which are built for archiving.
+	Archive []SchemeTarget
+
+	// Unresolved lists the buildable references, which target was not found, like the ones into a missing project.
+	// References into other containers than projects (like Swift packages) are neither resolved nor listed.
+	Unresolved []BuildableReference
+}
+
+// ProjectSchemeTargets returns the shared and user schemes of the project, with their targets resolved.
+func ProjectSchemeTargets(projectPth string) ([]SchemeTargets, error) {
+	return containersSchemeTargets([]string{projectPth})
+}
+
+// WorkspaceSchemeTargets returns the shared and user schemes of the workspace and its projects, with their targets resolved.
+func WorkspaceSchemeTargets(workspacePth string) ([]SchemeTargets, error) {
+	return WorkspaceSchemeTargetsWithOptions(workspacePth, WorkspaceListOptions{})
+}
+
+// WorkspaceSchemeTargetsWithOptions is WorkspaceSchemeTargets over the containers selected by the options.
+func WorkspaceSchemeTargetsWithOptions(workspacePth string, opts WorkspaceListOptions) ([]SchemeTargets, error) {
+	workspaces, projects, err := workspaceContainers(workspacePth, opts)
+	if err != nil {
+		return nil, err
+	}
+	return containersSchemeTargets(append(workspaces, projects...))
+}
+
+// SchemesForTarget returns the schemes, which launch or archive the named target.
+func SchemesForTarget(schemes []SchemeTargets, targetName string) []SchemeTargets {
+	filtered := []SchemeTargets{}
+	for _, scheme := range schemes {
+		matches := scheme.Launch != nil && scheme.Launch.Target.Name == targetName
+		for _, target := range scheme.Archive {
+			if target.Target.Name == targetName {
+				matches = true
+			}
+		}
+
+		if matches {
+			filtered = append(filtered, scheme)
+		}
+	}
+	return filtered
+}
+
+func containersSchemeTargets(containers []string) ([]SchemeTargets, error) {
+	resolver := newSchemeTargetResolver()
+
+	schemeTargets := []SchemeTargets{}
+	for _, container := range containers {
+		sharedSchemePaths, err := sharedSchemeFilePaths(container)
+		if err != nil {
+			return nil, err
+		}
+		userSchemePaths, err := userSchemeFilePaths(container)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, schemePth := range append(sharedSchemePaths, userSchemePaths...) {
+			scheme, err := OpenScheme(schemePth)
+			if err != nil {
+				return nil, err
+			}
+
+			targets := resolver.schemeTargets(scheme, container)
+			targets.IsShared = isSharedSchemeFilePath(schemePth)
+
+			schemeTargets = append(schemeTargets, targets)
+		}
+	}
+
+	return schemeTargets, nil
+}
+
+// schemeTargetResolver resolves buildable references, opening each referenced project once.
+type schemeTargetResolver struct {
+	projects map[string]*Project
+}
+
+func newSchemeTargetResolver() *schemeTargetResolver {
+	return &schemeTargetResolver{projects: map[string]*Project{}}
+}
+
+// referencedContainerPath returns the path of a ReferencedContainer, like `container:SampleApp.xcodeproj`,
+// which is relative to the directory of the scheme's container.
+func referencedContainerPath(referencedContainer, container string) (string, error) {
+	location, err := parseWorkspaceLocation(referencedContainer)
+	if err != nil {
+		return "", err
+	}
+
+	switch location.Type {
+	case WorkspaceLocationContainer:
+		return filepath.Join(filepath.Dir(container), location.Path), nil
+	case WorkspaceLocationAbsolute:
+		return filepath.Clean(location.Path), nil
+	}
+	return "", fmt.Errorf("invalid location (%s): unsupported location type", referencedContainer)
+}
+
+func (r *schemeTargetResolver) project(pth string) (*Project, error) {
+	if project, found := r.projects[pth]; found {
+		return project, nil
+	}
+
+	project, err := OpenProject(pth)
+	if err != nil {
+		return nil, err
+	}
+	r.projects[pth] = project
+	return project, nil
+}
+
+// target returns the native target of the buildable reference, or nil if the referenced project can not be opened
+// (like a not installed Pods project) or has no such target.
+func (r *schemeTargetResolver) target(reference BuildableReference, container string) *SchemeTarget {
+	projectPth, err := referencedContainerPath(reference.ReferencedContainer, container)
+	if err != nil {
+		return nil
+	}
+
+	project, err := r.project(projectPth)
+	if err != nil {
+		return nil
+	}
+
+	target, ok := project.Objects[reference.BlueprintIdentifier].(*PBXNativeTarget)
+	if !ok {
+		return nil
+	}
+	return &SchemeTarget{Project: project, Target: target}
+}
+
+func (r *schemeTargetResolver) schemeTargets(scheme *XCScheme, container string) SchemeTargets {
+	schemeTargets := SchemeTargets{
+		Scheme:     scheme,
+		Container:  container,
+		Build:      []SchemeTarget{},
+		Test:       []SchemeTarget{},
+		Archive:    []SchemeTarget{},
+		Unresolved: []BuildableReference{},
+	}
+
+	resolve := func(reference BuildableReference) *SchemeTarget {
+		if filepath.Ext(reference.ReferencedContainer) != ".xcodeproj" {
+			// like `container:MyPackage`, Swift package products are not native targets
+			return nil
+		}
+
+		target := r.target(reference, container)
+		if target == nil {
+			for _, unresolved := range schemeTargets.Unresolved {
+				if unresolved.BlueprintIdentifier == reference.BlueprintIdentifier && unresolved.ReferencedContainer == reference.ReferencedContainer {
+					return nil
+				}
+			}
+			schemeTargets.Unresolved = append(schemeTargets.Unresolved, reference)
+		}
+		return target
+	}
+
+	if scheme.BuildAction != nil {
+		for _, entry := range scheme.BuildAction.BuildActionEntries {
+			target := resolve(entry.BuildableReference)
+			if target == nil {
+				continue
+			}
+
+			schemeTargets.Build = append(schemeTargets.Build, *target)
+			if entry.BuildForArchiving == "YES" {
+				schemeTargets.Archive = append(schemeTargets.Archive, *target)
+			}
+		}
+	}
+
+	if scheme.TestAction != nil {
+		for _, testable := range scheme.TestAction.Testables {
+			if testable.IsSkipped() {
+				continue
+			}
+
+			target := resolve(testable.BuildableReference)
+			if target != nil {
+				schemeTargets.Test = append(schemeTargets.Test, *target)
+			}
+		}
+	}
+
+	if scheme.LaunchAction != nil && scheme.LaunchAction.BuildableProductRunnable != nil {
+		schemeTargets.Launch = resolve(scheme.LaunchAction.BuildableProductRunnable.BuildableReference)
+	}
+
+	if scheme.ProfileAction != nil && scheme.ProfileAction.BuildableProductRunnable != nil {
+		schemeTargets.Profile = resolve(scheme.ProfileAction.BuildableProductRunnable.BuildableReference)
+	}
+
+	return schemeTargets
+}
@@ -0,0 +1,277 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const workspaceSchemeContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1000"
+   version = "1.3">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES">
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "NO"
+            buildForArchiving = "NO"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "C4F1B3042245E0A700D2C8F1"
+               BuildableName = "Kit.framework"
+               BlueprintName = "Kit"
+               ReferencedContainer = "container:Kit/Kit.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+               BuildableName = "SampleApp.app"
+               BlueprintName = "SampleApp"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "0E57A1D21F0000AA00C1D2E3"
+               BuildableName = "Removed.app"
+               BlueprintName = "Removed"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+   <TestAction
+      buildConfiguration = "Debug"
+      shouldUseLaunchSchemeArgsEnv = "YES">
+      <Testables>
+         <TestableReference
+            skipped = "NO">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "C4F1B31B2245E0A700D2C8F1"
+               BuildableName = "KitTests.xctest"
+               BlueprintName = "KitTests"
+               ReferencedContainer = "container:Kit/Kit.xcodeproj">
+            </BuildableReference>
+         </TestableReference>
+         <TestableReference
+            skipped = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A162176C1D300A4F1B2"
+               BuildableName = "SampleAppTests.xctest"
+               BlueprintName = "SampleAppTests"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </TestableReference>
+      </Testables>
+   </TestAction>
+   <LaunchAction
+      buildConfiguration = "Debug">
+      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+            BuildableName = "SampleApp.app"
+            BlueprintName = "SampleApp"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </BuildableProductRunnable>
+   </LaunchAction>
+</Scheme>
+`
+
+func targetNames(targets []SchemeTarget) []string {
+	names := []string{}
+	for _, target := range targets {
+		names = append(names, target.Target.Name)
+	}
+	return names
+}
+
+func TestWorkspaceSchemeTargets(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	workspacePth := filepath.Join(tmpDir, "SampleApp.xcworkspace")
+	writeTestWorkspace(t, workspacePth, `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:SampleApp.xcodeproj">
+   </FileRef>
+   <FileRef
+      location = "group:Kit/Kit.xcodeproj">
+   </FileRef>
+</Workspace>
+`)
+	writeTestProject(t, filepath.Join(tmpDir, "SampleApp.xcodeproj"), sampleAppPbxprojContent)
+	writeTestProject(t, filepath.Join(tmpDir, "Kit", "Kit.xcodeproj"), kitPbxprojContent)
+
+	workspaceSchemesDir := filepath.Join(workspacePth, "xcshareddata", "xcschemes")
+	require.NoError(t, os.MkdirAll(workspaceSchemesDir, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(workspaceSchemesDir, "All.xcscheme"), []byte(workspaceSchemeContent), 0644))
+
+	projectSchemesDir := filepath.Join(tmpDir, "SampleApp.xcodeproj", "xcuserdata", "bitrise.xcuserdatad", "xcschemes")
+	require.NoError(t, os.MkdirAll(projectSchemesDir, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(projectSchemesDir, "SampleApp.xcscheme"), []byte(sampleAppSchemeContent), 0644))
+
+	schemes, err := WorkspaceSchemeTargets(workspacePth)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(schemes))
+
+	t.Log("workspace scheme, with targets of multiple projects")
+	{
+		scheme := schemes[0]
+		require.Equal(t, "All", scheme.Scheme.Name())
+		require.Equal(t, workspacePth, scheme.Container)
+		require.Equal(t, true, scheme.IsShared)
+
+		require.Equal(t, []string{"Kit", "SampleApp"}, targetNames(scheme.Build))
+		require.Equal(t, "Kit", scheme.Build[0].Project.Name())
+		require.Equal(t, "SampleApp", scheme.Build[1].Project.Name())
+		require.Equal(t, []string{"KitTests"}, targetNames(scheme.Test))
+		require.Equal(t, []string{"SampleApp"}, targetNames(scheme.Archive))
+		require.NotNil(t, scheme.Launch)
+		require.Equal(t, "SampleApp", scheme.Launch.Target.Name)
+		require.Nil(t, scheme.Profile)
+
+		// each project is opened once
+		require.Equal(t, scheme.Build[1].Project, scheme.Launch.Project)
+
+		require.Equal(t, 1, len(scheme.Unresolved))
+		require.Equal(t, "Removed", scheme.Unresolved[0].BlueprintName)
+	}
+
+	t.Log("project user scheme")
+	{
+		scheme := schemes[1]
+		require.Equal(t, "SampleApp", scheme.Scheme.Name())
+		require.Equal(t, filepath.Join(tmpDir, "SampleApp.xcodeproj"), scheme.Container)
+		require.Equal(t, false, scheme.IsShared)
+		require.Equal(t, []string{"SampleApp"}, targetNames(scheme.Build))
+		require.Equal(t, []string{"SampleAppTests", "SampleAppUITests"}, targetNames(scheme.Test))
+		require.Equal(t, "SampleApp", scheme.Profile.Target.Name)
+		require.Equal(t, 0, len(scheme.Unresolved))
+	}
+
+	t.Log("schemes for target")
+	{
+		require.Equal(t, 2, len(SchemesForTarget(schemes, "SampleApp")))
+		require.Equal(t, 0, len(SchemesForTarget(schemes, "Kit")))
+	}
+
+	t.Log("project schemes")
+	{
+		projectSchemes, err := ProjectSchemeTargets(filepath.Join(tmpDir, "SampleApp.xcodeproj"))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(projectSchemes))
+		require.Equal(t, "SampleApp", projectSchemes[0].Launch.Target.Name)
+	}
+
+	t.Log("references into packages and missing projects")
+	{
+		require.NoError(t, ioutil.WriteFile(filepath.Join(workspaceSchemesDir, "Dependencies.xcscheme"), []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1320"
+   version = "1.3">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES">
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "Networking"
+               BuildableName = "Networking"
+               BlueprintName = "Networking"
+               ReferencedContainer = "container:Networking">
+            </BuildableReference>
+         </BuildActionEntry>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "1A2B3C4D5E6F7A8B9C0D1E2F"
+               BuildableName = "Pods_SampleApp.framework"
+               BlueprintName = "Pods-SampleApp"
+               ReferencedContainer = "container:Pods/Pods.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+               BuildableName = "SampleApp.app"
+               BlueprintName = "SampleApp"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+</Scheme>
+`), 0644))
+
+		schemes, err := WorkspaceSchemeTargets(workspacePth)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(schemes))
+
+		scheme := schemes[1]
+		require.Equal(t, "Dependencies", scheme.Scheme.Name())
+		require.Equal(t, []string{"SampleApp"}, targetNames(scheme.Build))
+		require.Equal(t, 1, len(scheme.Unresolved))
+		require.Equal(t, "Pods-SampleApp", scheme.Unresolved[0].BlueprintName)
+	}
+}
+
+func TestReferencedContainerPath(t *testing.T) {
+	pth, err := referencedContainerPath("container:Kit/Kit.xcodeproj", "/Users/bitrise/SampleApp.xcworkspace")
+	require.NoError(t, err)
+	require.Equal(t, "/Users/bitrise/Kit/Kit.xcodeproj", pth)
+
+	pth, err = referencedContainerPath("absolute:/Users/bitrise/Kit/Kit.xcodeproj", "/Users/bitrise/SampleApp.xcworkspace")
+	require.NoError(t, err)
+	require.Equal(t, "/Users/bitrise/Kit/Kit.xcodeproj", pth)
+
+	_, err = referencedContainerPath("group:Kit.xcodeproj", "/Users/bitrise/SampleApp.xcworkspace")
+	require.EqualError(t, err, "invalid location (group:Kit.xcodeproj): unsupported location type")
+}
@@ -0,0 +1,180 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// TargetShellScript is a Run Script build phase of a target.
+type TargetShellScript struct {
+	Target     Target
+	BuildPhase *PBXShellScriptBuildPhase
+}
+
+// ShellScripts returns the Run Script build phases of every target of the project, in target and build phase order.
+func (p *Project) ShellScripts() []TargetShellScript {
+	scripts := []TargetShellScript{}
+	for _, target := range p.Targets() {
+		for _, buildPhase := range target.AbstractTarget().ShellScriptBuildPhases() {
+			scripts = append(scripts, TargetShellScript{Target: target, BuildPhase: buildPhase})
+		}
+	}
+	return scripts
+}
+
+// ShellScriptIssueKind is the kind of a problem found in a Run Script build phase.
+type ShellScriptIssueKind string
+
+// Shell script issue kinds
+const (
+	ShellScriptIssueNoInputs        ShellScriptIssueKind = "no_inputs"
+	ShellScriptIssueNoOutputs       ShellScriptIssueKind = "no_outputs"
+	ShellScriptIssueMissingFileList ShellScriptIssueKind = "missing_file_list"
+	ShellScriptIssueNoErrexit       ShellScriptIssueKind = "no_errexit"
+)
+
+// ShellScriptIssue is a problem of a Run Script build phase.
+type ShellScriptIssue struct {
+	TargetShellScript
+	Kind ShellScriptIssueKind
+	// Path is the (build setting expanded) path of the missing file list, for ShellScriptIssueMissingFileList.
+	Path string
+}
+
+func (i ShellScriptIssue) String() string {
+	phase := fmt.Sprintf("%s: Run Script phase \"%s\"", i.Target.AbstractTarget().Name, i.BuildPhase.DisplayName())
+	switch i.Kind {
+	case ShellScriptIssueNoInputs:
+		return phase + " does not declare any inputs"
+	case ShellScriptIssueNoOutputs:
+		return phase + " does not declare any outputs, it runs on every build"
+	case ShellScriptIssueMissingFileList:
+		return phase + " references a missing file list: " + i.Path
+	case ShellScriptIssueNoErrexit:
+		return phase + " does not use `set -e`, a failing command does not fail the build"
+	}
+	return phase + ": " + string(i.Kind)
+}
+
+// ProjectShellScriptIssues checks the Run Script build phases of the project at the given path.
+func ProjectShellScriptIssues(projectPth string) ([]ShellScriptIssue, error) {
+	project, err := OpenProject(projectPth)
+	if err != nil {
+		return nil, err
+	}
+
+	return project.ShellScriptIssues()
+}
+
+// ShellScriptIssues checks the Run Script build phases of the project, it reports:
+//   - phases without declared inputs or outputs (unless the phase is marked to run on every build, alwaysOutOfDate),
+//   - input and output .xcfilelist files which do not exist, the paths are expanded with the target's build settings
+//     of its default build configuration, relative paths are relative to the project's source root,
+//   - sh, bash, zsh, dash and ksh scripts of more than one command, which do not enable errexit (`set -e`).
+func (p *Project) ShellScriptIssues() ([]ShellScriptIssue, error) {
+	issues := []ShellScriptIssue{}
+
+	for _, script := range p.ShellScripts() {
+		buildPhase := script.BuildPhase
+
+		if !buildPhase.AlwaysOutOfDate {
+			if len(buildPhase.InputPaths) == 0 && len(buildPhase.InputFileListPaths) == 0 {
+				issues = append(issues, ShellScriptIssue{TargetShellScript: script, Kind: ShellScriptIssueNoInputs})
+			}
+			if len(buildPhase.OutputPaths) == 0 && len(buildPhase.OutputFileListPaths) == 0 {
+				issues = append(issues, ShellScriptIssue{TargetShellScript: script, Kind: ShellScriptIssueNoOutputs})
+			}
+		}
+
+		fileLists := append(append([]string{}, buildPhase.InputFileListPaths...), buildPhase.OutputFileListPaths...)
+		if len(fileLists) > 0 {
+			missing, err := p.missingFileLists(script.Target, fileLists)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check file lists of %s: %s", buildPhase.DisplayName(), err)
+			}
+			for _, pth := range missing {
+				issues = append(issues, ShellScriptIssue{TargetShellScript: script, Kind: ShellScriptIssueMissingFileList, Path: pth})
+			}
+		}
+
+		if !usesErrexit(buildPhase.ShellPath, buildPhase.ShellScript) {
+			issues = append(issues, ShellScriptIssue{TargetShellScript: script, Kind: ShellScriptIssueNoErrexit})
+		}
+	}
+
+	return issues, nil
+}
+
+// missingFileLists returns the expanded paths of the file lists, which do not exist.
+// The build settings are resolved with the target's default configuration, or its first one if the default is not set.
+// File lists referencing undefined build settings (like the ones set by xcodebuild only) are not checked,
+// neither are the file lists of targets, which build settings can not be resolved (like because of a missing xcconfig file).
+func (p *Project) missingFileLists(target Target, fileLists []string) ([]string, error) {
+	configuration := ""
+	if configurationList := target.AbstractTarget().BuildConfigurationList; configurationList != nil {
+		configuration = configurationList.DefaultConfigurationName
+		if _, found := configurationList.BuildConfiguration(configuration); !found && len(configurationList.BuildConfigurations) > 0 {
+			configuration = configurationList.BuildConfigurations[0].Name
+		}
+	}
+	settings, err := p.ResolveBuildSettings(target, configuration, BuildSettingsOptions{})
+	if err != nil {
+		return []string{}, nil
+	}
+
+	missing := []string{}
+	for _, fileList := range fileLists {
+		pth, ok := expandBuildSettingPath(settings, fileList)
+		if !ok {
+			continue
+		}
+
+		if exist, err := pathutil.IsPathExists(pth); err != nil {
+			return nil, err
+		} else if !exist {
+			missing = append(missing, pth)
+		}
+	}
+	return missing, nil
+}
+
+var errexitShells = map[string]bool{"sh": true, "bash": true, "zsh": true, "dash": true, "ksh": true}
+
+var errexitRegexp = regexp.MustCompile(`^set\s+(.*\s)?(-[A-Za-z]*e[A-Za-z]*|-o\s+errexit)(\s|$)`)
+
+// usesErrexit reports whether the script stops at the first failing command.
+// Scripts of non POSIX shells and scripts of a single command (which exit status is the script's) always pass.
+func usesErrexit(shellPath, script string) bool {
+	shell := strings.Fields(shellPath)
+	if len(shell) > 1 && path.Base(shell[0]) == "env" {
+		shell = shell[1:]
+	}
+	if len(shell) == 0 {
+		return true
+	}
+	if !errexitShells[path.Base(shell[0])] {
+		return true
+	}
+	for _, arg := range shell[1:] {
+		if strings.HasPrefix(arg, "-") && !strings.HasPrefix(arg, "--") && strings.Contains(arg, "e") {
+			return true
+		}
+	}
+
+	commands := 0
+	for _, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if errexitRegexp.MatchString(line) {
+			return true
+		}
+		commands++
+	}
+	return commands <= 1
+}
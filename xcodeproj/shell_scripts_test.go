@@ -0,0 +1,169 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var sampleAppFileListsPbxprojContent = strings.Replace(sampleAppPbxprojContent, `			inputFileListPaths = (
+			);
+			inputPaths = (
+				"$(SRCROOT)/SampleApp/Info.plist",
+			);
+			name = "Generate Version";
+			outputFileListPaths = (
+			);`, `			inputFileListPaths = (
+				"$(SRCROOT)/Scripts/inputs.xcfilelist",
+				"$(BUILD_DIR)/generated.xcfilelist",
+			);
+			inputPaths = (
+				"$(SRCROOT)/SampleApp/Info.plist",
+			);
+			name = "Generate Version";
+			outputFileListPaths = (
+				Scripts/outputs.xcfilelist,
+			);`, 1)
+
+func TestShellScripts(t *testing.T) {
+	project, err := ParseProject(sampleAppPbxprojContent)
+	require.NoError(t, err)
+
+	scripts := project.ShellScripts()
+	require.Equal(t, 2, len(scripts))
+
+	require.Equal(t, "SampleApp", scripts[0].Target.AbstractTarget().Name)
+	generateVersion := scripts[0].BuildPhase
+	require.Equal(t, "Generate Version", generateVersion.DisplayName())
+	require.Equal(t, "/bin/bash", generateVersion.ShellPath)
+	require.Equal(t, "set -e\n\"${SRCROOT}/scripts/generate_version.sh\" > \"${DERIVED_FILE_DIR}/Version.swift\"\n", generateVersion.ShellScript)
+	require.Equal(t, []string{"$(SRCROOT)/SampleApp/Info.plist"}, generateVersion.InputPaths)
+	require.Equal(t, []string{"$(DERIVED_FILE_DIR)/Version.swift"}, generateVersion.OutputPaths)
+	require.Equal(t, []string{}, generateVersion.InputFileListPaths)
+	require.Equal(t, false, generateVersion.AlwaysOutOfDate)
+	require.Equal(t, false, generateVersion.RunOnlyForDeploymentPostprocessing)
+
+	require.Equal(t, "Lint", scripts[1].Target.AbstractTarget().Name)
+	require.Equal(t, "SwiftLint", scripts[1].BuildPhase.DisplayName())
+}
+
+func TestShellScriptIssues(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	t.Log("phases without inputs, outputs and errexit")
+	{
+		projectPth := writeSampleAppProject(t, filepath.Join(tmpDir, "SampleApp"), sampleAppPbxprojContent)
+
+		issues, err := ProjectShellScriptIssues(projectPth)
+		require.NoError(t, err)
+
+		messages := []string{}
+		for _, issue := range issues {
+			messages = append(messages, issue.String())
+		}
+		require.Equal(t, []string{
+			`Lint: Run Script phase "SwiftLint" does not declare any inputs`,
+			`Lint: Run Script phase "SwiftLint" does not declare any outputs, it runs on every build`,
+			"Lint: Run Script phase \"SwiftLint\" does not use `set -e`, a failing command does not fail the build",
+		}, messages)
+	}
+
+	t.Log("always out of date phase")
+	{
+		content := strings.Replace(sampleAppPbxprojContent, `		8D3E2A3C2176C1D300A4F1B2 /* SwiftLint */ = {
+			isa = PBXShellScriptBuildPhase;
+`, `		8D3E2A3C2176C1D300A4F1B2 /* SwiftLint */ = {
+			isa = PBXShellScriptBuildPhase;
+			alwaysOutOfDate = 1;
+`, 1)
+		project, err := ParseProject(content)
+		require.NoError(t, err)
+
+		issues, err := project.ShellScriptIssues()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(issues))
+		require.Equal(t, ShellScriptIssueNoErrexit, issues[0].Kind)
+	}
+
+	t.Log("missing file lists")
+	{
+		dir := filepath.Join(tmpDir, "FileLists")
+		projectPth := writeSampleAppProject(t, dir, sampleAppFileListsPbxprojContent)
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "Scripts"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "Scripts", "inputs.xcfilelist"), []byte("$(SRCROOT)/SampleApp/Info.plist\n"), 0644))
+
+		project, err := OpenProject(projectPth)
+		require.NoError(t, err)
+
+		issues, err := project.ShellScriptIssues()
+		require.NoError(t, err)
+		require.Equal(t, 4, len(issues))
+		require.Equal(t, ShellScriptIssueMissingFileList, issues[0].Kind)
+		require.Equal(t, "SampleApp", issues[0].Target.AbstractTarget().Name)
+		require.Equal(t, filepath.Join(dir, "Scripts", "outputs.xcfilelist"), issues[0].Path)
+		require.Equal(t, `SampleApp: Run Script phase "Generate Version" references a missing file list: `+filepath.Join(dir, "Scripts", "outputs.xcfilelist"), issues[0].String())
+	}
+
+	t.Log("missing file lists, without default configuration")
+	{
+		dir := filepath.Join(tmpDir, "NoDefaultConfiguration")
+		content := strings.Replace(sampleAppFileListsPbxprojContent, "\t\t\tdefaultConfigurationName = Release;\n", "", -1)
+		projectPth := writeSampleAppProject(t, dir, content)
+
+		project, err := OpenProject(projectPth)
+		require.NoError(t, err)
+
+		issues, err := project.ShellScriptIssues()
+		require.NoError(t, err)
+		require.Equal(t, ShellScriptIssueMissingFileList, issues[0].Kind)
+		require.Equal(t, filepath.Join(dir, "Scripts", "inputs.xcfilelist"), issues[0].Path)
+		require.Equal(t, ShellScriptIssueMissingFileList, issues[1].Kind)
+		require.Equal(t, filepath.Join(dir, "Scripts", "outputs.xcfilelist"), issues[1].Path)
+	}
+
+	t.Log("file lists of a target, which build settings can not be resolved")
+	{
+		projectPth := filepath.Join(tmpDir, "MissingXCConfig", "SampleApp.xcodeproj")
+		writeTestProject(t, projectPth, sampleAppFileListsPbxprojContent)
+
+		project, err := OpenProject(projectPth)
+		require.NoError(t, err)
+
+		issues, err := project.ShellScriptIssues()
+		require.NoError(t, err)
+		for _, issue := range issues {
+			require.NotEqual(t, ShellScriptIssueMissingFileList, issue.Kind)
+		}
+	}
+}
+
+func TestUsesErrexit(t *testing.T) {
+	for _, c := range []struct {
+		shellPath string
+		script    string
+		want      bool
+	}{
+		{"/bin/sh", "set -e\nswiftlint\nswiftformat .\n", true},
+		{"/bin/bash", "#!/bin/bash\nset -euo pipefail\nswiftlint\nswiftformat .\n", true},
+		{"/bin/zsh", "set -o errexit\nswiftlint\nswiftformat .\n", true},
+		{"/bin/sh -e", "swiftlint\nswiftformat .\n", true},
+		{"/bin/sh", "\"${PODS_ROOT}/SwiftLint/swiftlint\"\n", true},
+		{"/usr/bin/env ruby", "puts 'a'\nputs 'b'\n", true},
+		{"/usr/bin/python3", "import os\nprint(os.environ)\n", true},
+		{"/bin/sh", "swiftlint\nswiftformat .\n", false},
+		{"/usr/bin/env bash", "swiftlint\nswiftformat .\n", false},
+		{"/bin/bash", "# set -e\nswiftlint\nswiftformat .\n", false},
+		{"/bin/sh", "set -x\nswiftlint\nswiftformat .\n", false},
+		{"/bin/sh", "cd \"${SRCROOT}\"\nset +e\nswiftlint\n", false},
+	} {
+		require.Equal(t, c.want, usesErrexit(c.shellPath, c.script), c.shellPath+": "+c.script)
+	}
+}
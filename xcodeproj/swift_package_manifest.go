@@ -0,0 +1,565 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// Swift package product kinds
+const (
+	SwiftPackageProductLibrary    = "library"
+	SwiftPackageProductExecutable = "executable"
+	SwiftPackageProductPlugin     = "plugin"
+)
+
+// Swift package target kinds
+const (
+	SwiftPackageTargetRegular       = "target"
+	SwiftPackageTargetExecutable    = "executableTarget"
+	SwiftPackageTargetTest          = "testTarget"
+	SwiftPackageTargetBinary        = "binaryTarget"
+	SwiftPackageTargetPlugin        = "plugin"
+	SwiftPackageTargetMacro         = "macro"
+	SwiftPackageTargetSystemLibrary = "systemLibrary"
+)
+
+var (
+	swiftToolsVersionRegexp   = regexp.MustCompile(`^//\s*swift-tools-version\s*:\s*([0-9][0-9.]*)`)
+	swiftPackageCallRegexp    = regexp.MustCompile(`\bPackage\s*\(`)
+	swiftCallRegexp           = regexp.MustCompile(`^\.?([A-Za-z_][A-Za-z0-9_]*)\s*\(`)
+	swiftLabelRegexp          = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\s*:\s*`)
+	swiftStringLiteralRegexp  = regexp.MustCompile(`^"((?:[^"\\]|\\.)*)"$`)
+	swiftVersionRangeRegexp   = regexp.MustCompile(`^"([^"]*)"\s*\.\.<\s*"([^"]*)"$`)
+	swiftStringEscapeReplacer = strings.NewReplacer(`\"`, `"`, `\\`, `\`)
+)
+
+// SwiftPackageProduct is a product of a Swift package manifest.
+type SwiftPackageProduct struct {
+	Name string
+	// Kind is library, executable or plugin.
+	Kind    string
+	Targets []string
+}
+
+// SwiftPackageTargetDependency is a dependency of a Swift package target:
+// a target of the same package, or a product of a package dependency.
+type SwiftPackageTargetDependency struct {
+	Name string
+	// Package is the package of a product dependency, empty for target (and by name) dependencies.
+	Package string
+}
+
+// SwiftPackageTarget is a target of a Swift package manifest.
+type SwiftPackageTarget struct {
+	Name string
+	// Kind is the target's declaration, like target, testTarget or executableTarget.
+	Kind         string
+	Dependencies []SwiftPackageTargetDependency
+}
+
+// SwiftPackageDependency is a package dependency of a Swift package manifest.
+type SwiftPackageDependency struct {
+	// Name is the explicitly declared name of the dependency, if any.
+	Name string
+	URL  string
+	// Path is the path of a local package dependency, relative to the package.
+	Path        string
+	Requirement SwiftPackageRequirement
+}
+
+// Identity returns the package identity of the dependency, like `alamofire`.
+func (d SwiftPackageDependency) Identity() string {
+	if d.URL != "" {
+		return SwiftPackageIdentity(d.URL)
+	}
+	return SwiftPackageIdentity(d.Path)
+}
+
+// SwiftPackageManifest is the statically extracted content of a Package.swift.
+type SwiftPackageManifest struct {
+	// Path is the path of the package's directory.
+	Path         string
+	ToolsVersion string
+	Name         string
+	Products     []SwiftPackageProduct
+	Dependencies []SwiftPackageDependency
+	Targets      []SwiftPackageTarget
+}
+
+// OpenSwiftPackageManifest parses the Package.swift of the Swift package at the given directory.
+func OpenSwiftPackageManifest(packagePth string) (*SwiftPackageManifest, error) {
+	manifestPth := filepath.Join(packagePth, "Package.swift")
+	if exist, err := pathutil.IsPathExists(manifestPth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, fmt.Errorf("Package.swift does not exist at: %s", manifestPth)
+	}
+
+	content, err := fileutil.ReadStringFromFile(manifestPth)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSwiftPackageManifest(content, packagePth)
+}
+
+// ParseSwiftPackageManifest extracts the name, products, dependencies and targets of the Package.swift content,
+// without running swift: only the literal values of the `Package(...)` initializer are read,
+// values computed by the manifest (variables, loops, conditions) are skipped.
+// If the name is not a string literal, the name of the package's directory is used.
+func ParseSwiftPackageManifest(content, packagePth string) (*SwiftPackageManifest, error) {
+	manifest := &SwiftPackageManifest{
+		Path:         packagePth,
+		Name:         filepath.Base(packagePth),
+		Products:     []SwiftPackageProduct{},
+		Dependencies: []SwiftPackageDependency{},
+		Targets:      []SwiftPackageTarget{},
+	}
+
+	if match := swiftToolsVersionRegexp.FindStringSubmatch(strings.TrimSpace(content)); match != nil {
+		manifest.ToolsVersion = match[1]
+	}
+
+	content = stripSwiftComments(content)
+	loc := swiftPackageCallRegexp.FindStringIndex(content)
+	if loc == nil {
+		return nil, fmt.Errorf("failed to parse Package.swift (%s): missing Package declaration", packagePth)
+	}
+	open := loc[1] - 1
+	end := swiftClosingIndex(content, open)
+	if end == -1 {
+		return nil, fmt.Errorf("failed to parse Package.swift (%s): unbalanced Package declaration", packagePth)
+	}
+
+	for _, arg := range parseSwiftArguments(content[open+1 : end]) {
+		switch arg.Label {
+		case "name":
+			if name, ok := swiftString(arg.Value); ok {
+				manifest.Name = name
+			}
+		case "products":
+			for _, element := range swiftArrayElements(arg.Value) {
+				if product, ok := parseSwiftPackageProduct(element); ok {
+					manifest.Products = append(manifest.Products, product)
+				}
+			}
+		case "dependencies":
+			for _, element := range swiftArrayElements(arg.Value) {
+				if dependency, ok := parseSwiftPackageDependency(element); ok {
+					manifest.Dependencies = append(manifest.Dependencies, dependency)
+				}
+			}
+		case "targets":
+			for _, element := range swiftArrayElements(arg.Value) {
+				if target, ok := parseSwiftPackageTarget(element); ok {
+					manifest.Targets = append(manifest.Targets, target)
+				}
+			}
+		}
+	}
+
+	return manifest, nil
+}
+
+func parseSwiftPackageProduct(element string) (SwiftPackageProduct, bool) {
+	kind, args, ok := swiftCall(element)
+	if !ok {
+		return SwiftPackageProduct{}, false
+	}
+
+	product := SwiftPackageProduct{Kind: kind, Targets: []string{}}
+	for _, arg := range parseSwiftArguments(args) {
+		switch arg.Label {
+		case "name":
+			product.Name, _ = swiftString(arg.Value)
+		case "targets":
+			for _, target := range swiftArrayElements(arg.Value) {
+				if name, ok := swiftString(target); ok {
+					product.Targets = append(product.Targets, name)
+				}
+			}
+		}
+	}
+	return product, product.Name != ""
+}
+
+func parseSwiftPackageTarget(element string) (SwiftPackageTarget, bool) {
+	kind, args, ok := swiftCall(element)
+	if !ok {
+		return SwiftPackageTarget{}, false
+	}
+
+	target := SwiftPackageTarget{Kind: kind, Dependencies: []SwiftPackageTargetDependency{}}
+	for _, arg := range parseSwiftArguments(args) {
+		switch arg.Label {
+		case "name":
+			target.Name, _ = swiftString(arg.Value)
+		case "dependencies":
+			for _, dependency := range swiftArrayElements(arg.Value) {
+				if dependency, ok := parseSwiftPackageTargetDependency(dependency); ok {
+					target.Dependencies = append(target.Dependencies, dependency)
+				}
+			}
+		}
+	}
+	return target, target.Name != ""
+}
+
+// parseSwiftPackageTargetDependency parses a target dependency: `"Name"`, `.target(name:)`, `.byName(name:)` or `.product(name:package:)`.
+func parseSwiftPackageTargetDependency(element string) (SwiftPackageTargetDependency, bool) {
+	if name, ok := swiftString(element); ok {
+		return SwiftPackageTargetDependency{Name: name}, true
+	}
+
+	kind, args, ok := swiftCall(element)
+	if !ok || (kind != "target" && kind != "byName" && kind != "product") {
+		return SwiftPackageTargetDependency{}, false
+	}
+
+	dependency := SwiftPackageTargetDependency{}
+	for _, arg := range parseSwiftArguments(args) {
+		switch arg.Label {
+		case "name":
+			dependency.Name, _ = swiftString(arg.Value)
+		case "package":
+			dependency.Package, _ = swiftString(arg.Value)
+		}
+	}
+	return dependency, dependency.Name != ""
+}
+
+// parseSwiftPackageDependency parses a `.package(...)` dependency.
+// Requirements, which have no Xcode equivalent (like closed version ranges), are left empty.
+func parseSwiftPackageDependency(element string) (SwiftPackageDependency, bool) {
+	kind, args, ok := swiftCall(element)
+	if !ok || kind != "package" {
+		return SwiftPackageDependency{}, false
+	}
+
+	dependency := SwiftPackageDependency{}
+	for _, arg := range parseSwiftArguments(args) {
+		value, isString := swiftString(arg.Value)
+		switch arg.Label {
+		case "name":
+			dependency.Name = value
+		case "url":
+			dependency.URL = value
+		case "path":
+			dependency.Path = value
+		case "from":
+			dependency.Requirement = SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: value}
+		case "exact":
+			dependency.Requirement = SwiftPackageRequirement{Kind: SwiftPackageRequirementExactVersion, Version: value}
+		case "branch":
+			dependency.Requirement = SwiftPackageRequirement{Kind: SwiftPackageRequirementBranch, Branch: value}
+		case "revision":
+			dependency.Requirement = SwiftPackageRequirement{Kind: SwiftPackageRequirementRevision, Revision: value}
+		case "":
+			if !isString {
+				dependency.Requirement = parseSwiftPackageRequirement(arg.Value)
+			}
+		}
+	}
+	return dependency, dependency.URL != "" || dependency.Path != ""
+}
+
+// parseSwiftPackageRequirement parses an unlabeled requirement, like `.upToNextMinor(from: "1.2.0")` or `"1.0.0"..<"2.0.0"`.
+func parseSwiftPackageRequirement(value string) SwiftPackageRequirement {
+	if match := swiftVersionRangeRegexp.FindStringSubmatch(value); match != nil {
+		return SwiftPackageRequirement{Kind: SwiftPackageRequirementVersionRange, MinimumVersion: match[1], MaximumVersion: match[2]}
+	}
+
+	kind, args, ok := swiftCall(value)
+	if !ok {
+		return SwiftPackageRequirement{}
+	}
+	arguments := parseSwiftArguments(args)
+	if len(arguments) != 1 {
+		return SwiftPackageRequirement{}
+	}
+	argument, _ := swiftString(arguments[0].Value)
+
+	switch kind {
+	case "upToNextMajor":
+		return SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: argument}
+	case "upToNextMinor":
+		return SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMinorVersion, MinimumVersion: argument}
+	case "exact":
+		return SwiftPackageRequirement{Kind: SwiftPackageRequirementExactVersion, Version: argument}
+	case "branch":
+		return SwiftPackageRequirement{Kind: SwiftPackageRequirementBranch, Branch: argument}
+	case "revision":
+		return SwiftPackageRequirement{Kind: SwiftPackageRequirementRevision, Revision: argument}
+	}
+	return SwiftPackageRequirement{}
+}
+
+// Product returns the product with the given name.
+func (m *SwiftPackageManifest) Product(name string) (SwiftPackageProduct, bool) {
+	for _, product := range m.Products {
+		if product.Name == name {
+			return product, true
+		}
+	}
+	return SwiftPackageProduct{}, false
+}
+
+// Target returns the target with the given name.
+func (m *SwiftPackageManifest) Target(name string) (SwiftPackageTarget, bool) {
+	for _, target := range m.Targets {
+		if target.Name == name {
+			return target, true
+		}
+	}
+	return SwiftPackageTarget{}, false
+}
+
+// TestTargets returns the test targets of the package.
+func (m *SwiftPackageManifest) TestTargets() []SwiftPackageTarget {
+	targets := []SwiftPackageTarget{}
+	for _, target := range m.Targets {
+		if target.Kind == SwiftPackageTargetTest {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// Schemes returns the schemes, which Xcode generates for the package, mapped to whether they contain tests.
+// A package with multiple library and executable products gets a scheme per product,
+// testing the test targets which depend on the product's targets, and a <Name>-Package scheme testing every test target.
+// Otherwise the single scheme is named after the product (or the package) and tests every test target.
+func (m *SwiftPackageManifest) Schemes() map[string]bool {
+	products := []SwiftPackageProduct{}
+	for _, product := range m.Products {
+		if product.Kind == SwiftPackageProductLibrary || product.Kind == SwiftPackageProductExecutable {
+			products = append(products, product)
+		}
+	}
+	hasTests := len(m.TestTargets()) > 0
+
+	schemes := map[string]bool{}
+	if len(products) <= 1 {
+		name := m.Name
+		if len(products) == 1 {
+			name = products[0].Name
+		}
+		schemes[name] = hasTests
+		return schemes
+	}
+
+	for _, product := range products {
+		schemes[product.Name] = m.isProductTested(product)
+	}
+	schemes[m.Name+"-Package"] = hasTests
+	return schemes
+}
+
+// isProductTested reports whether a test target depends on a target of the product.
+func (m *SwiftPackageManifest) isProductTested(product SwiftPackageProduct) bool {
+	productTargets := map[string]bool{}
+	for _, target := range product.Targets {
+		productTargets[target] = true
+	}
+
+	for _, target := range m.TestTargets() {
+		for _, dependency := range target.Dependencies {
+			if dependency.Package == "" && productTargets[dependency.Name] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WorkspaceSwiftPackages returns the manifests of the local Swift packages referenced by the workspace.
+func WorkspaceSwiftPackages(workspacePth string) ([]*SwiftPackageManifest, error) {
+	return WorkspaceSwiftPackagesWithOptions(workspacePth, WorkspaceListOptions{})
+}
+
+// WorkspaceSwiftPackagesWithOptions is WorkspaceSwiftPackages over the workspaces selected by the options.
+func WorkspaceSwiftPackagesWithOptions(workspacePth string, opts WorkspaceListOptions) ([]*SwiftPackageManifest, error) {
+	workspaces, _, err := workspaceContainers(workspacePth, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := map[string]bool{}
+	manifests := []*SwiftPackageManifest{}
+	for _, pth := range workspaces {
+		workspace, err := OpenWorkspace(pth)
+		if err != nil {
+			return nil, err
+		}
+
+		packagePaths, err := workspace.SwiftPackagePaths()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, packagePth := range packagePaths {
+			if visited[packagePth] {
+				continue
+			}
+			visited[packagePth] = true
+
+			manifest, err := OpenSwiftPackageManifest(packagePth)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, manifest)
+		}
+	}
+	return manifests, nil
+}
+
+// ------------------------------
+// Swift source
+
+// stripSwiftComments removes the line and block comments of the Swift source, string literals are kept.
+func stripSwiftComments(content string) string {
+	stripped := make([]byte, 0, len(content))
+	for i := 0; i < len(content); i++ {
+		switch {
+		case content[i] == '"':
+			end := swiftStringEnd(content, i)
+			stripped = append(stripped, content[i:end]...)
+			i = end - 1
+		case strings.HasPrefix(content[i:], "//"):
+			end := strings.Index(content[i:], "\n")
+			if end == -1 {
+				return string(stripped)
+			}
+			i += end - 1
+		case strings.HasPrefix(content[i:], "/*"):
+			end := strings.Index(content[i+2:], "*/")
+			if end == -1 {
+				return string(stripped)
+			}
+			stripped = append(stripped, ' ')
+			i += end + 3
+		default:
+			stripped = append(stripped, content[i])
+		}
+	}
+	return string(stripped)
+}
+
+// swiftStringEnd returns the index after the string literal starting at the given index, including multi-line literals.
+func swiftStringEnd(content string, start int) int {
+	if strings.HasPrefix(content[start:], `"""`) {
+		if end := strings.Index(content[start+3:], `"""`); end != -1 {
+			return start + 3 + end + 3
+		}
+		return len(content)
+	}
+
+	for i := start + 1; i < len(content); i++ {
+		switch content[i] {
+		case '\\':
+			i++
+		case '"', '\n':
+			return i + 1
+		}
+	}
+	return len(content)
+}
+
+// swiftClosingIndex returns the index of the bracket closing the one at the given index, or -1 if it is not closed.
+func swiftClosingIndex(content string, open int) int {
+	depth := 0
+	for i := open; i < len(content); i++ {
+		switch content[i] {
+		case '"':
+			i = swiftStringEnd(content, i) - 1
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitSwiftList splits the content at the top level commas, the parts are trimmed, empty parts are dropped.
+func splitSwiftList(content string) []string {
+	parts := []string{}
+	depth, start := 0, 0
+	for i := 0; i <= len(content); i++ {
+		if i == len(content) || (content[i] == ',' && depth == 0) {
+			if part := strings.TrimSpace(content[start:i]); part != "" {
+				parts = append(parts, part)
+			}
+			start = i + 1
+			continue
+		}
+
+		switch content[i] {
+		case '"':
+			i = swiftStringEnd(content, i) - 1
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		}
+	}
+	return parts
+}
+
+type swiftArgument struct {
+	// Label is empty for unlabeled arguments.
+	Label string
+	Value string
+}
+
+func parseSwiftArguments(content string) []swiftArgument {
+	arguments := []swiftArgument{}
+	for _, part := range splitSwiftList(content) {
+		argument := swiftArgument{Value: part}
+		if loc := swiftLabelRegexp.FindStringSubmatchIndex(part); loc != nil {
+			argument.Label = part[loc[2]:loc[3]]
+			argument.Value = part[loc[1]:]
+		}
+		arguments = append(arguments, argument)
+	}
+	return arguments
+}
+
+// swiftCall returns the function name and the arguments of a call, like `.library(name: "Kit", targets: ["Kit"])`.
+func swiftCall(value string) (string, string, bool) {
+	loc := swiftCallRegexp.FindStringSubmatchIndex(value)
+	if loc == nil {
+		return "", "", false
+	}
+	open := loc[1] - 1
+	end := swiftClosingIndex(value, open)
+	if end == -1 || strings.TrimSpace(value[end+1:]) != "" {
+		return "", "", false
+	}
+	return value[loc[2]:loc[3]], value[open+1 : end], true
+}
+
+// swiftArrayElements returns the elements of an array literal, nil if the value is not an array literal.
+func swiftArrayElements(value string) []string {
+	if !strings.HasPrefix(value, "[") || swiftClosingIndex(value, 0) != len(value)-1 {
+		return nil
+	}
+	return splitSwiftList(value[1 : len(value)-1])
+}
+
+// swiftString returns the value of a string literal without interpolation.
+func swiftString(value string) (string, bool) {
+	match := swiftStringLiteralRegexp.FindStringSubmatch(value)
+	if match == nil || strings.Contains(match[1], `\(`) {
+		return "", false
+	}
+	return swiftStringEscapeReplacer.Replace(match[1]), true
+}
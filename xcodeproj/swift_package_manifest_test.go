@@ -0,0 +1,193 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSwiftPackageManifest(t *testing.T) {
+	manifest, err := ParseSwiftPackageManifest(networkingPackageSwiftContent, "/Packages/Networking")
+	require.NoError(t, err)
+	require.Equal(t, "/Packages/Networking", manifest.Path)
+	require.Equal(t, "5.7", manifest.ToolsVersion)
+	require.Equal(t, "Networking", manifest.Name)
+
+	require.Equal(t, []SwiftPackageProduct{
+		{Name: "Networking", Kind: SwiftPackageProductLibrary, Targets: []string{"Networking"}},
+		{Name: "NetworkingMocks", Kind: SwiftPackageProductLibrary, Targets: []string{"NetworkingMocks"}},
+		{Name: "networking-cli", Kind: SwiftPackageProductExecutable, Targets: []string{"CLI"}},
+		{Name: "GenerateEndpoints", Kind: SwiftPackageProductPlugin, Targets: []string{"GenerateEndpoints"}},
+	}, manifest.Products)
+
+	require.Equal(t, []SwiftPackageDependency{
+		{
+			URL:         "https://github.com/Alamofire/Alamofire.git",
+			Requirement: SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMajorVersion, MinimumVersion: "5.6.0"},
+		},
+		{
+			URL:         "https://github.com/apple/swift-argument-parser",
+			Requirement: SwiftPackageRequirement{Kind: SwiftPackageRequirementUpToNextMinorVersion, MinimumVersion: "1.2.0"},
+		},
+		{
+			URL:         "https://github.com/apple/swift-collections",
+			Requirement: SwiftPackageRequirement{Kind: SwiftPackageRequirementBranch, Branch: "main"},
+		},
+		{
+			URL:         "https://github.com/Quick/Nimble.git",
+			Requirement: SwiftPackageRequirement{Kind: SwiftPackageRequirementVersionRange, MinimumVersion: "12.0.0", MaximumVersion: "13.0.0"},
+		},
+		{Name: "Logger", Path: "../Logger"},
+	}, manifest.Dependencies)
+	require.Equal(t, "nimble", manifest.Dependencies[3].Identity())
+	require.Equal(t, "logger", manifest.Dependencies[4].Identity())
+
+	require.Equal(t, []SwiftPackageTarget{
+		{
+			Name: "Networking",
+			Kind: SwiftPackageTargetRegular,
+			Dependencies: []SwiftPackageTargetDependency{
+				{Name: "Alamofire", Package: "Alamofire"},
+				{Name: "Logger"},
+			},
+		},
+		{
+			Name:         "NetworkingMocks",
+			Kind:         SwiftPackageTargetRegular,
+			Dependencies: []SwiftPackageTargetDependency{{Name: "Networking"}},
+		},
+		{
+			Name: "CLI",
+			Kind: SwiftPackageTargetExecutable,
+			Dependencies: []SwiftPackageTargetDependency{
+				{Name: "Networking"},
+				{Name: "ArgumentParser", Package: "swift-argument-parser"},
+			},
+		},
+		{
+			Name:         "GenerateEndpoints",
+			Kind:         SwiftPackageTargetPlugin,
+			Dependencies: []SwiftPackageTargetDependency{},
+		},
+		{
+			Name: "NetworkingTests",
+			Kind: SwiftPackageTargetTest,
+			Dependencies: []SwiftPackageTargetDependency{
+				{Name: "Networking"},
+				{Name: "NetworkingMocks"},
+				{Name: "Nimble", Package: "Nimble"},
+			},
+		},
+	}, manifest.Targets)
+
+	t.Log("computed values")
+	{
+		manifest, err := ParseSwiftPackageManifest(`// swift-tools-version: 5.9
+import PackageDescription
+
+let name = "Logger"
+let package = Package(
+    name: name,
+    products: [.library(name: name, targets: [name])],
+    targets: [.target(name: "Logger")] + extraTargets
+)
+`, "/Packages/Logger")
+		require.NoError(t, err)
+		require.Equal(t, "5.9", manifest.ToolsVersion)
+		require.Equal(t, "Logger", manifest.Name)
+		require.Equal(t, 0, len(manifest.Products))
+		require.Equal(t, 0, len(manifest.Targets))
+	}
+
+	t.Log("invalid")
+	{
+		_, err := ParseSwiftPackageManifest("// swift-tools-version:5.0\n", "/Packages/Logger")
+		require.EqualError(t, err, "failed to parse Package.swift (/Packages/Logger): missing Package declaration")
+
+		_, err = ParseSwiftPackageManifest(`let package = Package(name: "Logger"`, "/Packages/Logger")
+		require.EqualError(t, err, "failed to parse Package.swift (/Packages/Logger): unbalanced Package declaration")
+	}
+}
+
+func TestSwiftPackageManifestSchemes(t *testing.T) {
+	manifest, err := ParseSwiftPackageManifest(networkingPackageSwiftContent, "/Packages/Networking")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{
+		"Networking":         true,
+		"NetworkingMocks":    true,
+		"networking-cli":     false,
+		"Networking-Package": true,
+	}, manifest.Schemes())
+
+	manifest, err = ParseSwiftPackageManifest(`let package = Package(
+    name: "Logger",
+    products: [.library(name: "LoggerKit", targets: ["Logger"])],
+    targets: [.target(name: "Logger"), .testTarget(name: "LoggerTests", dependencies: ["Logger"])]
+)`, "/Packages/Logger")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"LoggerKit": true}, manifest.Schemes())
+
+	manifest, err = ParseSwiftPackageManifest(`let package = Package(name: "Plugins", products: [.plugin(name: "Lint", targets: ["Lint"])])`, "/Packages/Plugins")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"Plugins": false}, manifest.Schemes())
+}
+
+func TestWorkspaceSwiftPackages(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	workspacePth := filepath.Join(tmpDir, "Networking.xcworkspace")
+	writeTestWorkspace(t, workspacePth, `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <Group
+      location = "group:Packages"
+      name = "Packages">
+      <FileRef
+         location = "group:Networking">
+      </FileRef>
+      <FileRef
+         location = "group:README.md">
+      </FileRef>
+   </Group>
+</Workspace>
+`)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "Packages", "Networking"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "Packages", "Networking", "Package.swift"), []byte(networkingPackageSwiftContent), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "Packages", "README.md"), []byte("# Packages\n"), 0644))
+
+	manifests, err := WorkspaceSwiftPackages(workspacePth)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(manifests))
+	require.Equal(t, filepath.Join(tmpDir, "Packages", "Networking"), manifests[0].Path)
+	require.Equal(t, "Networking", manifests[0].Name)
+
+	t.Log("shared schemes")
+	{
+		schemesDir := filepath.Join(workspacePth, "xcshareddata", "xcschemes")
+		require.NoError(t, os.MkdirAll(schemesDir, 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(schemesDir, "networking-cli.xcscheme"), []byte(compactSchemeContent), 0644))
+
+		schemes, err := WorkspaceSharedSchemes(workspacePth)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"networking-cli": true}, schemes)
+
+		schemes, err = WorkspaceSharedSchemesWithOptions(workspacePth, WorkspaceListOptions{IncludePackages: true})
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{
+			"Networking":         true,
+			"NetworkingMocks":    true,
+			"networking-cli":     true,
+			"Networking-Package": true,
+		}, schemes)
+	}
+
+	_, err = OpenSwiftPackageManifest(filepath.Join(tmpDir, "Packages"))
+	require.EqualError(t, err, "Package.swift does not exist at: "+filepath.Join(tmpDir, "Packages", "Package.swift"))
+}
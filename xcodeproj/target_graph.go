@@ -0,0 +1,388 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// TargetNode is a target of the dependency graph, with the project, which contains it.
+type TargetNode struct {
+	Project *Project
+	Target  Target
+}
+
+// Name returns the name of the target.
+func (n *TargetNode) Name() string {
+	return n.Target.AbstractTarget().Name
+}
+
+// String returns the name of the target qualified with the name of its project, like `Kit/KitTests`.
+func (n *TargetNode) String() string {
+	if projectName := n.Project.Name(); projectName != "" {
+		return projectName + "/" + n.Name()
+	}
+	return n.Name()
+}
+
+// TargetDependencyCycleError is returned if targets (transitively) depend on each other.
+type TargetDependencyCycleError struct {
+	// Cycle lists the targets of the cycle, the first and the last item is the same target.
+	Cycle []*TargetNode
+}
+
+func (e *TargetDependencyCycleError) Error() string {
+	names := []string{}
+	for _, node := range e.Cycle {
+		names = append(names, node.String())
+	}
+	return fmt.Sprintf("target dependency cycle: %s", strings.Join(names, " -> "))
+}
+
+// TargetGraph is the dependency graph of native, aggregate and legacy targets.
+// Dependencies on targets of other projects (through a PBXContainerItemProxy) are followed into the referenced projects.
+type TargetGraph struct {
+	nodes         []*TargetNode
+	nodesByTarget map[Target]*TargetNode
+	dependencies  map[*TargetNode][]*TargetNode
+	dependents    map[*TargetNode][]*TargetNode
+
+	// projects caches the projects by path, each project is opened once
+	projects map[string]*Project
+}
+
+// ProjectTargetGraph returns the dependency graph of the project's targets.
+func ProjectTargetGraph(projectPth string) (*TargetGraph, error) {
+	project, err := OpenProject(projectPth)
+	if err != nil {
+		return nil, err
+	}
+	return NewTargetGraph(project)
+}
+
+// WorkspaceTargetGraph returns the dependency graph of the targets of the workspace's projects.
+func WorkspaceTargetGraph(workspacePth string) (*TargetGraph, error) {
+	return WorkspaceTargetGraphWithOptions(workspacePth, WorkspaceListOptions{})
+}
+
+// WorkspaceTargetGraphWithOptions is WorkspaceTargetGraph over the projects selected by the options.
+func WorkspaceTargetGraphWithOptions(workspacePth string, opts WorkspaceListOptions) (*TargetGraph, error) {
+	_, projectPths, err := workspaceContainers(workspacePth, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := []*Project{}
+	for _, projectPth := range projectPths {
+		project, err := OpenProject(projectPth)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return NewTargetGraph(projects...)
+}
+
+// NewTargetGraph returns the dependency graph of every target of the given projects
+// and of the targets of other projects, which they depend on.
+func NewTargetGraph(projects ...*Project) (*TargetGraph, error) {
+	g := &TargetGraph{
+		nodes:         []*TargetNode{},
+		nodesByTarget: map[Target]*TargetNode{},
+		dependencies:  map[*TargetNode][]*TargetNode{},
+		dependents:    map[*TargetNode][]*TargetNode{},
+		projects:      map[string]*Project{},
+	}
+
+	for _, project := range projects {
+		if project.Path != "" {
+			g.projects[filepath.Clean(project.Path)] = project
+		}
+	}
+	for _, project := range projects {
+		for _, target := range project.Targets() {
+			g.addNode(project, target)
+		}
+	}
+
+	// nodes of other projects are appended while walking the dependencies
+	for i := 0; i < len(g.nodes); i++ {
+		node := g.nodes[i]
+		for _, dependency := range node.Target.AbstractTarget().Dependencies {
+			project, target, err := g.dependencyTarget(node.Project, dependency)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve dependency %s of target %s: %s", dependency.ID, node, err)
+			}
+			if target == nil {
+				continue
+			}
+
+			dependencyNode := g.addNode(project, target)
+			if g.dependsOn(node, dependencyNode) {
+				continue
+			}
+			g.dependencies[node] = append(g.dependencies[node], dependencyNode)
+			g.dependents[dependencyNode] = append(g.dependents[dependencyNode], node)
+		}
+	}
+
+	return g, nil
+}
+
+func (g *TargetGraph) addNode(project *Project, target Target) *TargetNode {
+	if node, found := g.nodesByTarget[target]; found {
+		return node
+	}
+
+	node := &TargetNode{Project: project, Target: target}
+	g.nodes = append(g.nodes, node)
+	g.nodesByTarget[target] = node
+	return node
+}
+
+func (g *TargetGraph) project(pth string) (*Project, error) {
+	pth = filepath.Clean(pth)
+	if project, found := g.projects[pth]; found {
+		return project, nil
+	}
+
+	project, err := OpenProject(pth)
+	if err != nil {
+		return nil, err
+	}
+	g.projects[pth] = project
+	return project, nil
+}
+
+// dependencyTarget returns the target of the dependency with its project,
+// or nil if the dependency does not refer to a target (like a Swift package product dependency).
+func (g *TargetGraph) dependencyTarget(project *Project, dependency *PBXTargetDependency) (*Project, Target, error) {
+	if dependency.Target != nil {
+		return project, dependency.Target, nil
+	}
+
+	proxy := dependency.TargetProxy
+	if proxy == nil || proxy.ContainerPortal == nil {
+		return nil, nil, nil
+	}
+
+	remoteProject := project
+	if project.RootObject == nil || proxy.ContainerPortal.ObjectID() != project.RootObject.ID {
+		portal, ok := proxy.ContainerPortal.(FileElement)
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported container portal: %s", proxy.ContainerPortal.ObjectIsa())
+		}
+
+		pth, err := project.FileElementPath(portal)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		remoteProject, err = g.project(pth)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	target, ok := remoteProject.Objects[proxy.RemoteGlobalIDString].(Target)
+	if !ok {
+		return nil, nil, fmt.Errorf("target %s (%s) not found in %s", proxy.RemoteInfo, proxy.RemoteGlobalIDString, remoteProject.Path)
+	}
+	return remoteProject, target, nil
+}
+
+// Nodes returns the targets of the graph: the targets of the projects, then the targets of other projects in discovery order.
+func (g *TargetGraph) Nodes() []*TargetNode {
+	return g.nodes
+}
+
+// Node returns the node of the target.
+func (g *TargetGraph) Node(target Target) (*TargetNode, bool) {
+	node, found := g.nodesByTarget[target]
+	return node, found
+}
+
+// NodeByName returns the first node with the given target name.
+func (g *TargetGraph) NodeByName(name string) (*TargetNode, bool) {
+	for _, node := range g.nodes {
+		if node.Name() == name {
+			return node, true
+		}
+	}
+	return nil, false
+}
+
+// Dependencies returns the direct dependencies of the target, in the order of its dependencies list.
+func (g *TargetGraph) Dependencies(node *TargetNode) []*TargetNode {
+	return append([]*TargetNode{}, g.dependencies[node]...)
+}
+
+// Dependents returns the targets, which directly depend on the target.
+func (g *TargetGraph) Dependents(node *TargetNode) []*TargetNode {
+	return append([]*TargetNode{}, g.dependents[node]...)
+}
+
+// TransitiveDependencies returns every target, which the target (transitively) depends on, in depth-first order.
+func (g *TargetGraph) TransitiveDependencies(node *TargetNode) []*TargetNode {
+	return g.reachable(node, g.dependencies)
+}
+
+// TransitiveDependents returns every target, which (transitively) depends on the target, in depth-first order.
+func (g *TargetGraph) TransitiveDependents(node *TargetNode) []*TargetNode {
+	return g.reachable(node, g.dependents)
+}
+
+func (g *TargetGraph) reachable(node *TargetNode, edges map[*TargetNode][]*TargetNode) []*TargetNode {
+	visited := map[*TargetNode]bool{node: true}
+	reachable := []*TargetNode{}
+
+	var visit func(*TargetNode)
+	visit = func(n *TargetNode) {
+		for _, next := range edges[n] {
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			reachable = append(reachable, next)
+			visit(next)
+		}
+	}
+	visit(node)
+
+	return reachable
+}
+
+// Cycles returns the groups of targets, which depend on each other (the strongly connected components with a cycle).
+func (g *TargetGraph) Cycles() [][]*TargetNode {
+	// Tarjan's strongly connected components algorithm
+	index := 0
+	indexes := map[*TargetNode]int{}
+	lowLinks := map[*TargetNode]int{}
+	onStack := map[*TargetNode]bool{}
+	stack := []*TargetNode{}
+	components := [][]*TargetNode{}
+
+	var connect func(*TargetNode)
+	connect = func(node *TargetNode) {
+		indexes[node] = index
+		lowLinks[node] = index
+		index++
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, dependency := range g.dependencies[node] {
+			if _, visited := indexes[dependency]; !visited {
+				connect(dependency)
+				if lowLinks[dependency] < lowLinks[node] {
+					lowLinks[node] = lowLinks[dependency]
+				}
+			} else if onStack[dependency] && indexes[dependency] < lowLinks[node] {
+				lowLinks[node] = indexes[dependency]
+			}
+		}
+
+		if lowLinks[node] != indexes[node] {
+			return
+		}
+
+		component := []*TargetNode{}
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == node {
+				break
+			}
+		}
+		components = append(components, component)
+	}
+
+	for _, node := range g.nodes {
+		if _, visited := indexes[node]; !visited {
+			connect(node)
+		}
+	}
+
+	cycles := [][]*TargetNode{}
+	for _, component := range components {
+		if len(component) == 1 && !g.dependsOn(component[0], component[0]) {
+			continue
+		}
+
+		// list the targets of the cycle in graph order
+		inComponent := map[*TargetNode]bool{}
+		for _, node := range component {
+			inComponent[node] = true
+		}
+		cycle := []*TargetNode{}
+		for _, node := range g.nodes {
+			if inComponent[node] {
+				cycle = append(cycle, node)
+			}
+		}
+		cycles = append(cycles, cycle)
+	}
+	return cycles
+}
+
+func (g *TargetGraph) dependsOn(node, dependency *TargetNode) bool {
+	for _, n := range g.dependencies[node] {
+		if n == dependency {
+			return true
+		}
+	}
+	return false
+}
+
+// TopologicalOrder returns every target of the graph in build order: each target is listed after its dependencies.
+func (g *TargetGraph) TopologicalOrder() ([]*TargetNode, error) {
+	return g.BuildOrder(g.nodes...)
+}
+
+// BuildOrder returns the given targets and their transitive dependencies in build order:
+// each target is listed after its dependencies, otherwise the order of the graph is kept.
+func (g *TargetGraph) BuildOrder(nodes ...*TargetNode) ([]*TargetNode, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	states := map[*TargetNode]int{}
+	path := []*TargetNode{}
+	order := []*TargetNode{}
+
+	var visit func(*TargetNode) error
+	visit = func(node *TargetNode) error {
+		switch states[node] {
+		case visited:
+			return nil
+		case visiting:
+			for i, n := range path {
+				if n == node {
+					cycle := append(append([]*TargetNode{}, path[i:]...), node)
+					return &TargetDependencyCycleError{Cycle: cycle}
+				}
+			}
+		}
+
+		states[node] = visiting
+		path = append(path, node)
+		for _, dependency := range g.dependencies[node] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[node] = visited
+
+		order = append(order, node)
+		return nil
+	}
+
+	for _, node := range nodes {
+		if err := visit(node); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
@@ -0,0 +1,289 @@
+package xcodeproj
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Node kinds of the exported target graph
+const (
+	graphNodeTarget         = "target"
+	graphNodePackageProduct = "package-product"
+	graphNodeFramework      = "framework"
+)
+
+// Edge kinds of the exported target graph
+const (
+	graphEdgeDependency     = "dependency"
+	graphEdgeLink           = "link"
+	graphEdgeEmbed          = "embed"
+	graphEdgePackageProduct = "package-product"
+)
+
+type graphNode struct {
+	id    string
+	kind  string
+	label string
+}
+
+type graphEdge struct {
+	from string
+	to   string
+	kind string
+}
+
+// graphExport is the format independent content of the exported target graph.
+type graphExport struct {
+	nodes     []graphNode
+	nodeIDs   map[string]string
+	edges     []graphEdge
+	edgeFound map[graphEdge]bool
+}
+
+// DOT renders the graph in Graphviz DOT format:
+// targets with their product type, their dependencies, linked and embedded frameworks and Swift package products.
+func (g *TargetGraph) DOT() string {
+	export := g.export()
+
+	var buffer bytes.Buffer
+	buffer.WriteString("digraph targets {\n")
+	buffer.WriteString("\trankdir=LR;\n")
+	buffer.WriteString("\tnode [shape=box];\n")
+
+	for _, node := range export.nodes {
+		attributes := fmt.Sprintf("label=%s", dotQuote(node.label))
+		switch node.kind {
+		case graphNodePackageProduct:
+			attributes += ", shape=component"
+		case graphNodeFramework:
+			attributes += ", shape=folder"
+		}
+		buffer.WriteString(fmt.Sprintf("\t%s [%s];\n", node.id, attributes))
+	}
+
+	for _, edge := range export.edges {
+		attributes := ""
+		switch edge.kind {
+		case graphEdgeLink:
+			attributes = ` [label="links", style=dashed]`
+		case graphEdgeEmbed:
+			attributes = ` [label="embeds", style=bold]`
+		case graphEdgePackageProduct:
+			attributes = ` [label="package", style=dashed]`
+		}
+		buffer.WriteString(fmt.Sprintf("\t%s -> %s%s;\n", edge.from, edge.to, attributes))
+	}
+
+	buffer.WriteString("}\n")
+	return buffer.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart, with the same content as DOT.
+func (g *TargetGraph) Mermaid() string {
+	export := g.export()
+
+	var buffer bytes.Buffer
+	buffer.WriteString("graph LR\n")
+
+	for _, node := range export.nodes {
+		label := mermaidQuote(node.label)
+		switch node.kind {
+		case graphNodePackageProduct:
+			buffer.WriteString(fmt.Sprintf("\t%s([%s])\n", node.id, label))
+		case graphNodeFramework:
+			buffer.WriteString(fmt.Sprintf("\t%s[(%s)]\n", node.id, label))
+		default:
+			buffer.WriteString(fmt.Sprintf("\t%s[%s]\n", node.id, label))
+		}
+	}
+
+	for _, edge := range export.edges {
+		switch edge.kind {
+		case graphEdgeLink:
+			buffer.WriteString(fmt.Sprintf("\t%s -. links .-> %s\n", edge.from, edge.to))
+		case graphEdgeEmbed:
+			buffer.WriteString(fmt.Sprintf("\t%s == embeds ==> %s\n", edge.from, edge.to))
+		case graphEdgePackageProduct:
+			buffer.WriteString(fmt.Sprintf("\t%s -. package .-> %s\n", edge.from, edge.to))
+		default:
+			buffer.WriteString(fmt.Sprintf("\t%s --> %s\n", edge.from, edge.to))
+		}
+	}
+
+	return buffer.String()
+}
+
+func dotQuote(str string) string {
+	str = strings.Replace(str, `\`, `\\`, -1)
+	str = strings.Replace(str, `"`, `\"`, -1)
+	str = strings.Replace(str, "\n", `\n`, -1)
+	return `"` + str + `"`
+}
+
+func mermaidQuote(str string) string {
+	str = strings.Replace(str, `"`, "#quot;", -1)
+	str = strings.Replace(str, "\n", "<br/>", -1)
+	return `"` + str + `"`
+}
+
+// targetNodeLabel returns the qualified name of the target with its kind: the product type of native targets,
+// like `Kit/Kit\nframework`.
+func targetNodeLabel(node *TargetNode) string {
+	switch target := node.Target.(type) {
+	case *PBXNativeTarget:
+		return node.String() + "\n" + target.ProductType.Name()
+	case *PBXAggregateTarget:
+		return node.String() + "\naggregate"
+	case *PBXLegacyTarget:
+		return node.String() + "\nlegacy"
+	}
+	return node.String()
+}
+
+func (e *graphExport) addNode(key, kind, label string) string {
+	if id, found := e.nodeIDs[key]; found {
+		return id
+	}
+
+	id := fmt.Sprintf("n%d", len(e.nodes))
+	e.nodes = append(e.nodes, graphNode{id: id, kind: kind, label: label})
+	e.nodeIDs[key] = id
+	return id
+}
+
+func (e *graphExport) addEdge(from, to, kind string) {
+	edge := graphEdge{from: from, to: to, kind: kind}
+	if e.edgeFound[edge] {
+		return
+	}
+	e.edgeFound[edge] = true
+	e.edges = append(e.edges, edge)
+}
+
+func (g *TargetGraph) export() graphExport {
+	export := graphExport{
+		nodes:     []graphNode{},
+		nodeIDs:   map[string]string{},
+		edges:     []graphEdge{},
+		edgeFound: map[graphEdge]bool{},
+	}
+
+	targetNodeIDs := map[*TargetNode]string{}
+	for _, node := range g.nodes {
+		targetNodeIDs[node] = export.addNode(node.Project.Path+"/"+node.Target.ObjectID(), graphNodeTarget, targetNodeLabel(node))
+	}
+
+	for _, node := range g.nodes {
+		from := targetNodeIDs[node]
+
+		for _, dependency := range g.dependencies[node] {
+			export.addEdge(from, targetNodeIDs[dependency], graphEdgeDependency)
+		}
+
+		nativeTarget, ok := node.Target.(*PBXNativeTarget)
+		if !ok {
+			continue
+		}
+
+		for _, product := range nativeTarget.PackageProductDependencies {
+			export.addEdge(from, g.exportPackageProduct(&export, node.Project, product), graphEdgePackageProduct)
+		}
+
+		for _, buildPhase := range nativeTarget.BuildPhases {
+			kind := ""
+			switch phase := buildPhase.(type) {
+			case *PBXFrameworksBuildPhase:
+				kind = graphEdgeLink
+			case *PBXCopyFilesBuildPhase:
+				if phase.DstSubfolderSpec == CopyFilesDestinationFrameworks {
+					kind = graphEdgeEmbed
+				}
+			}
+			if kind == "" {
+				continue
+			}
+
+			for _, buildFile := range buildPhase.AbstractBuildPhase().Files {
+				if buildFile.ProductRef != nil {
+					// a linked package product is the same edge as the package product dependency
+					productKind := kind
+					if productKind == graphEdgeLink {
+						productKind = graphEdgePackageProduct
+					}
+					export.addEdge(from, g.exportPackageProduct(&export, node.Project, buildFile.ProductRef), productKind)
+				} else if buildFile.FileRef != nil {
+					// a product of the target itself is not a link
+					if to := g.exportFramework(&export, targetNodeIDs, node.Project, buildFile.FileRef); to != "" && to != from {
+						export.addEdge(from, to, kind)
+					}
+				}
+			}
+		}
+	}
+
+	return export
+}
+
+func (g *TargetGraph) exportPackageProduct(export *graphExport, project *Project, product *XCSwiftPackageProductDependency) string {
+	key := "package:" + product.ProductName
+	if product.Package != nil {
+		key = "package:" + project.Path + "/" + product.Package.ObjectID() + "/" + product.ProductName
+	}
+	return export.addNode(key, graphNodePackageProduct, product.ProductName)
+}
+
+// exportFramework returns the node of a linked or embedded file:
+// the target, which builds the product, or a framework node; SDK and developer frameworks are skipped.
+func (g *TargetGraph) exportFramework(export *graphExport, targetNodeIDs map[*TargetNode]string, project *Project, fileRef FileElement) string {
+	if node := g.productTarget(project, fileRef); node != nil {
+		return targetNodeIDs[node]
+	}
+
+	fileElement := fileRef.AbstractFileElement()
+	switch fileElement.SourceTree {
+	case SourceTreeSDKRoot, SourceTreeDeveloper:
+		return ""
+	}
+
+	name := fileElement.DisplayName()
+	return export.addNode("framework:"+name, graphNodeFramework, name)
+}
+
+// productTarget returns the target of the graph, which builds the product:
+// a target of the project or, for a PBXReferenceProxy, a target of the referenced project.
+func (g *TargetGraph) productTarget(project *Project, fileRef FileElement) *TargetNode {
+	productProject := project
+	productID := fileRef.ObjectID()
+
+	if proxy, ok := fileRef.(*PBXReferenceProxy); ok {
+		if proxy.RemoteRef == nil || proxy.RemoteRef.ContainerPortal == nil {
+			return nil
+		}
+		portal, ok := proxy.RemoteRef.ContainerPortal.(FileElement)
+		if !ok {
+			return nil
+		}
+		pth, err := project.FileElementPath(portal)
+		if err != nil {
+			return nil
+		}
+
+		productProject, ok = g.projects[filepath.Clean(pth)]
+		if !ok {
+			return nil
+		}
+		productID = proxy.RemoteRef.RemoteGlobalIDString
+	}
+
+	for _, node := range g.nodes {
+		if node.Project != productProject {
+			continue
+		}
+		if target, ok := node.Target.(*PBXNativeTarget); ok && target.ProductReference != nil && target.ProductReference.ID == productID {
+			return node
+		}
+	}
+	return nil
+}
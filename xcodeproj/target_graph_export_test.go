@@ -0,0 +1,133 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// kitPackagesPbxprojContent is the Kit project, which Kit target links the Alamofire Swift package product.
+var kitPackagesPbxprojContent = strings.NewReplacer(
+	`		C4F1B3222245E0A700D2C8F1 /* Kit.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = C4F1B3022245E0A700D2C8F1 /* Kit.framework */; };
+`, `		C4F1B3222245E0A700D2C8F1 /* Kit.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = C4F1B3022245E0A700D2C8F1 /* Kit.framework */; };
+		C4F1B3372245E0A700D2C8F1 /* Alamofire in Frameworks */ = {isa = PBXBuildFile; productRef = C4F1B3362245E0A700D2C8F1 /* Alamofire */; };
+`,
+	`				C4F1B3182245E0A700D2C8F1 /* Sub.framework in Frameworks */,
+`, `				C4F1B3182245E0A700D2C8F1 /* Sub.framework in Frameworks */,
+				C4F1B3372245E0A700D2C8F1 /* Alamofire in Frameworks */,
+`,
+	`			name = Kit;
+			productName = Kit;
+`, `			name = Kit;
+			packageProductDependencies = (
+				C4F1B3362245E0A700D2C8F1 /* Alamofire */,
+			);
+			productName = Kit;
+`,
+	`/* End XCVersionGroup section */
+`, `/* End XCVersionGroup section */
+
+/* Begin XCRemoteSwiftPackageReference section */
+		C4F1B3352245E0A700D2C8F1 /* XCRemoteSwiftPackageReference "Alamofire" */ = {
+			isa = XCRemoteSwiftPackageReference;
+			repositoryURL = "https://github.com/Alamofire/Alamofire.git";
+			requirement = {
+				kind = upToNextMajorVersion;
+				minimumVersion = 5.0.0;
+			};
+		};
+/* End XCRemoteSwiftPackageReference section */
+
+/* Begin XCSwiftPackageProductDependency section */
+		C4F1B3362245E0A700D2C8F1 /* Alamofire */ = {
+			isa = XCSwiftPackageProductDependency;
+			package = C4F1B3352245E0A700D2C8F1 /* XCRemoteSwiftPackageReference "Alamofire" */;
+			productName = Alamofire;
+		};
+/* End XCSwiftPackageProductDependency section */
+`,
+).Replace(kitPbxprojContent)
+
+func TestTargetGraphExport(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	// KitTests links a Carthage built framework and an SDK framework
+	content := strings.NewReplacer(
+		`				C4F1B3222245E0A700D2C8F1 /* Kit.framework in Frameworks */,
+`, `				C4F1B3222245E0A700D2C8F1 /* Kit.framework in Frameworks */,
+				C4F1B33A2245E0A700D2C8F1 /* Realm.framework in Frameworks */,
+				C4F1B33B2245E0A700D2C8F1 /* XCTest.framework in Frameworks */,
+`,
+		`/* End PBXBuildFile section */
+`, `		C4F1B33A2245E0A700D2C8F1 /* Realm.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = C4F1B3382245E0A700D2C8F1 /* Realm.framework */; };
+		C4F1B33B2245E0A700D2C8F1 /* XCTest.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = C4F1B3392245E0A700D2C8F1 /* XCTest.framework */; };
+/* End PBXBuildFile section */
+`,
+		`/* End PBXFileReference section */
+`, `		C4F1B3382245E0A700D2C8F1 /* Realm.framework */ = {isa = PBXFileReference; lastKnownFileType = wrapper.framework; name = Realm.framework; path = Carthage/Build/iOS/Realm.framework; sourceTree = "<group>"; };
+		C4F1B3392245E0A700D2C8F1 /* XCTest.framework */ = {isa = PBXFileReference; lastKnownFileType = wrapper.framework; name = XCTest.framework; path = Library/Frameworks/XCTest.framework; sourceTree = SDKROOT; };
+/* End PBXFileReference section */
+`,
+	).Replace(kitPackagesPbxprojContent)
+
+	writeTestProject(t, filepath.Join(tmpDir, "Kit", "Kit.xcodeproj"), content)
+	writeTestProject(t, filepath.Join(tmpDir, "Sub", "Sub.xcodeproj"), subPbxprojContent)
+
+	graph, err := ProjectTargetGraph(filepath.Join(tmpDir, "Kit", "Kit.xcodeproj"))
+	require.NoError(t, err)
+
+	t.Log("DOT")
+	{
+		require.Equal(t, `digraph targets {
+	rankdir=LR;
+	node [shape=box];
+	n0 [label="Kit/Kit\nframework"];
+	n1 [label="Kit/KitTests\nbundle.unit-test"];
+	n2 [label="Kit/Docs\nlegacy"];
+	n3 [label="Sub/Kit\nframework"];
+	n4 [label="Alamofire", shape=component];
+	n5 [label="Realm.framework", shape=folder];
+	n0 -> n4 [label="package", style=dashed];
+	n0 -> n3 [label="links", style=dashed];
+	n0 -> n3 [label="embeds", style=bold];
+	n1 -> n0;
+	n1 -> n3;
+	n1 -> n0 [label="links", style=dashed];
+	n1 -> n5 [label="links", style=dashed];
+}
+`, graph.DOT())
+	}
+
+	t.Log("Mermaid")
+	{
+		require.Equal(t, `graph LR
+	n0["Kit/Kit<br/>framework"]
+	n1["Kit/KitTests<br/>bundle.unit-test"]
+	n2["Kit/Docs<br/>legacy"]
+	n3["Sub/Kit<br/>framework"]
+	n4(["Alamofire"])
+	n5[("Realm.framework")]
+	n0 -. package .-> n4
+	n0 -. links .-> n3
+	n0 == embeds ==> n3
+	n1 --> n0
+	n1 --> n3
+	n1 -. links .-> n0
+	n1 -. links .-> n5
+`, graph.Mermaid())
+	}
+
+	t.Log("quoting")
+	{
+		require.Equal(t, `"My \"App\"\nframework"`, dotQuote("My \"App\"\nframework"))
+		require.Equal(t, `"My #quot;App#quot;<br/>framework"`, mermaidQuote("My \"App\"\nframework"))
+	}
+}
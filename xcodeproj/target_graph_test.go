@@ -0,0 +1,131 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// subPbxprojContent is the Kit project, which Kit target and product have the IDs referenced by Kit.xcodeproj's Sub proxies.
+var subPbxprojContent = strings.NewReplacer(
+	"C4F1B3042245E0A700D2C8F1", "0E57A1D21F0000AA00C1D2E3",
+	"C4F1B3022245E0A700D2C8F1", "0E57A1D11F0000AA00C1D2E3",
+).Replace(kitPbxprojContent)
+
+func nodeNames(nodes []*TargetNode) []string {
+	names := []string{}
+	for _, node := range nodes {
+		names = append(names, node.String())
+	}
+	return names
+}
+
+func TestTargetGraph(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	writeTestProject(t, filepath.Join(tmpDir, "Kit", "Kit.xcodeproj"), kitPbxprojContent)
+	writeTestProject(t, filepath.Join(tmpDir, "Sub", "Sub.xcodeproj"), subPbxprojContent)
+
+	t.Log("project graph with cross-project dependencies")
+	{
+		graph, err := ProjectTargetGraph(filepath.Join(tmpDir, "Kit", "Kit.xcodeproj"))
+		require.NoError(t, err)
+		require.Equal(t, []string{"Kit/Kit", "Kit/KitTests", "Kit/Docs", "Sub/Kit"}, nodeNames(graph.Nodes()))
+
+		kitTests, found := graph.NodeByName("KitTests")
+		require.Equal(t, true, found)
+		require.Equal(t, []string{"Kit/Kit", "Sub/Kit"}, nodeNames(graph.Dependencies(kitTests)))
+		require.Equal(t, []string{}, nodeNames(graph.Dependents(kitTests)))
+
+		subKit := graph.Dependencies(kitTests)[1]
+		require.Equal(t, filepath.Join(tmpDir, "Sub", "Sub.xcodeproj"), subKit.Project.Path)
+		require.Equal(t, []string{"Kit/KitTests"}, nodeNames(graph.Dependents(subKit)))
+		require.Equal(t, []string{"Kit/KitTests"}, nodeNames(graph.TransitiveDependents(subKit)))
+		require.Equal(t, []string{"Kit/Kit", "Sub/Kit"}, nodeNames(graph.TransitiveDependencies(kitTests)))
+
+		node, found := graph.Node(kitTests.Target)
+		require.Equal(t, true, found)
+		require.Equal(t, kitTests, node)
+
+		require.Equal(t, 0, len(graph.Cycles()))
+
+		order, err := graph.TopologicalOrder()
+		require.NoError(t, err)
+		require.Equal(t, []string{"Kit/Kit", "Sub/Kit", "Kit/KitTests", "Kit/Docs"}, nodeNames(order))
+
+		order, err = graph.BuildOrder(kitTests)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Kit/Kit", "Sub/Kit", "Kit/KitTests"}, nodeNames(order))
+	}
+
+	t.Log("workspace graph")
+	{
+		workspacePth := filepath.Join(tmpDir, "Kit.xcworkspace")
+		writeTestWorkspace(t, workspacePth, `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:Kit/Kit.xcodeproj">
+   </FileRef>
+   <FileRef
+      location = "group:Sub/Sub.xcodeproj">
+   </FileRef>
+</Workspace>
+`)
+
+		graph, err := WorkspaceTargetGraph(workspacePth)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Kit/Kit", "Kit/KitTests", "Kit/Docs", "Sub/Kit", "Sub/KitTests", "Sub/Docs"}, nodeNames(graph.Nodes()))
+
+		// Sub's KitTests depends on its Kit both directly and through a proxy to its own project
+		subKitTests := graph.Nodes()[4]
+		require.Equal(t, []string{"Sub/Kit"}, nodeNames(graph.Dependencies(subKitTests)))
+		require.Equal(t, []string{"Kit/KitTests", "Sub/KitTests"}, nodeNames(graph.Dependents(graph.Nodes()[3])))
+	}
+
+	t.Log("dependency cycle")
+	{
+		content := strings.Replace(kitPbxprojContent, `			dependencies = (
+			);
+			name = Kit;`, `			dependencies = (
+				C4F1B3342245E0A700D2C8F1 /* PBXTargetDependency */,
+			);
+			name = Kit;`, 1)
+		content = strings.Replace(content, "/* End PBXTargetDependency section */", `		C4F1B3342245E0A700D2C8F1 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = C4F1B31B2245E0A700D2C8F1 /* KitTests */;
+		};
+/* End PBXTargetDependency section */`, 1)
+		writeTestProject(t, filepath.Join(tmpDir, "Cycle", "Kit.xcodeproj"), content)
+
+		graph, err := ProjectTargetGraph(filepath.Join(tmpDir, "Cycle", "Kit.xcodeproj"))
+		require.NoError(t, err)
+
+		cycles := graph.Cycles()
+		require.Equal(t, 1, len(cycles))
+		require.Equal(t, []string{"Kit/Kit", "Kit/KitTests"}, nodeNames(cycles[0]))
+
+		_, err = graph.TopologicalOrder()
+		require.EqualError(t, err, "target dependency cycle: Kit/Kit -> Kit/KitTests -> Kit/Kit")
+		cycleErr, ok := err.(*TargetDependencyCycleError)
+		require.Equal(t, true, ok)
+		require.Equal(t, 3, len(cycleErr.Cycle))
+	}
+
+	t.Log("missing sub-project")
+	{
+		writeTestProject(t, filepath.Join(tmpDir, "Missing", "Kit", "Kit.xcodeproj"), kitPbxprojContent)
+
+		_, err := ProjectTargetGraph(filepath.Join(tmpDir, "Missing", "Kit", "Kit.xcodeproj"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to resolve dependency C4F1B3262245E0A700D2C8F1 of target Kit/KitTests")
+	}
+}
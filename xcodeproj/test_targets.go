@@ -0,0 +1,156 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// ProjectBuildTargetTestTargets maps the names of the project's build (non test) targets to the names of their test targets.
+func ProjectBuildTargetTestTargets(projectPth string) (map[string][]string, error) {
+	project, err := OpenProject(projectPth)
+	if err != nil {
+		return map[string][]string{}, err
+	}
+
+	return project.BuildTargetTestTargets(), nil
+}
+
+// WorkspaceBuildTargetTestTargets is ProjectBuildTargetTestTargets merged over the projects of the workspace.
+func WorkspaceBuildTargetTestTargets(workspacePth string) (map[string][]string, error) {
+	return WorkspaceBuildTargetTestTargetsWithOptions(workspacePth, WorkspaceListOptions{})
+}
+
+// WorkspaceBuildTargetTestTargetsWithOptions is WorkspaceBuildTargetTestTargets over the projects selected by the options.
+func WorkspaceBuildTargetTestTargetsWithOptions(workspacePth string, opts WorkspaceListOptions) (map[string][]string, error) {
+	_, projects, err := workspaceContainers(workspacePth, opts)
+	if err != nil {
+		return map[string][]string{}, err
+	}
+
+	testTargetsByBuildTarget := map[string][]string{}
+	for _, projectPth := range projects {
+		projectTestTargetsByBuildTarget, err := ProjectBuildTargetTestTargets(projectPth)
+		if err != nil {
+			return map[string][]string{}, err
+		}
+
+		for buildTarget, testTargets := range projectTestTargetsByBuildTarget {
+			merged := testTargetsByBuildTarget[buildTarget]
+			for _, testTarget := range testTargets {
+				// projects of the workspace can have targets of the same name, like copies of a project
+				if !containsString(merged, testTarget) {
+					merged = append(merged, testTarget)
+				}
+			}
+			testTargetsByBuildTarget[buildTarget] = merged
+		}
+	}
+
+	return testTargetsByBuildTarget, nil
+}
+
+// BuildTargetTestTargets maps the names of the build (non test) targets to the names of their test targets.
+// A test target belongs to a build target if it depends on it, hosts its tests in it (TEST_HOST)
+// or tests it as a UI test (TEST_TARGET_NAME). The build settings are resolved with the target's default configuration,
+// test targets, which build settings can not be resolved (like because of a missing xcconfig file), are mapped by their dependencies only.
+// Dependencies on targets of other projects are not followed.
+func (p *Project) BuildTargetTestTargets() map[string][]string {
+	buildTargets, testTargetsByBuildTarget := projectBuildTargetTestTargets(p)
+
+	buildTargetsByProduct := map[string]*PBXNativeTarget{}
+	for _, buildTarget := range buildTargets {
+		if productPth := p.productPath(buildTarget); productPth != "" {
+			buildTargetsByProduct[filepath.Base(productPth)] = buildTarget
+		}
+	}
+
+	for _, testTarget := range p.NativeTargets() {
+		if !testTarget.ProductType.IsTest() {
+			continue
+		}
+
+		settings, err := p.testTargetBuildSettings(testTarget)
+		if err != nil || settings == nil {
+			continue
+		}
+
+		if testHost, ok := settings.Value("TEST_HOST"); ok {
+			if buildTarget := testHostTarget(testHost, buildTargetsByProduct); buildTarget != nil {
+				testTargetsByBuildTarget[buildTarget.ID] = appendTargetIfMissing(testTargetsByBuildTarget[buildTarget.ID], testTarget)
+			}
+		}
+
+		if testTargetName, ok := settings.Value("TEST_TARGET_NAME"); ok {
+			for _, buildTarget := range buildTargets {
+				if buildTarget.Name == testTargetName {
+					testTargetsByBuildTarget[buildTarget.ID] = appendTargetIfMissing(testTargetsByBuildTarget[buildTarget.ID], testTarget)
+				}
+			}
+		}
+	}
+
+	testTargetNamesByBuildTarget := map[string][]string{}
+	for _, buildTarget := range buildTargets {
+		testTargetNames := []string{}
+		for _, target := range p.NativeTargets() {
+			for _, testTarget := range testTargetsByBuildTarget[buildTarget.ID] {
+				if testTarget == target {
+					testTargetNames = append(testTargetNames, target.Name)
+				}
+			}
+		}
+		testTargetNamesByBuildTarget[buildTarget.Name] = testTargetNames
+	}
+
+	return testTargetNamesByBuildTarget
+}
+
+// testTargetBuildSettings resolves the build settings of the target's default configuration,
+// returns nil if the target has no build configuration.
+func (p *Project) testTargetBuildSettings(target *PBXNativeTarget) (*ResolvedBuildSettings, error) {
+	configurationList := target.BuildConfigurationList
+	if configurationList == nil || len(configurationList.BuildConfigurations) == 0 {
+		return nil, nil
+	}
+
+	configuration := configurationList.DefaultConfigurationName
+	if _, found := configurationList.BuildConfiguration(configuration); !found {
+		configuration = configurationList.BuildConfigurations[0].Name
+	}
+
+	settings, err := p.ResolveBuildSettings(target, configuration, BuildSettingsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve build settings of target %s: %s", target.Name, err)
+	}
+	return settings, nil
+}
+
+// testHostTarget returns the build target, which product is the application of the TEST_HOST,
+// like `$(BUILT_PRODUCTS_DIR)/SampleApp.app/SampleApp` or `$(BUILT_PRODUCTS_DIR)/SampleApp.app/Contents/MacOS/SampleApp`.
+func testHostTarget(testHost string, buildTargetsByProduct map[string]*PBXNativeTarget) *PBXNativeTarget {
+	for _, component := range strings.Split(testHost, "/") {
+		if filepath.Ext(component) == ".app" {
+			return buildTargetsByProduct[component]
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func appendTargetIfMissing(targets []*PBXNativeTarget, target *PBXNativeTarget) []*PBXNativeTarget {
+	for _, t := range targets {
+		if t == target {
+			return targets
+		}
+	}
+	return append(targets, target)
+}
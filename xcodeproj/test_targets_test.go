@@ -0,0 +1,145 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeSampleAppProject(t *testing.T, dir, content string) string {
+	projectPth := filepath.Join(dir, "SampleApp.xcodeproj")
+	writeTestProject(t, projectPth, content)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "Base.xcconfig"), []byte(baseXCConfigContent), 0644))
+	return projectPth
+}
+
+func TestBuildTargetTestTargets(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	t.Log("test targets depending on the build target")
+	{
+		projectPth := writeSampleAppProject(t, filepath.Join(tmpDir, "dependencies"), sampleAppPbxprojContent)
+
+		testTargetsByBuildTarget, err := ProjectBuildTargetTestTargets(projectPth)
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{
+			"SampleApp": []string{"SampleAppTests", "SampleAppUITests"},
+		}, testTargetsByBuildTarget)
+	}
+
+	t.Log("test targets without dependencies, mapped by TEST_HOST and TEST_TARGET_NAME")
+	{
+		content := strings.Replace(sampleAppPbxprojContent, "\t\t\t\t8D3E2A202176C1D300A4F1B2 /* PBXTargetDependency */,\n", "", 1)
+		content = strings.Replace(content, "\t\t\t\t8D3E2A2B2176C1D300A4F1B2 /* PBXTargetDependency */,\n", "", 1)
+		projectPth := writeSampleAppProject(t, filepath.Join(tmpDir, "build_settings"), content)
+
+		project, err := OpenProject(projectPth)
+		require.NoError(t, err)
+		_, testTargetsByBuildTarget := projectBuildTargetTestTargets(project)
+		require.Equal(t, 0, len(testTargetsByBuildTarget["8D3E2A042176C1D300A4F1B2"]))
+
+		testTargetNamesByBuildTarget := project.BuildTargetTestTargets()
+		require.Equal(t, map[string][]string{
+			"SampleApp": []string{"SampleAppTests", "SampleAppUITests"},
+		}, testTargetNamesByBuildTarget)
+	}
+
+	t.Log("dependencies without target, resolved by the target proxy")
+	{
+		content := strings.Replace(sampleAppPbxprojContent, "\t\t\ttarget = 8D3E2A042176C1D300A4F1B2 /* SampleApp */;\n", "", -1)
+		project, err := ParseProject(content)
+		require.NoError(t, err)
+
+		buildTargets, testTargetsByBuildTarget := projectBuildTargetTestTargets(project)
+		require.Equal(t, 1, len(buildTargets))
+		require.Equal(t, "SampleApp", buildTargets[0].Name)
+
+		testTargets := testTargetsByBuildTarget["8D3E2A042176C1D300A4F1B2"]
+		require.Equal(t, 2, len(testTargets))
+		require.Equal(t, "SampleAppTests", testTargets[0].Name)
+		require.Equal(t, "SampleAppUITests", testTargets[1].Name)
+	}
+
+	t.Log("missing xcconfig")
+	{
+		projectPth := filepath.Join(tmpDir, "missing", "SampleApp.xcodeproj")
+		writeTestProject(t, projectPth, sampleAppPbxprojContent)
+
+		testTargetsByBuildTarget, err := ProjectBuildTargetTestTargets(projectPth)
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{
+			"SampleApp": []string{"SampleAppTests", "SampleAppUITests"},
+		}, testTargetsByBuildTarget)
+
+		content := strings.Replace(sampleAppPbxprojContent, "\t\t\t\t8D3E2A202176C1D300A4F1B2 /* PBXTargetDependency */,\n", "", 1)
+		projectPth = filepath.Join(tmpDir, "missing_dependency", "SampleApp.xcodeproj")
+		writeTestProject(t, projectPth, content)
+
+		testTargetsByBuildTarget, err = ProjectBuildTargetTestTargets(projectPth)
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{
+			"SampleApp": []string{"SampleAppUITests"},
+		}, testTargetsByBuildTarget)
+	}
+}
+
+func TestWorkspaceBuildTargetTestTargets(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	writeSampleAppProject(t, tmpDir, sampleAppPbxprojContent)
+	workspacePth := filepath.Join(tmpDir, "SampleApp.xcworkspace")
+	writeTestWorkspace(t, workspacePth, `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:SampleApp.xcodeproj">
+   </FileRef>
+</Workspace>
+`)
+
+	testTargetsByBuildTarget, err := WorkspaceBuildTargetTestTargets(workspacePth)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"SampleApp": []string{"SampleAppTests", "SampleAppUITests"},
+	}, testTargetsByBuildTarget)
+
+	testTargetsByBuildTarget, err = WorkspaceBuildTargetTestTargetsWithOptions(workspacePth, WorkspaceListOptions{Recursive: true})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"SampleApp": []string{"SampleAppTests", "SampleAppUITests"},
+	}, testTargetsByBuildTarget)
+
+	t.Log("projects with targets of the same name")
+	{
+		writeSampleAppProject(t, filepath.Join(tmpDir, "Copy"), sampleAppPbxprojContent)
+		writeTestWorkspace(t, workspacePth, `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:SampleApp.xcodeproj">
+   </FileRef>
+   <FileRef
+      location = "group:Copy/SampleApp.xcodeproj">
+   </FileRef>
+</Workspace>
+`)
+
+		testTargetsByBuildTarget, err := WorkspaceBuildTargetTestTargets(workspacePth)
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{
+			"SampleApp": []string{"SampleAppTests", "SampleAppUITests"},
+		}, testTargetsByBuildTarget)
+	}
+}
@@ -0,0 +1,288 @@
+package xcodeproj
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// Workspace location types, the prefix of a FileRef or Group location, like `group:SampleApp.xcodeproj`.
+const (
+	// WorkspaceLocationGroup is relative to the enclosing group (the workspace's directory at the top level).
+	WorkspaceLocationGroup = "group"
+	// WorkspaceLocationContainer is relative to the workspace's directory.
+	WorkspaceLocationContainer = "container"
+	// WorkspaceLocationAbsolute is an absolute path.
+	WorkspaceLocationAbsolute = "absolute"
+	// WorkspaceLocationSelf refers to the project, which contains the (project.xcworkspace) workspace.
+	WorkspaceLocationSelf = "self"
+	// WorkspaceLocationDeveloper is relative to the Xcode developer directory.
+	WorkspaceLocationDeveloper = "developer"
+)
+
+// WorkspaceLocation ...
+type WorkspaceLocation struct {
+	Type string
+	Path string
+}
+
+func (l WorkspaceLocation) String() string {
+	return l.Type + ":" + l.Path
+}
+
+func parseWorkspaceLocation(location string) (WorkspaceLocation, error) {
+	split := strings.SplitN(location, ":", 2)
+	if len(split) != 2 {
+		return WorkspaceLocation{}, fmt.Errorf("invalid location (%s): missing location type", location)
+	}
+
+	switch split[0] {
+	case WorkspaceLocationGroup, WorkspaceLocationContainer, WorkspaceLocationAbsolute, WorkspaceLocationSelf, WorkspaceLocationDeveloper:
+		return WorkspaceLocation{Type: split[0], Path: split[1]}, nil
+	}
+	return WorkspaceLocation{}, fmt.Errorf("invalid location (%s): unknown location type", location)
+}
+
+// WorkspaceFileRef kinds
+const (
+	WorkspaceFileRefProject      = "project"
+	WorkspaceFileRefWorkspace    = "workspace"
+	WorkspaceFileRefSwiftPackage = "swift-package"
+	WorkspaceFileRefFolder       = "folder"
+	WorkspaceFileRefFile         = "file"
+)
+
+// WorkspaceFileRef is a file or folder reference of the workspace.
+type WorkspaceFileRef struct {
+	Location WorkspaceLocation
+	// Path is the resolved path of the reference, empty for `developer:` locations.
+	Path string
+}
+
+// IsProject ...
+func (r WorkspaceFileRef) IsProject() bool {
+	return IsXCodeProj(r.Path)
+}
+
+// IsPodsProject reports whether the reference is the CocoaPods generated Pods project.
+func (r WorkspaceFileRef) IsPodsProject() bool {
+	return IsPodsProject(r.Path)
+}
+
+// Kind returns the kind of the reference (project, workspace, swift-package, folder or file).
+// Swift packages and folders are recognised by checking the referenced directory.
+func (r WorkspaceFileRef) Kind() (string, error) {
+	if IsXCodeProj(r.Path) {
+		return WorkspaceFileRefProject, nil
+	}
+	if IsXCWorkspace(r.Path) {
+		return WorkspaceFileRefWorkspace, nil
+	}
+	if r.Path == "" {
+		return WorkspaceFileRefFile, nil
+	}
+
+	if isDir, err := pathutil.IsDirExists(r.Path); err != nil {
+		return "", err
+	} else if !isDir {
+		return WorkspaceFileRefFile, nil
+	}
+
+	if exist, err := pathutil.IsPathExists(filepath.Join(r.Path, "Package.swift")); err != nil {
+		return "", err
+	} else if exist {
+		return WorkspaceFileRefSwiftPackage, nil
+	}
+	return WorkspaceFileRefFolder, nil
+}
+
+// WorkspaceGroup groups file references and groups, its location is the base of the nested `group:` locations.
+type WorkspaceGroup struct {
+	Name     string
+	Location WorkspaceLocation
+	Path     string
+	FileRefs []*WorkspaceFileRef
+	Groups   []*WorkspaceGroup
+}
+
+// Workspace is the content of an .xcworkspace's contents.xcworkspacedata.
+type Workspace struct {
+	Path     string
+	Version  string
+	FileRefs []*WorkspaceFileRef
+	Groups   []*WorkspaceGroup
+
+	// fileRefs lists every FileRef in document order
+	fileRefs []*WorkspaceFileRef
+}
+
+// xcworkspacedataItem is a Workspace, Group or FileRef element, keeping the document order of the children.
+type xcworkspacedataItem struct {
+	XMLName  xml.Name
+	Version  string                `xml:"version,attr"`
+	Location string                `xml:"location,attr"`
+	Name     string                `xml:"name,attr"`
+	Children []xcworkspacedataItem `xml:",any"`
+}
+
+// OpenWorkspace parses the contents.xcworkspacedata of the given .xcworkspace.
+func OpenWorkspace(pth string) (*Workspace, error) {
+	xcworkspacedataPth := filepath.Join(pth, "contents.xcworkspacedata")
+	if exist, err := pathutil.IsPathExists(xcworkspacedataPth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, fmt.Errorf("contents.xcworkspacedata does not exist at: %s", xcworkspacedataPth)
+	}
+
+	content, err := fileutil.ReadStringFromFile(xcworkspacedataPth)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := ParseWorkspace(content, pth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", xcworkspacedataPth, err)
+	}
+	return workspace, nil
+}
+
+// ParseWorkspace parses contents.xcworkspacedata content,
+// the file reference paths are resolved against the given .xcworkspace path.
+func ParseWorkspace(content, pth string) (*Workspace, error) {
+	root := xcworkspacedataItem{}
+	if err := xml.Unmarshal([]byte(content), &root); err != nil {
+		return nil, fmt.Errorf("failed to parse workspace: %s", err)
+	}
+	if root.XMLName.Local != "Workspace" {
+		return nil, fmt.Errorf("failed to parse workspace: unexpected root element: %s", root.XMLName.Local)
+	}
+
+	workspace := &Workspace{
+		Path:     pth,
+		Version:  root.Version,
+		FileRefs: []*WorkspaceFileRef{},
+		Groups:   []*WorkspaceGroup{},
+		fileRefs: []*WorkspaceFileRef{},
+	}
+
+	fileRefs, groups, err := workspace.decodeChildren(root.Children, filepath.Dir(pth))
+	if err != nil {
+		return nil, err
+	}
+	workspace.FileRefs = fileRefs
+	workspace.Groups = groups
+
+	return workspace, nil
+}
+
+func (w *Workspace) decodeChildren(items []xcworkspacedataItem, groupPth string) ([]*WorkspaceFileRef, []*WorkspaceGroup, error) {
+	fileRefs := []*WorkspaceFileRef{}
+	groups := []*WorkspaceGroup{}
+
+	for _, item := range items {
+		switch item.XMLName.Local {
+		case "FileRef":
+			location, err := parseWorkspaceLocation(item.Location)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			fileRef := &WorkspaceFileRef{
+				Location: location,
+				Path:     w.resolveLocation(location, groupPth),
+			}
+			fileRefs = append(fileRefs, fileRef)
+			w.fileRefs = append(w.fileRefs, fileRef)
+		case "Group":
+			location, err := parseWorkspaceLocation(item.Location)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			group := &WorkspaceGroup{
+				Name:     item.Name,
+				Location: location,
+				Path:     w.resolveLocation(location, groupPth),
+			}
+			group.FileRefs, group.Groups, err = w.decodeChildren(item.Children, group.Path)
+			if err != nil {
+				return nil, nil, err
+			}
+			groups = append(groups, group)
+		}
+	}
+
+	return fileRefs, groups, nil
+}
+
+// resolveLocation returns the path of the location, groupPth is the path of the enclosing group.
+func (w *Workspace) resolveLocation(location WorkspaceLocation, groupPth string) string {
+	containerPth := filepath.Dir(w.Path)
+
+	switch location.Type {
+	case WorkspaceLocationGroup:
+		return filepath.Join(groupPth, location.Path)
+	case WorkspaceLocationContainer:
+		return filepath.Join(containerPth, location.Path)
+	case WorkspaceLocationAbsolute:
+		return filepath.Clean(location.Path)
+	case WorkspaceLocationSelf:
+		// self: locations are used by the project.xcworkspace embedded into an .xcodeproj
+		if location.Path == "" {
+			return containerPth
+		}
+		return filepath.Join(filepath.Dir(containerPth), location.Path)
+	}
+	return ""
+}
+
+// Name returns the name of the workspace, based on its path.
+func (w *Workspace) Name() string {
+	return strings.TrimSuffix(filepath.Base(w.Path), XCWorkspaceExt)
+}
+
+// AllFileRefs returns every file reference of the workspace, including the ones in groups, in document order.
+func (w *Workspace) AllFileRefs() []*WorkspaceFileRef {
+	return w.fileRefs
+}
+
+// ProjectPaths returns the paths of the referenced .xcodeproj files, in document order.
+func (w *Workspace) ProjectPaths() []string {
+	projects := []string{}
+	for _, fileRef := range w.fileRefs {
+		if fileRef.IsProject() {
+			projects = append(projects, fileRef.Path)
+		}
+	}
+	return projects
+}
+
+// NonProjectFileRefs returns the file references, which are not .xcodeproj files, like folders, nested workspaces or Swift packages.
+func (w *Workspace) NonProjectFileRefs() []*WorkspaceFileRef {
+	fileRefs := []*WorkspaceFileRef{}
+	for _, fileRef := range w.fileRefs {
+		if !fileRef.IsProject() {
+			fileRefs = append(fileRefs, fileRef)
+		}
+	}
+	return fileRefs
+}
+
+// SwiftPackagePaths returns the paths of the referenced local Swift packages (folders containing a Package.swift), in document order.
+func (w *Workspace) SwiftPackagePaths() ([]string, error) {
+	packages := []string{}
+	for _, fileRef := range w.fileRefs {
+		kind, err := fileRef.Kind()
+		if err != nil {
+			return nil, err
+		}
+		if kind == WorkspaceFileRefSwiftPackage {
+			packages = append(packages, fileRef.Path)
+		}
+	}
+	return packages, nil
+}
@@ -0,0 +1,156 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWorkspace(t *testing.T) {
+	workspace, err := ParseWorkspace(workspaceContent, "/Users/bitrise/SampleApp/SampleApp.xcworkspace")
+	require.NoError(t, err)
+
+	require.Equal(t, "SampleApp", workspace.Name())
+	require.Equal(t, "1.0", workspace.Version)
+
+	t.Log("top level file references and groups")
+	{
+		require.Equal(t, 4, len(workspace.FileRefs))
+		require.Equal(t, WorkspaceLocation{Type: WorkspaceLocationGroup, Path: "SampleApp.xcodeproj"}, workspace.FileRefs[0].Location)
+		require.Equal(t, "/Users/bitrise/SampleApp/SampleApp.xcodeproj", workspace.FileRefs[0].Path)
+		require.Equal(t, "/Users/bitrise/Frameworks/Analytics.xcodeproj", workspace.FileRefs[1].Path)
+		require.Equal(t, "", workspace.FileRefs[2].Path)
+		require.Equal(t, true, workspace.FileRefs[3].IsPodsProject())
+
+		require.Equal(t, 2, len(workspace.Groups))
+		require.Equal(t, "Libraries", workspace.Groups[0].Name)
+		require.Equal(t, "/Users/bitrise/SampleApp/Libraries", workspace.Groups[0].Path)
+		require.Equal(t, "Docs", workspace.Groups[1].Name)
+		require.Equal(t, "/Users/bitrise/SampleApp", workspace.Groups[1].Path)
+	}
+
+	t.Log("nested groups")
+	{
+		vendor := workspace.Groups[0].Groups[0]
+		require.Equal(t, "Vendor", vendor.Name)
+		require.Equal(t, "/Users/bitrise/SampleApp/Libraries/Vendor", vendor.Path)
+		require.Equal(t, 2, len(vendor.FileRefs))
+		require.Equal(t, "/Users/bitrise/SampleApp/Libraries/Vendor/Networking/Networking.xcodeproj", vendor.FileRefs[0].Path)
+		require.Equal(t, "/Users/bitrise/SampleApp/Shared/Shared.xcodeproj", vendor.FileRefs[1].Path)
+	}
+
+	t.Log("projects")
+	{
+		require.Equal(t, []string{
+			"/Users/bitrise/SampleApp/SampleApp.xcodeproj",
+			"/Users/bitrise/SampleApp/Libraries/Kit/Kit.xcodeproj",
+			"/Users/bitrise/SampleApp/Libraries/Vendor/Networking/Networking.xcodeproj",
+			"/Users/bitrise/SampleApp/Shared/Shared.xcodeproj",
+			"/Users/bitrise/Frameworks/Analytics.xcodeproj",
+			"/Users/bitrise/SampleApp/Pods/Pods.xcodeproj",
+		}, workspace.ProjectPaths())
+	}
+
+	t.Log("non project references")
+	{
+		paths := []string{}
+		for _, fileRef := range workspace.NonProjectFileRefs() {
+			paths = append(paths, fileRef.Location.String())
+		}
+		require.Equal(t, []string{
+			"group:Packages/Logger",
+			"group:README.md",
+			"developer:Platforms/iPhoneOS.platform/Developer/Library/Frameworks/XCTest.framework",
+		}, paths)
+	}
+
+	t.Log("embedded project.xcworkspace")
+	{
+		workspace, err := ParseWorkspace(embeddedWorkspaceContent, "/Users/bitrise/SampleApp/SampleApp.xcodeproj/project.xcworkspace")
+		require.NoError(t, err)
+		require.Equal(t, []string{"/Users/bitrise/SampleApp/SampleApp.xcodeproj"}, workspace.ProjectPaths())
+	}
+}
+
+func TestParseWorkspaceErrors(t *testing.T) {
+	t.Log("invalid xml")
+	{
+		_, err := ParseWorkspace(`<Workspace version = "1.0">`, "SampleApp.xcworkspace")
+		require.EqualError(t, err, "failed to parse workspace: XML syntax error on line 1: unexpected EOF")
+	}
+
+	t.Log("not a workspace")
+	{
+		_, err := ParseWorkspace(`<Scheme></Scheme>`, "SampleApp.xcworkspace")
+		require.EqualError(t, err, "failed to parse workspace: unexpected root element: Scheme")
+	}
+
+	t.Log("missing location type")
+	{
+		_, err := ParseWorkspace(`<Workspace><FileRef location = "SampleApp.xcodeproj"></FileRef></Workspace>`, "SampleApp.xcworkspace")
+		require.EqualError(t, err, "invalid location (SampleApp.xcodeproj): missing location type")
+	}
+
+	t.Log("unknown location type")
+	{
+		_, err := ParseWorkspace(`<Workspace><Group location = "remote:Libs"></Group></Workspace>`, "SampleApp.xcworkspace")
+		require.EqualError(t, err, "invalid location (remote:Libs): unknown location type")
+	}
+}
+
+func TestWorkspaceFileRefKind(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	workspacePth := filepath.Join(tmpDir, "SampleApp.xcworkspace")
+	require.NoError(t, os.MkdirAll(workspacePth, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(workspacePth, "contents.xcworkspacedata"), []byte(workspaceContent), 0644))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "Libraries", "Packages", "Logger"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "Libraries", "Packages", "Logger", "Package.swift"), []byte("// swift-tools-version:5.0\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "README.md"), []byte("# SampleApp\n"), 0644))
+
+	workspace, err := OpenWorkspace(workspacePth)
+	require.NoError(t, err)
+
+	kinds := []string{}
+	for _, fileRef := range workspace.AllFileRefs() {
+		kind, err := fileRef.Kind()
+		require.NoError(t, err)
+		kinds = append(kinds, kind)
+	}
+	require.Equal(t, []string{
+		WorkspaceFileRefProject,
+		WorkspaceFileRefProject,
+		WorkspaceFileRefProject,
+		WorkspaceFileRefProject,
+		WorkspaceFileRefSwiftPackage,
+		WorkspaceFileRefFile,
+		WorkspaceFileRefProject,
+		WorkspaceFileRefFile,
+		WorkspaceFileRefProject,
+	}, kinds)
+
+	packages, err := workspace.SwiftPackagePaths()
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(tmpDir, "Libraries", "Packages", "Logger")}, packages)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(tmpDir, "Libraries", "Packages", "Logger", "Package.swift")))
+	kind, err := workspace.AllFileRefs()[4].Kind()
+	require.NoError(t, err)
+	require.Equal(t, WorkspaceFileRefFolder, kind)
+
+	projects, err := WorkspaceProjectReferences(workspacePth)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(projects))
+	require.Equal(t, "/Users/bitrise/Frameworks/Analytics.xcodeproj", projects[0])
+
+	_, err = OpenWorkspace(tmpDir)
+	require.EqualError(t, err, "contents.xcworkspacedata does not exist at: "+filepath.Join(tmpDir, "contents.xcworkspacedata"))
+}
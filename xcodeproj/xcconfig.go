@@ -0,0 +1,190 @@
+package xcodeproj
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// XCConfigSyntaxError is returned for an invalid line of an .xcconfig file.
+type XCConfigSyntaxError struct {
+	Path string
+	Line int
+	Msg  string
+}
+
+func (e *XCConfigSyntaxError) Error() string {
+	return fmt.Sprintf("xcconfig syntax error at %s:%d: %s", e.Path, e.Line, e.Msg)
+}
+
+// XCConfigFileNotFoundError is returned if an .xcconfig file or a (non optional) included file does not exist.
+type XCConfigFileNotFoundError struct {
+	Path string
+	// IncludedFrom is the path of the including .xcconfig, empty for the opened file itself.
+	IncludedFrom string
+	Line         int
+}
+
+func (e *XCConfigFileNotFoundError) Error() string {
+	if e.IncludedFrom == "" {
+		return fmt.Sprintf("xcconfig does not exist at: %s", e.Path)
+	}
+	return fmt.Sprintf("xcconfig does not exist at: %s, included from %s:%d", e.Path, e.IncludedFrom, e.Line)
+}
+
+// XCConfigIncludeCycleError is returned if .xcconfig files include each other.
+type XCConfigIncludeCycleError struct {
+	// Cycle lists the files of the cycle, the first and the last item is the same file.
+	Cycle []string
+}
+
+func (e *XCConfigIncludeCycleError) Error() string {
+	return fmt.Sprintf("xcconfig include cycle: %s", strings.Join(e.Cycle, " -> "))
+}
+
+// XCConfigAssignment is a build setting assignment of an .xcconfig file.
+type XCConfigAssignment struct {
+	Name       string
+	Conditions []BuildSettingCondition
+	Value      string
+
+	// Path and Line locate the assignment, which may come from an included file.
+	Path string
+	Line int
+}
+
+// XCConfig is an .xcconfig file with its includes resolved.
+type XCConfig struct {
+	Path string
+	// Assignments lists the assignments of the file and the included files, in evaluation order.
+	Assignments []XCConfigAssignment
+	// Includes lists the included files, in include order.
+	Includes []string
+}
+
+var xcconfigIncludeRegexp = regexp.MustCompile(`^#include(\?)?\s*"(.*)"$`)
+var buildSettingNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// OpenXCConfig parses the .xcconfig file at the given path, including the files referenced by `#include` and `#include?`.
+func OpenXCConfig(pth string) (*XCConfig, error) {
+	if exist, err := pathutil.IsPathExists(pth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, &XCConfigFileNotFoundError{Path: pth}
+	}
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseXCConfig(content, pth)
+}
+
+// ParseXCConfig parses .xcconfig content, the included files are resolved relative to the directory of the given path.
+func ParseXCConfig(content, pth string) (*XCConfig, error) {
+	config := &XCConfig{
+		Path:        pth,
+		Assignments: []XCConfigAssignment{},
+		Includes:    []string{},
+	}
+	if err := config.parse(content, pth, []string{pth}); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// parse parses the content of the file at pth, stack lists the files being included, to detect cycles.
+func (c *XCConfig) parse(content, pth string, stack []string) error {
+	for i, line := range strings.Split(content, "\n") {
+		lineNumber := i + 1
+
+		// `//` starts a comment anywhere in the line, even within a value
+		if idx := strings.Index(line, "//"); idx != -1 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			match := xcconfigIncludeRegexp.FindStringSubmatch(line)
+			if match == nil {
+				return &XCConfigSyntaxError{Path: pth, Line: lineNumber, Msg: fmt.Sprintf("invalid directive: %s", line)}
+			}
+			if err := c.include(match[2], match[1] == "?", pth, lineNumber, stack); err != nil {
+				return err
+			}
+			continue
+		}
+
+		separator := assignmentSeparatorIndex(line)
+		if separator == -1 {
+			return &XCConfigSyntaxError{Path: pth, Line: lineNumber, Msg: fmt.Sprintf("missing '=': %s", line)}
+		}
+
+		name, conditions, err := parseBuildSettingKey(strings.TrimSpace(line[:separator]))
+		if err != nil {
+			return &XCConfigSyntaxError{Path: pth, Line: lineNumber, Msg: err.Error()}
+		}
+		if !buildSettingNameRegexp.MatchString(name) {
+			return &XCConfigSyntaxError{Path: pth, Line: lineNumber, Msg: fmt.Sprintf("invalid build setting name: %s", name)}
+		}
+
+		c.Assignments = append(c.Assignments, XCConfigAssignment{
+			Name:       name,
+			Conditions: conditions,
+			Value:      strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(line[separator+1:]), ";")),
+			Path:       pth,
+			Line:       lineNumber,
+		})
+	}
+
+	return nil
+}
+
+func (c *XCConfig) include(includePth string, optional bool, pth string, lineNumber int, stack []string) error {
+	if !filepath.IsAbs(includePth) {
+		includePth = filepath.Join(filepath.Dir(pth), includePth)
+	}
+
+	for _, including := range stack {
+		if including == includePth {
+			return &XCConfigIncludeCycleError{Cycle: append(append([]string{}, stack...), includePth)}
+		}
+	}
+
+	if exist, err := pathutil.IsPathExists(includePth); err != nil {
+		return err
+	} else if !exist {
+		if optional {
+			return nil
+		}
+		return &XCConfigFileNotFoundError{Path: includePth, IncludedFrom: pth, Line: lineNumber}
+	}
+
+	content, err := fileutil.ReadStringFromFile(includePth)
+	if err != nil {
+		return err
+	}
+
+	c.Includes = append(c.Includes, includePth)
+	return c.parse(content, includePth, append(stack, includePth))
+}
+
+func (c *XCConfig) buildSettingAssignments() []buildSettingAssignment {
+	assignments := []buildSettingAssignment{}
+	for _, assignment := range c.Assignments {
+		assignments = append(assignments, buildSettingAssignment{
+			Name:       assignment.Name,
+			Conditions: assignment.Conditions,
+			Value:      assignment.Value,
+		})
+	}
+	return assignments
+}
@@ -0,0 +1,157 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestXCConfigs(t *testing.T, dir string, contents map[string]string) {
+	for name, content := range contents {
+		pth := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(pth), 0755))
+		require.NoError(t, ioutil.WriteFile(pth, []byte(content), 0644))
+	}
+}
+
+func TestParseXCConfig(t *testing.T) {
+	t.Log("assignments, comments and conditions")
+	{
+		content := `// Comment
+#include? "Missing.xcconfig"
+
+PRODUCT_NAME = SampleApp;
+OTHER_LDFLAGS = $(inherited) -ObjC // trailing comment
+EXCLUDED_ARCHS[sdk=iphonesimulator*][arch=arm64] = arm64
+EMPTY =
+`
+		config, err := ParseXCConfig(content, "/tmp/Base.xcconfig")
+		require.NoError(t, err)
+		require.Equal(t, "/tmp/Base.xcconfig", config.Path)
+		require.Equal(t, 0, len(config.Includes))
+		require.Equal(t, []XCConfigAssignment{
+			XCConfigAssignment{Name: "PRODUCT_NAME", Conditions: []BuildSettingCondition{}, Value: "SampleApp", Path: "/tmp/Base.xcconfig", Line: 4},
+			XCConfigAssignment{Name: "OTHER_LDFLAGS", Conditions: []BuildSettingCondition{}, Value: "$(inherited) -ObjC", Path: "/tmp/Base.xcconfig", Line: 5},
+			XCConfigAssignment{
+				Name: "EXCLUDED_ARCHS",
+				Conditions: []BuildSettingCondition{
+					BuildSettingCondition{Key: "sdk", Value: "iphonesimulator*"},
+					BuildSettingCondition{Key: "arch", Value: "arm64"},
+				},
+				Value: "arm64",
+				Path:  "/tmp/Base.xcconfig",
+				Line:  6,
+			},
+			XCConfigAssignment{Name: "EMPTY", Conditions: []BuildSettingCondition{}, Value: "", Path: "/tmp/Base.xcconfig", Line: 7},
+		}, config.Assignments)
+	}
+
+	t.Log("syntax errors")
+	{
+		_, err := ParseXCConfig("PRODUCT_NAME", "Base.xcconfig")
+		require.EqualError(t, err, "xcconfig syntax error at Base.xcconfig:1: missing '=': PRODUCT_NAME")
+
+		_, err = ParseXCConfig("\nPRODUCT NAME = SampleApp", "Base.xcconfig")
+		require.EqualError(t, err, "xcconfig syntax error at Base.xcconfig:2: invalid build setting name: PRODUCT NAME")
+
+		_, err = ParseXCConfig("ARCHS[sdk=iphoneos* = arm64", "Base.xcconfig")
+		require.Error(t, err)
+		_, ok := err.(*XCConfigSyntaxError)
+		require.Equal(t, true, ok)
+
+		_, err = ParseXCConfig("#import \"Other.xcconfig\"", "Base.xcconfig")
+		require.EqualError(t, err, "xcconfig syntax error at Base.xcconfig:1: invalid directive: #import \"Other.xcconfig\"")
+	}
+}
+
+func TestOpenXCConfig(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcconfig")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	t.Log("includes")
+	{
+		dir := filepath.Join(tmpDir, "includes")
+		writeTestXCConfigs(t, dir, map[string]string{
+			"Configs/App.xcconfig":         "#include \"Shared/Base.xcconfig\"\n#include? \"Local.xcconfig\"\nPRODUCT_NAME = App\n",
+			"Configs/Shared/Base.xcconfig": "#include \"../../Common.xcconfig\"\nPRODUCT_NAME = Base\n",
+			"Common.xcconfig":              "SWIFT_VERSION = 5.0\n",
+		})
+
+		config, err := OpenXCConfig(filepath.Join(dir, "Configs/App.xcconfig"))
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			filepath.Join(dir, "Configs/Shared/Base.xcconfig"),
+			filepath.Join(dir, "Common.xcconfig"),
+		}, config.Includes)
+
+		require.Equal(t, 3, len(config.Assignments))
+		require.Equal(t, "SWIFT_VERSION", config.Assignments[0].Name)
+		require.Equal(t, filepath.Join(dir, "Common.xcconfig"), config.Assignments[0].Path)
+		require.Equal(t, "Base", config.Assignments[1].Value)
+		require.Equal(t, "App", config.Assignments[2].Value)
+		require.Equal(t, 3, config.Assignments[2].Line)
+	}
+
+	t.Log("missing file")
+	{
+		pth := filepath.Join(tmpDir, "Missing.xcconfig")
+		_, err := OpenXCConfig(pth)
+		require.EqualError(t, err, "xcconfig does not exist at: "+pth)
+		_, ok := err.(*XCConfigFileNotFoundError)
+		require.Equal(t, true, ok)
+	}
+
+	t.Log("missing include")
+	{
+		dir := filepath.Join(tmpDir, "missing")
+		writeTestXCConfigs(t, dir, map[string]string{
+			"App.xcconfig": "PRODUCT_NAME = App\n#include \"Missing.xcconfig\"\n",
+		})
+
+		_, err := OpenXCConfig(filepath.Join(dir, "App.xcconfig"))
+		require.Error(t, err)
+		notFoundErr, ok := err.(*XCConfigFileNotFoundError)
+		require.Equal(t, true, ok)
+		require.Equal(t, filepath.Join(dir, "Missing.xcconfig"), notFoundErr.Path)
+		require.Equal(t, filepath.Join(dir, "App.xcconfig"), notFoundErr.IncludedFrom)
+		require.Equal(t, 2, notFoundErr.Line)
+	}
+
+	t.Log("include cycle")
+	{
+		dir := filepath.Join(tmpDir, "cycle")
+		writeTestXCConfigs(t, dir, map[string]string{
+			"A.xcconfig": "#include \"B.xcconfig\"\n",
+			"B.xcconfig": "#include \"A.xcconfig\"\n",
+		})
+
+		_, err := OpenXCConfig(filepath.Join(dir, "A.xcconfig"))
+		require.Error(t, err)
+		cycleErr, ok := err.(*XCConfigIncludeCycleError)
+		require.Equal(t, true, ok)
+		require.Equal(t, []string{
+			filepath.Join(dir, "A.xcconfig"),
+			filepath.Join(dir, "B.xcconfig"),
+			filepath.Join(dir, "A.xcconfig"),
+		}, cycleErr.Cycle)
+	}
+
+	t.Log("the same file included twice is not a cycle")
+	{
+		dir := filepath.Join(tmpDir, "twice")
+		writeTestXCConfigs(t, dir, map[string]string{
+			"App.xcconfig":    "#include \"Common.xcconfig\"\n#include \"Common.xcconfig\"\n",
+			"Common.xcconfig": "SWIFT_VERSION = 5.0\n",
+		})
+
+		config, err := OpenXCConfig(filepath.Join(dir, "App.xcconfig"))
+		require.NoError(t, err)
+		require.Equal(t, 2, len(config.Assignments))
+	}
+}
@@ -0,0 +1,209 @@
+package xcodeproj
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+var buildSettingReferenceRegexp = regexp.MustCompile(`\$[({]([A-Za-z_][A-Za-z0-9_]*)(:[^)}]*)?[)}]`)
+
+// XCFileListEntry is a line of an .xcfilelist file: a path, a comment or an empty line.
+type XCFileListEntry struct {
+	// Path may contain build setting references, like `$(SRCROOT)/Resources/Colors.json`.
+	Path string
+	// Comment is the text of a comment line, without the leading `#`.
+	Comment string
+	Line    int
+}
+
+// XCFileList is an .xcfilelist file, listing the input or output files of a Run Script build phase, one path per line.
+type XCFileList struct {
+	Path    string
+	Entries []XCFileListEntry
+}
+
+// NewXCFileList returns a new file list with the given paths, call Save to write it to the given path.
+func NewXCFileList(pth string, paths ...string) *XCFileList {
+	fileList := &XCFileList{Path: pth, Entries: []XCFileListEntry{}}
+	fileList.Add(paths...)
+	return fileList
+}
+
+// OpenXCFileList parses the .xcfilelist file at the given path.
+func OpenXCFileList(pth string) (*XCFileList, error) {
+	if exist, err := pathutil.IsPathExists(pth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, fmt.Errorf("xcfilelist does not exist at: %s", pth)
+	}
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseXCFileList(content, pth), nil
+}
+
+// ParseXCFileList parses .xcfilelist content: lines are trimmed, lines starting with `#` are comments.
+func ParseXCFileList(content, pth string) *XCFileList {
+	fileList := &XCFileList{Path: pth, Entries: []XCFileListEntry{}}
+
+	lines := strings.Split(content, "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		entry := XCFileListEntry{Line: i + 1}
+		if strings.HasPrefix(line, "#") {
+			entry.Comment = strings.TrimPrefix(line, "#")
+		} else {
+			entry.Path = line
+		}
+		fileList.Entries = append(fileList.Entries, entry)
+	}
+
+	return fileList
+}
+
+// Paths returns the listed paths, without expanding their build setting references.
+func (l *XCFileList) Paths() []string {
+	paths := []string{}
+	for _, entry := range l.Entries {
+		if entry.Path != "" {
+			paths = append(paths, entry.Path)
+		}
+	}
+	return paths
+}
+
+// Add appends the paths, which are not yet listed.
+func (l *XCFileList) Add(paths ...string) {
+	listed := map[string]bool{}
+	for _, pth := range l.Paths() {
+		listed[pth] = true
+	}
+
+	for _, pth := range paths {
+		if pth == "" || listed[pth] {
+			continue
+		}
+		listed[pth] = true
+		l.Entries = append(l.Entries, XCFileListEntry{Path: pth})
+	}
+	l.renumber()
+}
+
+// Remove removes the lines of the path, it reports whether the path was listed.
+func (l *XCFileList) Remove(pth string) bool {
+	entries := []XCFileListEntry{}
+	for _, entry := range l.Entries {
+		if entry.Path != pth {
+			entries = append(entries, entry)
+		}
+	}
+
+	removed := len(entries) != len(l.Entries)
+	l.Entries = entries
+	l.renumber()
+	return removed
+}
+
+func (l *XCFileList) renumber() {
+	for i := range l.Entries {
+		l.Entries[i].Line = i + 1
+	}
+}
+
+// Encode returns the .xcfilelist content of the file list, comments and empty lines are kept.
+func (l *XCFileList) Encode() string {
+	var buffer bytes.Buffer
+	for _, entry := range l.Entries {
+		if entry.Path != "" {
+			buffer.WriteString(entry.Path)
+		} else if entry.Comment != "" {
+			buffer.WriteString("#" + entry.Comment)
+		}
+		buffer.WriteString("\n")
+	}
+	return buffer.String()
+}
+
+// Save writes the file list to its path.
+func (l *XCFileList) Save() error {
+	if l.Path == "" {
+		return errors.New("failed to save xcfilelist: missing path")
+	}
+	return fileutil.WriteStringToFile(l.Path, l.Encode())
+}
+
+// ExpandedPaths returns the listed paths with their build setting references expanded,
+// relative paths are made absolute, relative to SRCROOT.
+// Paths referencing undefined build settings are returned unexpanded.
+func (l *XCFileList) ExpandedPaths(settings *ResolvedBuildSettings) []string {
+	paths := []string{}
+	for _, pth := range l.Paths() {
+		if expanded, ok := expandBuildSettingPath(settings, pth); ok {
+			pth = expanded
+		}
+		paths = append(paths, pth)
+	}
+	return paths
+}
+
+// XCFileListMissingFile is a listed file, which does not exist.
+type XCFileListMissingFile struct {
+	// Path is the expanded, absolute path of the file.
+	Path string
+	Line int
+}
+
+// MissingFiles checks whether the listed files exist, the paths are expanded with the given build settings,
+// relative paths are relative to SRCROOT. Paths referencing undefined build settings are not checked.
+func (l *XCFileList) MissingFiles(settings *ResolvedBuildSettings) ([]XCFileListMissingFile, error) {
+	missing := []XCFileListMissingFile{}
+	for _, entry := range l.Entries {
+		if entry.Path == "" {
+			continue
+		}
+		pth, ok := expandBuildSettingPath(settings, entry.Path)
+		if !ok {
+			continue
+		}
+
+		if exist, err := pathutil.IsPathExists(pth); err != nil {
+			return nil, err
+		} else if !exist {
+			missing = append(missing, XCFileListMissingFile{Path: pth, Line: entry.Line})
+		}
+	}
+	return missing, nil
+}
+
+// expandBuildSettingPath expands the build setting references of the path and makes it absolute, relative to SRCROOT.
+// It returns false if the path references an undefined build setting (like the ones set by xcodebuild only).
+func expandBuildSettingPath(settings *ResolvedBuildSettings, pth string) (string, bool) {
+	for _, match := range buildSettingReferenceRegexp.FindAllStringSubmatch(pth, -1) {
+		if _, found := settings.Value(match[1]); !found {
+			return "", false
+		}
+	}
+
+	pth = settings.Expand(pth)
+	if !filepath.IsAbs(pth) {
+		srcRoot, found := settings.Value("SRCROOT")
+		if !found {
+			return "", false
+		}
+		pth = filepath.Join(srcRoot, pth)
+	}
+	return pth, true
+}
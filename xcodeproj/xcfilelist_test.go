@@ -0,0 +1,107 @@
+package xcodeproj
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const swiftGenInputsXCFileListContent = `# SwiftGen inputs
+$(SRCROOT)/SampleApp/Info.plist
+  ${SRCROOT}/SampleApp/Colors.json
+
+Resources/Localizable.strings
+$(BUILD_DIR)/Generated.strings
+`
+
+func TestParseXCFileList(t *testing.T) {
+	fileList := ParseXCFileList(swiftGenInputsXCFileListContent, "/tmp/inputs.xcfilelist")
+	require.Equal(t, "/tmp/inputs.xcfilelist", fileList.Path)
+	require.Equal(t, []XCFileListEntry{
+		{Comment: " SwiftGen inputs", Line: 1},
+		{Path: "$(SRCROOT)/SampleApp/Info.plist", Line: 2},
+		{Path: "${SRCROOT}/SampleApp/Colors.json", Line: 3},
+		{Line: 4},
+		{Path: "Resources/Localizable.strings", Line: 5},
+		{Path: "$(BUILD_DIR)/Generated.strings", Line: 6},
+	}, fileList.Entries)
+	require.Equal(t, []string{
+		"$(SRCROOT)/SampleApp/Info.plist",
+		"${SRCROOT}/SampleApp/Colors.json",
+		"Resources/Localizable.strings",
+		"$(BUILD_DIR)/Generated.strings",
+	}, fileList.Paths())
+
+	t.Log("edit and encode")
+	{
+		fileList.Add("$(SRCROOT)/SampleApp/Info.plist", "Resources/Fonts.json")
+		require.Equal(t, true, fileList.Remove("$(BUILD_DIR)/Generated.strings"))
+		require.Equal(t, false, fileList.Remove("$(BUILD_DIR)/Generated.strings"))
+		require.Equal(t, `# SwiftGen inputs
+$(SRCROOT)/SampleApp/Info.plist
+${SRCROOT}/SampleApp/Colors.json
+
+Resources/Localizable.strings
+Resources/Fonts.json
+`, fileList.Encode())
+		require.Equal(t, 6, fileList.Entries[5].Line)
+	}
+
+	require.Equal(t, "a.json\nb.json\n", NewXCFileList("", "a.json", "b.json", "a.json").Encode())
+	require.Equal(t, "", ParseXCFileList("", "").Encode())
+}
+
+func TestOpenAndSaveXCFileList(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	pth := filepath.Join(tmpDir, "outputs.xcfilelist")
+	_, err = OpenXCFileList(pth)
+	require.EqualError(t, err, "xcfilelist does not exist at: "+pth)
+
+	require.NoError(t, NewXCFileList(pth, "$(DERIVED_FILE_DIR)/Colors.swift").Save())
+	fileList, err := OpenXCFileList(pth)
+	require.NoError(t, err)
+	require.Equal(t, []string{"$(DERIVED_FILE_DIR)/Colors.swift"}, fileList.Paths())
+
+	require.EqualError(t, NewXCFileList("").Save(), "failed to save xcfilelist: missing path")
+}
+
+func TestXCFileListMissingFiles(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	projectPth := writeSampleAppProject(t, tmpDir, sampleAppPbxprojContent)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "SampleApp"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "SampleApp", "Info.plist"), []byte{}, 0644))
+
+	project, err := OpenProject(projectPth)
+	require.NoError(t, err)
+	target, _ := project.TargetByName("SampleApp")
+	settings, err := project.ResolveBuildSettings(target, "Debug", BuildSettingsOptions{})
+	require.NoError(t, err)
+
+	fileList := ParseXCFileList(swiftGenInputsXCFileListContent, filepath.Join(tmpDir, "inputs.xcfilelist"))
+	require.Equal(t, []string{
+		filepath.Join(tmpDir, "SampleApp", "Info.plist"),
+		filepath.Join(tmpDir, "SampleApp", "Colors.json"),
+		filepath.Join(tmpDir, "Resources", "Localizable.strings"),
+		"$(BUILD_DIR)/Generated.strings",
+	}, fileList.ExpandedPaths(settings))
+
+	missing, err := fileList.MissingFiles(settings)
+	require.NoError(t, err)
+	require.Equal(t, []XCFileListMissingFile{
+		{Path: filepath.Join(tmpDir, "SampleApp", "Colors.json"), Line: 3},
+		{Path: filepath.Join(tmpDir, "Resources", "Localizable.strings"), Line: 5},
+	}, missing)
+}
@@ -1,21 +1,12 @@
 package xcodeproj
 
 import (
-	"bufio"
-	"bytes"
-	"errors"
-	"fmt"
 	"os"
 	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
-
-	"github.com/bitrise-io/go-utils/cmdex"
-	"github.com/bitrise-io/go-utils/errorutil"
-	"github.com/bitrise-io/go-utils/fileutil"
-	"github.com/bitrise-io/go-utils/pathutil"
 )
 
 // Extensions
@@ -50,12 +41,12 @@ func SchemeNameFromPath(schemePth string) string {
 
 // SchemeFileContainsXCTestBuildAction ...
 func SchemeFileContainsXCTestBuildAction(schemeFilePth string) (bool, error) {
-	content, err := fileutil.ReadStringFromFile(schemeFilePth)
+	scheme, err := OpenScheme(schemeFilePth)
 	if err != nil {
 		return false, err
 	}
 
-	return schemeFileContentContainsXCTestBuildAction(content)
+	return scheme.ContainsXCTestBuildAction(), nil
 }
 
 // ProjectSharedSchemeFilePaths ...
@@ -63,29 +54,25 @@ func ProjectSharedSchemeFilePaths(projectPth string) ([]string, error) {
 	return sharedSchemeFilePaths(projectPth)
 }
 
+// WorkspaceListOptions configures which containers of the workspace the Workspace*WithOptions functions list over.
+type WorkspaceListOptions struct {
+	// Recursive lists over the full container closure of the workspace: nested workspaces, projects and sub-projects.
+	Recursive bool
+	// ExcludePods skips the CocoaPods generated Pods projects.
+	ExcludePods bool
+	// IncludePackages adds the schemes Xcode generates for the local Swift packages of the workspaces
+	// to the WorkspaceSharedSchemesWithOptions result, shared schemes of the same name take precedence.
+	IncludePackages bool
+}
+
 // WorkspaceSharedSchemeFilePaths ...
 func WorkspaceSharedSchemeFilePaths(workspacePth string) ([]string, error) {
-	workspaceSchemeFilePaths, err := sharedSchemeFilePaths(workspacePth)
-	if err != nil {
-		return []string{}, err
-	}
-
-	projects, err := WorkspaceProjectReferences(workspacePth)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, project := range projects {
-		projectSchemeFilePaths, err := sharedSchemeFilePaths(project)
-		if err != nil {
-			return []string{}, err
-		}
-		workspaceSchemeFilePaths = append(workspaceSchemeFilePaths, projectSchemeFilePaths...)
-	}
-
-	sort.Strings(workspaceSchemeFilePaths)
+	return WorkspaceSharedSchemeFilePathsWithOptions(workspacePth, WorkspaceListOptions{})
+}
 
-	return workspaceSchemeFilePaths, nil
+// WorkspaceSharedSchemeFilePathsWithOptions is WorkspaceSharedSchemeFilePaths over the containers selected by the options.
+func WorkspaceSharedSchemeFilePathsWithOptions(workspacePth string, opts WorkspaceListOptions) ([]string, error) {
+	return workspaceSchemeFilePaths(workspacePth, opts, sharedSchemeFilePaths)
 }
 
 // ProjectSharedSchemes ...
@@ -95,24 +82,31 @@ func ProjectSharedSchemes(projectPth string) (map[string]bool, error) {
 
 // WorkspaceSharedSchemes ...
 func WorkspaceSharedSchemes(workspacePth string) (map[string]bool, error) {
-	schemeMap, err := sharedSchemes(workspacePth)
+	return WorkspaceSharedSchemesWithOptions(workspacePth, WorkspaceListOptions{})
+}
+
+// WorkspaceSharedSchemesWithOptions is WorkspaceSharedSchemes over the containers selected by the options.
+func WorkspaceSharedSchemesWithOptions(workspacePth string, opts WorkspaceListOptions) (map[string]bool, error) {
+	schemeMap, err := workspaceSchemes(workspacePth, opts, sharedSchemes)
 	if err != nil {
 		return map[string]bool{}, err
 	}
 
-	projects, err := WorkspaceProjectReferences(workspacePth)
-	if err != nil {
-		return nil, err
+	if !opts.IncludePackages {
+		return schemeMap, nil
 	}
 
-	for _, project := range projects {
-		projectSchemeMap, err := sharedSchemes(project)
-		if err != nil {
-			return map[string]bool{}, err
-		}
+	packages, err := WorkspaceSwiftPackagesWithOptions(workspacePth, opts)
+	if err != nil {
+		return map[string]bool{}, err
+	}
 
-		for name, hasXCtest := range projectSchemeMap {
-			schemeMap[name] = hasXCtest
+	// schemes shared by the workspace or its projects take precedence over the generated package schemes
+	for _, manifest := range packages {
+		for name, hasXCTest := range manifest.Schemes() {
+			if _, found := schemeMap[name]; !found {
+				schemeMap[name] = hasXCTest
+			}
 		}
 	}
 
@@ -126,27 +120,12 @@ func ProjectUserSchemeFilePaths(projectPth string) ([]string, error) {
 
 // WorkspaceUserSchemeFilePaths ...
 func WorkspaceUserSchemeFilePaths(workspacePth string) ([]string, error) {
-	workspaceSchemeFilePaths, err := userSchemeFilePaths(workspacePth)
-	if err != nil {
-		return []string{}, err
-	}
-
-	projects, err := WorkspaceProjectReferences(workspacePth)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, project := range projects {
-		projectSchemeFilePaths, err := userSchemeFilePaths(project)
-		if err != nil {
-			return []string{}, err
-		}
-		workspaceSchemeFilePaths = append(workspaceSchemeFilePaths, projectSchemeFilePaths...)
-	}
-
-	sort.Strings(workspaceSchemeFilePaths)
+	return WorkspaceUserSchemeFilePathsWithOptions(workspacePth, WorkspaceListOptions{})
+}
 
-	return workspaceSchemeFilePaths, nil
+// WorkspaceUserSchemeFilePathsWithOptions is WorkspaceUserSchemeFilePaths over the containers selected by the options.
+func WorkspaceUserSchemeFilePathsWithOptions(workspacePth string, opts WorkspaceListOptions) ([]string, error) {
+	return workspaceSchemeFilePaths(workspacePth, opts, userSchemeFilePaths)
 }
 
 // ProjectUserSchemes ...
@@ -156,148 +135,22 @@ func ProjectUserSchemes(projectPth string) (map[string]bool, error) {
 
 // WorkspaceUserSchemes ...
 func WorkspaceUserSchemes(workspacePth string) (map[string]bool, error) {
-	schemeMap, err := userSchemes(workspacePth)
-	if err != nil {
-		return map[string]bool{}, err
-	}
-
-	projects, err := WorkspaceProjectReferences(workspacePth)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, project := range projects {
-		projectSchemeMap, err := userSchemes(project)
-		if err != nil {
-			return map[string]bool{}, err
-		}
-
-		for name, hasXCtest := range projectSchemeMap {
-			schemeMap[name] = hasXCtest
-		}
-	}
-
-	return schemeMap, nil
+	return WorkspaceUserSchemesWithOptions(workspacePth, WorkspaceListOptions{})
 }
 
-func runRubyScriptForOutput(scriptContent, gemfileContent, inDir string, withEnvs []string) (string, error) {
-	tmpDir, err := pathutil.NormalizedOSTempDirPath("bitrise")
-	if err != nil {
-		return "", err
-	}
-
-	// Write Gemfile to file and install
-	if gemfileContent != "" {
-		gemfilePth := path.Join(tmpDir, "Gemfile")
-		if err := fileutil.WriteStringToFile(gemfilePth, gemfileContent); err != nil {
-			return "", err
-		}
-
-		cmd := cmdex.NewCommand("bundle", "install")
-
-		if inDir != "" {
-			cmd.SetDir(inDir)
-		}
-
-		withEnvs = append(withEnvs, "BUNDLE_GEMFILE="+gemfilePth)
-		cmd.SetEnvs(withEnvs)
-
-		var outBuffer bytes.Buffer
-		outWriter := bufio.NewWriter(&outBuffer)
-		cmd.SetStdout(outWriter)
-
-		var errBuffer bytes.Buffer
-		errWriter := bufio.NewWriter(&errBuffer)
-		cmd.SetStderr(errWriter)
-
-		if err := cmd.Run(); err != nil {
-			if errorutil.IsExitStatusError(err) {
-				errMsg := ""
-				if errBuffer.String() != "" {
-					errMsg += fmt.Sprintf("error: %s\n", errBuffer.String())
-				}
-				if outBuffer.String() != "" {
-					errMsg += fmt.Sprintf("output: %s", outBuffer.String())
-				}
-				if errMsg == "" {
-					return "", err
-				}
-
-				return "", errors.New(errMsg)
-			}
-			return "", err
-		}
-	}
-
-	// Write script to file and run
-	rubyScriptPth := path.Join(tmpDir, "script.rb")
-	if err := fileutil.WriteStringToFile(rubyScriptPth, scriptContent); err != nil {
-		return "", err
-	}
-
-	var cmd *cmdex.CommandModel
-
-	if gemfileContent != "" {
-		cmd = cmdex.NewCommand("bundle", "exec", "ruby", rubyScriptPth)
-	} else {
-		cmd = cmdex.NewCommand("ruby", rubyScriptPth)
-	}
-
-	if inDir != "" {
-		cmd.SetDir(inDir)
-	}
-
-	if len(withEnvs) > 0 {
-		cmd.SetEnvs(withEnvs)
-	}
-
-	var outBuffer bytes.Buffer
-	outWriter := bufio.NewWriter(&outBuffer)
-	cmd.SetStdout(outWriter)
-
-	var errBuffer bytes.Buffer
-	errWriter := bufio.NewWriter(&errBuffer)
-	cmd.SetStderr(errWriter)
-
-	if err := cmd.Run(); err != nil {
-		if errorutil.IsExitStatusError(err) {
-			errMsg := ""
-			if errBuffer.String() != "" {
-				errMsg += fmt.Sprintf("error: %s\n", errBuffer.String())
-			}
-			if outBuffer.String() != "" {
-				errMsg += fmt.Sprintf("output: %s", outBuffer.String())
-			}
-			if errMsg == "" {
-				return "", err
-			}
-
-			return "", errors.New(errMsg)
-		}
-		return "", err
-	}
-
-	return outBuffer.String(), nil
-}
-
-func runRubyScript(scriptContent, gemfileContent, inDir string, withEnvs []string) error {
-	_, err := runRubyScriptForOutput(scriptContent, gemfileContent, inDir, withEnvs)
-	return err
-}
-
-// ReCreateProjectUserSchemes ....
-func ReCreateProjectUserSchemes(projectPth string) error {
-	projectDir := filepath.Dir(projectPth)
-
-	projectBase := filepath.Base(projectPth)
-	envs := append(os.Environ(), "project_path="+projectBase, "LC_ALL=en_US.UTF-8")
-
-	return runRubyScript(recreateUserSchemesRubyScriptContent, xcodeprojGemfileContent, projectDir, envs)
+// WorkspaceUserSchemesWithOptions is WorkspaceUserSchemes over the containers selected by the options.
+func WorkspaceUserSchemesWithOptions(workspacePth string, opts WorkspaceListOptions) (map[string]bool, error) {
+	return workspaceSchemes(workspacePth, opts, userSchemes)
 }
 
 // ReCreateWorkspaceUserSchemes ...
 func ReCreateWorkspaceUserSchemes(workspacePth string) error {
-	projects, err := WorkspaceProjectReferences(workspacePth)
+	return ReCreateWorkspaceUserSchemesWithOptions(workspacePth, WorkspaceListOptions{})
+}
+
+// ReCreateWorkspaceUserSchemesWithOptions is ReCreateWorkspaceUserSchemes over the projects selected by the options.
+func ReCreateWorkspaceUserSchemesWithOptions(workspacePth string, opts WorkspaceListOptions) error {
+	_, projects, err := workspaceContainers(workspacePth, opts)
 	if err != nil {
 		return err
 	}
@@ -313,25 +166,22 @@ func ReCreateWorkspaceUserSchemes(workspacePth string) error {
 
 // ProjectTargets ...
 func ProjectTargets(projectPth string) (map[string]bool, error) {
-	pbxProjPth := filepath.Join(projectPth, "project.pbxproj")
-	if exist, err := pathutil.IsPathExists(pbxProjPth); err != nil {
-		return map[string]bool{}, err
-	} else if !exist {
-		return map[string]bool{}, fmt.Errorf("project.pbxproj does not exist at: %s", pbxProjPth)
-	}
-
-	content, err := fileutil.ReadStringFromFile(pbxProjPth)
+	project, err := OpenProject(projectPth)
 	if err != nil {
 		return map[string]bool{}, err
 	}
 
-	return pbxprojContentTartgets(content)
-
+	return projectTargets(project), nil
 }
 
 // WorkspaceTargets ...
 func WorkspaceTargets(workspacePth string) (map[string]bool, error) {
-	projects, err := WorkspaceProjectReferences(workspacePth)
+	return WorkspaceTargetsWithOptions(workspacePth, WorkspaceListOptions{})
+}
+
+// WorkspaceTargetsWithOptions is WorkspaceTargets over the projects selected by the options.
+func WorkspaceTargetsWithOptions(workspacePth string, opts WorkspaceListOptions) (map[string]bool, error) {
+	_, projects, err := workspaceContainers(workspacePth, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -353,46 +203,97 @@ func WorkspaceTargets(workspacePth string) (map[string]bool, error) {
 
 // WorkspaceProjectReferences ...
 func WorkspaceProjectReferences(workspace string) ([]string, error) {
-	projects := []string{}
+	w, err := OpenWorkspace(workspace)
+	if err != nil {
+		return []string{}, err
+	}
 
-	workspaceDir := filepath.Dir(workspace)
+	projects := w.ProjectPaths()
+	sort.Strings(projects)
 
-	xcworkspacedataPth := path.Join(workspace, "contents.xcworkspacedata")
-	if exist, err := pathutil.IsPathExists(xcworkspacedataPth); err != nil {
+	return projects, nil
+}
+
+// WorkspaceProjectReferencesWithOptions returns the projects selected by the options,
+// like every project of the workspace's container closure if Recursive is set.
+func WorkspaceProjectReferencesWithOptions(workspace string, opts WorkspaceListOptions) ([]string, error) {
+	_, projects, err := workspaceContainers(workspace, opts)
+	if err != nil {
 		return []string{}, err
-	} else if !exist {
-		return []string{}, fmt.Errorf("contents.xcworkspacedata does not exist at: %s", xcworkspacedataPth)
 	}
 
-	xcworkspacedataStr, err := fileutil.ReadStringFromFile(xcworkspacedataPth)
+	sort.Strings(projects)
+
+	return projects, nil
+}
+
+// ------------------------------
+// Workspace
+
+// workspaceContainers returns the workspaces and projects, which the Workspace* functions operate on:
+// the workspace and its projects, or if Recursive is set, the full container closure of the workspace,
+// without the Pods projects if ExcludePods is set.
+func workspaceContainers(workspacePth string, opts WorkspaceListOptions) ([]string, []string, error) {
+	if !opts.Recursive {
+		projects, err := WorkspaceProjectReferences(workspacePth)
+		if err != nil {
+			return nil, nil, err
+		}
+		if opts.ExcludePods {
+			projects = ExcludePodsProjects(projects)
+		}
+		return []string{workspacePth}, projects, nil
+	}
+
+	containers, err := ResolveWorkspaceContainers(workspacePth)
+	if err != nil {
+		return nil, nil, err
+	}
+	if opts.ExcludePods {
+		return containers.Workspaces, ExcludePodsProjects(containers.Projects), nil
+	}
+	return containers.Workspaces, containers.Projects, nil
+}
+
+func workspaceSchemeFilePaths(workspacePth string, opts WorkspaceListOptions, schemeFilePaths func(string) ([]string, error)) ([]string, error) {
+	workspaces, projects, err := workspaceContainers(workspacePth, opts)
 	if err != nil {
 		return []string{}, err
 	}
 
-	xcworkspacedataLines := strings.Split(xcworkspacedataStr, "\n")
-	fileRefStart := false
-	regexp := regexp.MustCompile(`location = "(.+):(.+).xcodeproj"`)
+	workspaceSchemeFilePaths := []string{}
+	for _, container := range append(workspaces, projects...) {
+		containerSchemeFilePaths, err := schemeFilePaths(container)
+		if err != nil {
+			return []string{}, err
+		}
+		workspaceSchemeFilePaths = append(workspaceSchemeFilePaths, containerSchemeFilePaths...)
+	}
 
-	for _, line := range xcworkspacedataLines {
-		if strings.Contains(line, "<FileRef") {
-			fileRefStart = true
-			continue
+	sort.Strings(workspaceSchemeFilePaths)
+
+	return workspaceSchemeFilePaths, nil
+}
+
+func workspaceSchemes(workspacePth string, opts WorkspaceListOptions, schemes func(string) (map[string]bool, error)) (map[string]bool, error) {
+	workspaces, projects, err := workspaceContainers(workspacePth, opts)
+	if err != nil {
+		return map[string]bool{}, err
+	}
+
+	schemeMap := map[string]bool{}
+	for _, container := range append(workspaces, projects...) {
+		containerSchemeMap, err := schemes(container)
+		if err != nil {
+			return map[string]bool{}, err
 		}
 
-		if fileRefStart {
-			fileRefStart = false
-			matches := regexp.FindStringSubmatch(line)
-			if len(matches) == 3 {
-				projectName := matches[2]
-				project := filepath.Join(workspaceDir, projectName+".xcodeproj")
-				projects = append(projects, project)
-			}
+		for name, hasXCtest := range containerSchemeMap {
+			schemeMap[name] = hasXCtest
 		}
 	}
 
-	sort.Strings(projects)
-
-	return projects, nil
+	return schemeMap, nil
 }
 
 // ------------------------------
@@ -503,354 +404,57 @@ func sharedSchemes(projectOrWorkspacePth string) (map[string]bool, error) {
 }
 
 func schemeFileContentContainsXCTestBuildAction(schemeFileContent string) (bool, error) {
-	testActionStartPattern := "<TestAction"
-	testActionEndPattern := "</TestAction>"
-	isTestableAction := false
-
-	testableReferenceStartPattern := "<TestableReference"
-	testableReferenceSkippedRegexp := regexp.MustCompile(`skipped = "(?P<skipped>.+)"`)
-	testableReferenceEndPattern := "</TestableReference>"
-	isTestableReference := false
-
-	xctestBuildableReferenceNameRegexp := regexp.MustCompile(`BuildableName = ".+.xctest"`)
-
-	scanner := bufio.NewScanner(strings.NewReader(schemeFileContent))
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		if strings.TrimSpace(line) == testActionEndPattern {
-			break
-		}
-
-		if strings.TrimSpace(line) == testActionStartPattern {
-			isTestableAction = true
-			continue
-		}
-
-		if !isTestableAction {
-			continue
-		}
-
-		// TestAction
-
-		if strings.TrimSpace(line) == testableReferenceEndPattern {
-			isTestableReference = false
-			continue
-		}
-
-		if strings.TrimSpace(line) == testableReferenceStartPattern {
-			isTestableReference = true
-			continue
-		}
-
-		if !isTestableReference {
-			continue
-		}
-
-		// TestableReference
-
-		if matches := testableReferenceSkippedRegexp.FindStringSubmatch(line); len(matches) > 1 {
-			skipped := matches[1]
-			if skipped != "NO" {
-				break
-			}
-		}
-
-		if match := xctestBuildableReferenceNameRegexp.FindString(line); match != "" {
-			return true, nil
-		}
-	}
-
-	if err := scanner.Err(); err != nil {
+	scheme, err := ParseScheme(schemeFileContent)
+	if err != nil {
 		return false, err
 	}
 
-	return false, nil
-}
-
-// PBXTargetDependency ...
-type PBXTargetDependency struct {
-	id     string
-	isa    string
-	target string
+	return scheme.ContainsXCTestBuildAction(), nil
 }
 
-func parsePBXTargetDependencies(pbxprojContent string) ([]PBXTargetDependency, error) {
-	pbxTargetDependencies := []PBXTargetDependency{}
-
-	id := ""
-	isa := ""
-	target := ""
-
-	beginPBXTargetDependencySectionPattern := `/* Begin PBXTargetDependency section */`
-	endPBXTargetDependencySectionPattern := `/* End PBXTargetDependency section */`
-	isPBXTargetDependencySection := false
-
-	// BAAFFEEF19EE788800F3AC91 /* PBXTargetDependency */ = {
-	beginPBXTargetDependencyRegexp := regexp.MustCompile(`\s*(?P<id>[A-Z0-9]+) /\* (?P<isa>.*) \*/ = {`)
-	endPBXTargetDependencyPattern := `};`
-	isPBXTargetDependency := false
-
-	// isa = PBXTargetDependency;
-	isaRegexp := regexp.MustCompile(`\s*isa = (?P<isa>.*);`)
-	// target = BAAFFED019EE788800F3AC91 /* SampleAppWithCocoapods */;
-	targetRegexp := regexp.MustCompile(`\s*target = (?P<id>[A-Z0-9]+) /\* (?P<name>.*) \*/;`)
-
-	scanner := bufio.NewScanner(strings.NewReader(pbxprojContent))
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		if strings.TrimSpace(line) == endPBXTargetDependencySectionPattern {
-			break
-		}
-
-		if strings.TrimSpace(line) == beginPBXTargetDependencySectionPattern {
-			isPBXTargetDependencySection = true
-			continue
-		}
-
-		if !isPBXTargetDependencySection {
-			continue
-		}
-
-		// PBXTargetDependency section
-
-		if strings.TrimSpace(line) == endPBXTargetDependencyPattern {
-			pbxTargetDependency := PBXTargetDependency{
-				id:     id,
-				isa:    isa,
-				target: target,
-			}
-			pbxTargetDependencies = append(pbxTargetDependencies, pbxTargetDependency)
-
-			id = ""
-			isa = ""
-			target = ""
-
-			isPBXTargetDependency = false
-			continue
-		}
-
-		if matches := beginPBXTargetDependencyRegexp.FindStringSubmatch(line); len(matches) == 3 {
-			id = matches[1]
-			isa = matches[2]
-
-			isPBXTargetDependency = true
-			continue
-		}
-
-		if !isPBXTargetDependency {
-			continue
-		}
-
-		// PBXTargetDependency item
-
-		if matches := isaRegexp.FindStringSubmatch(line); len(matches) == 2 {
-			isa = strings.Trim(matches[1], `"`)
-		}
-
-		if matches := targetRegexp.FindStringSubmatch(line); len(matches) == 3 {
-			targetID := strings.Trim(matches[1], `"`)
-			// targetName := strings.Trim(matches[2], `"`)
-
-			target = targetID
-		}
+// productPath returns the path of the target's product.
+// If the product file reference is not part of the content (partial project.pbxproj content),
+// the `/* name */` annotation of the productReference is used.
+func (p *Project) productPath(target *PBXNativeTarget) string {
+	if target.ProductReference != nil {
+		return target.ProductReference.Path
 	}
 
-	return pbxTargetDependencies, nil
-}
-
-// PBXNativeTarget ...
-type PBXNativeTarget struct {
-	id           string
-	isa          string
-	dependencies []string
-	name         string
-	productPath  string
-	productType  string
-}
-
-func parsePBXNativeTargets(pbxprojContent string) ([]PBXNativeTarget, error) {
-	pbxNativeTargets := []PBXNativeTarget{}
-
-	id := ""
-	isa := ""
-	dependencies := []string{}
-	name := ""
-	productPath := ""
-	productType := ""
-
-	beginPBXNativeTargetSectionPattern := `/* Begin PBXNativeTarget section */`
-	endPBXNativeTargetSectionPattern := `/* End PBXNativeTarget section */`
-	isPBXNativeTargetSection := false
-
-	// BAAFFED019EE788800F3AC91 /* SampleAppWithCocoapods */ = {
-	beginPBXNativeTargetRegexp := regexp.MustCompile(`\s*(?P<id>[A-Z0-9]+) /\* (?P<name>.*) \*/ = {`)
-	endPBXNativeTargetPattern := `};`
-	isPBXNativeTarget := false
-
-	// isa = PBXNativeTarget;
-	isaRegexp := regexp.MustCompile(`\s*isa = (?P<isa>.*);`)
-
-	beginDependenciesPattern := `dependencies = (`
-	dependencieRegexp := regexp.MustCompile(`\s*(?P<id>[A-Z0-9]+) /\* (?P<isa>.*) \*/,`)
-	endDependenciesPattern := `);`
-	isDependencies := false
-
-	// name = SampleAppWithCocoapods;
-	nameRegexp := regexp.MustCompile(`\s*name = (?P<name>.*);`)
-	// productReference = BAAFFEED19EE788800F3AC91 /* SampleAppWithCocoapodsTests.xctest */;
-	productReferenceRegexp := regexp.MustCompile(`\s*productReference = (?P<id>[A-Z0-9]+) /\* (?P<path>.*) \*/;`)
-	// productType = "com.apple.product-type.bundle.unit-test";
-	productTypeRegexp := regexp.MustCompile(`\s*productType = (?P<productType>.*);`)
-
-	scanner := bufio.NewScanner(strings.NewReader(pbxprojContent))
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		if strings.TrimSpace(line) == endPBXNativeTargetSectionPattern {
-			break
-		}
-
-		if strings.TrimSpace(line) == beginPBXNativeTargetSectionPattern {
-			isPBXNativeTargetSection = true
-			continue
-		}
-
-		if !isPBXNativeTargetSection {
-			continue
-		}
-
-		// PBXNativeTarget section
-
-		if strings.TrimSpace(line) == endPBXNativeTargetPattern {
-			pbxNativeTarget := PBXNativeTarget{
-				id:           id,
-				isa:          isa,
-				dependencies: dependencies,
-				name:         name,
-				productPath:  productPath,
-				productType:  productType,
-			}
-			pbxNativeTargets = append(pbxNativeTargets, pbxNativeTarget)
-
-			id = ""
-			isa = ""
-			name = ""
-			productPath = ""
-			productType = ""
-			dependencies = []string{}
-
-			isPBXNativeTarget = false
-			continue
-		}
-
-		if matches := beginPBXNativeTargetRegexp.FindStringSubmatch(line); len(matches) == 3 {
-			id = matches[1]
-			name = matches[2]
-
-			isPBXNativeTarget = true
-			continue
-		}
-
-		if !isPBXNativeTarget {
-			continue
-		}
-
-		// PBXNativeTarget item
-
-		if matches := isaRegexp.FindStringSubmatch(line); len(matches) == 2 {
-			isa = strings.Trim(matches[1], `"`)
-		}
-
-		if matches := nameRegexp.FindStringSubmatch(line); len(matches) == 2 {
-			name = strings.Trim(matches[1], `"`)
-		}
-
-		if matches := productTypeRegexp.FindStringSubmatch(line); len(matches) == 2 {
-			productType = strings.Trim(matches[1], `"`)
-		}
-
-		if matches := productReferenceRegexp.FindStringSubmatch(line); len(matches) == 3 {
-			// productId := strings.Trim(matches[1], `"`)
-			productPath = strings.Trim(matches[2], `"`)
-		}
-
-		if isDependencies && strings.TrimSpace(line) == endDependenciesPattern {
-			isDependencies = false
-			continue
-		}
-
-		if strings.TrimSpace(line) == beginDependenciesPattern {
-			isDependencies = true
-			continue
-		}
-
-		if !isDependencies {
-			continue
-		}
-
-		// dependencies
-		if matches := dependencieRegexp.FindStringSubmatch(line); len(matches) == 3 {
-			dependencieID := strings.Trim(matches[1], `"`)
-			dependencieIsa := strings.Trim(matches[2], `"`)
-
-			if dependencieIsa == "PBXTargetDependency" {
-				dependencies = append(dependencies, dependencieID)
-			}
-		}
+	raw, found := p.objects.GetDict(target.ID)
+	if !found {
+		return ""
 	}
-
-	if err := scanner.Err(); err != nil {
-		return []PBXNativeTarget{}, err
+	value, found := raw.Get("productReference")
+	if !found {
+		return ""
 	}
-
-	return pbxNativeTargets, nil
-}
-
-func targetDependencieWithID(dependencies []PBXTargetDependency, id string) (PBXTargetDependency, bool) {
-	for _, dependencie := range dependencies {
-		if dependencie.id == id {
-			return dependencie, true
-		}
+	productReference, ok := value.(PlistString)
+	if !ok {
+		return ""
 	}
-	return PBXTargetDependency{}, false
+	return productReference.Comment
 }
 
-func targetWithID(targets []PBXNativeTarget, id string) (PBXNativeTarget, bool) {
-	for _, target := range targets {
-		if target.id == id {
-			return target, true
-		}
+// isTestTarget reports whether the target builds a test bundle, based on its product type.
+// Partial project.pbxproj contents may miss the product type, then the product's extension is checked.
+func (p *Project) isTestTarget(target *PBXNativeTarget) bool {
+	if target.ProductType != "" {
+		return target.ProductType.IsTest()
 	}
-	return PBXNativeTarget{}, false
+	return path.Ext(p.productPath(target)) == ".xctest"
 }
 
-func pbxprojContentTartgets(pbxprojContent string) (map[string]bool, error) {
+func projectTargets(project *Project) map[string]bool {
 	targetMap := map[string]bool{}
 
-	targets, err := parsePBXNativeTargets(pbxprojContent)
-	if err != nil {
-		return map[string]bool{}, err
-	}
-
-	targetDependencies, err := parsePBXTargetDependencies(pbxprojContent)
-	if err != nil {
-		return map[string]bool{}, err
-	}
+	targets := project.NativeTargets()
 
 	// Add targets which has test targets
 	for _, target := range targets {
-		if path.Ext(target.productPath) == ".xctest" {
-			if len(target.dependencies) > 0 {
-				for _, dependencieID := range target.dependencies {
-					dependency, found := targetDependencieWithID(targetDependencies, dependencieID)
-					if found {
-						dependentTarget, found := targetWithID(targets, dependency.target)
-						if found {
-							targetMap[dependentTarget.name] = true
-						}
-					}
+		if project.isTestTarget(target) {
+			for _, dependency := range target.Dependencies {
+				if dependency.Target != nil {
+					targetMap[dependency.Target.AbstractTarget().Name] = true
 				}
 			}
 		}
@@ -858,13 +462,22 @@ func pbxprojContentTartgets(pbxprojContent string) (map[string]bool, error) {
 
 	// Add targets which has NO test targets
 	for _, target := range targets {
-		if path.Ext(target.productPath) != ".xctest" {
-			_, found := targetMap[target.name]
+		if !project.isTestTarget(target) {
+			_, found := targetMap[target.Name]
 			if !found {
-				targetMap[target.name] = false
+				targetMap[target.Name] = false
 			}
 		}
 	}
 
-	return targetMap, nil
+	return targetMap
+}
+
+func pbxprojContentTartgets(pbxprojContent string) (map[string]bool, error) {
+	project, err := parseProjectChunk(pbxprojContent)
+	if err != nil {
+		return map[string]bool{}, err
+	}
+
+	return projectTargets(project), nil
 }
@@ -1,6 +1,7 @@
 package xcodeproj
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -180,6 +181,21 @@ func TestSchemeFileContentContainsXCTestBuildAction(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, false, contains)
 	}
+
+	t.Log("Contains XCTestBuildAction - attributes in the same line, first testable skipped")
+	{
+		schemeContent := compactSchemeContent
+
+		contains, err := schemeFileContentContainsXCTestBuildAction(schemeContent)
+		require.NoError(t, err)
+		require.Equal(t, true, contains)
+	}
+
+	t.Log("Invalid scheme content")
+	{
+		_, err := schemeFileContentContainsXCTestBuildAction("<Scheme>")
+		require.Error(t, err)
+	}
 }
 
 func TestIsXCodeProj(t *testing.T) {
@@ -242,86 +258,14 @@ func TestPBXProjContentTartgets(t *testing.T) {
 		require.Equal(t, true, found)
 		require.Equal(t, true, hasXCTest)
 	}
-}
-
-func TestParsePBXTargetDependencies(t *testing.T) {
-	desiredDependencieMap := map[string]PBXTargetDependency{
-		"BAAFFEEF19EE788800F3AC91": PBXTargetDependency{
-			id:     "BAAFFEEF19EE788800F3AC91",
-			isa:    "PBXTargetDependency",
-			target: "BAAFFED019EE788800F3AC91",
-		},
-	}
-
-	dependencies, err := parsePBXTargetDependencies(pbxTargetDependencies)
-	require.NoError(t, err)
-	require.Equal(t, len(desiredDependencieMap), len(dependencies))
-
-	matchingDependencieMap := map[string]bool{}
-	for _, dependencie := range dependencies {
-		desiredDependencie, found := desiredDependencieMap[dependencie.id]
-		require.Equal(t, true, found)
 
-		require.Equal(t, desiredDependencie.id, dependencie.id)
-		require.Equal(t, desiredDependencie.isa, dependencie.isa)
-		require.Equal(t, desiredDependencie.target, dependencie.target)
-
-		matchingDependencieMap[dependencie.id] = true
-	}
-
-	require.Equal(t, len(desiredDependencieMap), len(matchingDependencieMap))
-}
-
-func TestParsePBXNativeTargets(t *testing.T) {
-	desiredTargetMap := map[string]PBXNativeTarget{
-		"BADDF9E61A703F87004C3526": PBXNativeTarget{
-			id:           "BADDF9E61A703F87004C3526",
-			isa:          "PBXNativeTarget",
-			dependencies: []string{},
-			name:         "BitriseSampleAppsiOS With Spaces",
-			productPath:  "BitriseSampleAppsiOS With Spaces.app",
-			productType:  "com.apple.product-type.application",
-		},
-		"BADDFA021A703F87004C3526": PBXNativeTarget{
-			id:  "BADDFA021A703F87004C3526",
-			isa: "PBXNativeTarget",
-			dependencies: []string{
-				"BADDFA051A703F87004C3526",
-			},
-			name:        "BitriseSampleAppsiOS With SpacesTests",
-			productPath: "BitriseSampleAppsiOS With SpacesTests.xctest",
-			productType: "com.apple.product-type.bundle.unit-test",
-		},
-	}
-
-	targets, err := parsePBXNativeTargets(pbxNativeTargetSectionWithSpace)
-	require.NoError(t, err)
-	require.Equal(t, len(desiredTargetMap), len(targets))
-
-	matchingTargetMap := map[string]bool{}
-	for _, target := range targets {
-		desiredTarget, found := desiredTargetMap[target.id]
-		require.Equal(t, true, found)
-
-		require.Equal(t, desiredTarget.id, target.id)
-		require.Equal(t, desiredTarget.isa, target.isa)
-		require.Equal(t, desiredTarget.name, target.name)
-		require.Equal(t, desiredTarget.productPath, target.productPath)
-		require.Equal(t, desiredTarget.productType, target.productType)
-		require.Equal(t, len(desiredTarget.dependencies), len(target.dependencies))
-
-		foundDependencieMap := map[string]bool{}
-		for _, dep := range target.dependencies {
-			for _, desiredDep := range desiredTarget.dependencies {
-				if dep == desiredDep {
-					foundDependencieMap[dep] = true
-				}
-			}
-		}
-		require.Equal(t, len(desiredTarget.dependencies), len(foundDependencieMap))
+	t.Log("test targets with renamed products")
+	{
+		content := strings.Replace(sampleAppPbxprojContent, "path = SampleAppTests.xctest;", "path = SampleAppTests.bundle;", 1)
+		content = strings.Replace(content, "path = SampleAppUITests.xctest;", "path = SampleAppUITests.bundle;", 1)
 
-		matchingTargetMap[target.id] = true
+		targetMap, err := pbxprojContentTartgets(content)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"SampleApp": true}, targetMap)
 	}
-
-	require.Equal(t, len(desiredTargetMap), len(matchingTargetMap))
 }
@@ -345,5 +345,3334 @@ const (
 /* End PBXTargetDependency section */
 
 /* Begin PBXVariantGroup section */
+`
+
+	sampleAppPbxprojContent = `// !$*UTF8*$!
+{
+	archiveVersion = 1;
+	classes = {
+	};
+	objectVersion = 50;
+	objects = {
+
+/* Begin PBXAggregateTarget section */
+		8D3E2A382176C1D300A4F1B2 /* Lint */ = {
+			isa = PBXAggregateTarget;
+			buildConfigurationList = 8D3E2A392176C1D300A4F1B2 /* Build configuration list for PBXAggregateTarget "Lint" */;
+			buildPhases = (
+				8D3E2A3C2176C1D300A4F1B2 /* SwiftLint */,
+			);
+			dependencies = (
+			);
+			name = Lint;
+			productName = Lint;
+		};
+/* End PBXAggregateTarget section */
+
+/* Begin PBXBuildFile section */
+		8D3E2A0A2176C1D300A4F1B2 /* AppDelegate.swift in Sources */ = {isa = PBXBuildFile; fileRef = 8D3E2A092176C1D300A4F1B2 /* AppDelegate.swift */; };
+		8D3E2A0C2176C1D300A4F1B2 /* ViewController.swift in Sources */ = {isa = PBXBuildFile; fileRef = 8D3E2A0B2176C1D300A4F1B2 /* ViewController.swift */; };
+		8D3E2A0F2176C1D300A4F1B2 /* Main.storyboard in Resources */ = {isa = PBXBuildFile; fileRef = 8D3E2A0D2176C1D300A4F1B2 /* Main.storyboard */; };
+		8D3E2A112176C1D300A4F1B2 /* Assets.xcassets in Resources */ = {isa = PBXBuildFile; fileRef = 8D3E2A102176C1D300A4F1B2 /* Assets.xcassets */; };
+		8D3E2A142176C1D300A4F1B2 /* LaunchScreen.storyboard in Resources */ = {isa = PBXBuildFile; fileRef = 8D3E2A122176C1D300A4F1B2 /* LaunchScreen.storyboard */; };
+		8D3E2A1D2176C1D300A4F1B2 /* SampleAppTests.swift in Sources */ = {isa = PBXBuildFile; fileRef = 8D3E2A1C2176C1D300A4F1B2 /* SampleAppTests.swift */; };
+		8D3E2A282176C1D300A4F1B2 /* SampleAppUITests.swift in Sources */ = {isa = PBXBuildFile; fileRef = 8D3E2A272176C1D300A4F1B2 /* SampleAppUITests.swift */; };
+/* End PBXBuildFile section */
+
+/* Begin PBXContainerItemProxy section */
+		8D3E2A1F2176C1D300A4F1B2 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = 8D3E2A012176C1D300A4F1B2 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = 8D3E2A042176C1D300A4F1B2;
+			remoteInfo = SampleApp;
+		};
+		8D3E2A2A2176C1D300A4F1B2 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = 8D3E2A012176C1D300A4F1B2 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = 8D3E2A042176C1D300A4F1B2;
+			remoteInfo = SampleApp;
+		};
+/* End PBXContainerItemProxy section */
+
+/* Begin PBXFileReference section */
+		8D3E2A022176C1D300A4F1B2 /* SampleApp.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = SampleApp.app; sourceTree = BUILT_PRODUCTS_DIR; };
+		8D3E2A092176C1D300A4F1B2 /* AppDelegate.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = AppDelegate.swift; sourceTree = "<group>"; };
+		8D3E2A0B2176C1D300A4F1B2 /* ViewController.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = ViewController.swift; sourceTree = "<group>"; };
+		8D3E2A0E2176C1D300A4F1B2 /* Base */ = {isa = PBXFileReference; lastKnownFileType = file.storyboard; name = Base; path = Base.lproj/Main.storyboard; sourceTree = "<group>"; };
+		8D3E2A102176C1D300A4F1B2 /* Assets.xcassets */ = {isa = PBXFileReference; lastKnownFileType = folder.assetcatalog; path = Assets.xcassets; sourceTree = "<group>"; };
+		8D3E2A132176C1D300A4F1B2 /* Base */ = {isa = PBXFileReference; lastKnownFileType = file.storyboard; name = Base; path = Base.lproj/LaunchScreen.storyboard; sourceTree = "<group>"; };
+		8D3E2A152176C1D300A4F1B2 /* Info.plist */ = {isa = PBXFileReference; lastKnownFileType = text.plist.xml; path = Info.plist; sourceTree = "<group>"; };
+		8D3E2A1A2176C1D300A4F1B2 /* SampleAppTests.xctest */ = {isa = PBXFileReference; explicitFileType = wrapper.cfbundle; includeInIndex = 0; path = SampleAppTests.xctest; sourceTree = BUILT_PRODUCTS_DIR; };
+		8D3E2A1C2176C1D300A4F1B2 /* SampleAppTests.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = SampleAppTests.swift; sourceTree = "<group>"; };
+		8D3E2A1E2176C1D300A4F1B2 /* Info.plist */ = {isa = PBXFileReference; lastKnownFileType = text.plist.xml; path = Info.plist; sourceTree = "<group>"; };
+		8D3E2A252176C1D300A4F1B2 /* SampleAppUITests.xctest */ = {isa = PBXFileReference; explicitFileType = wrapper.cfbundle; includeInIndex = 0; path = SampleAppUITests.xctest; sourceTree = BUILT_PRODUCTS_DIR; };
+		8D3E2A272176C1D300A4F1B2 /* SampleAppUITests.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = SampleAppUITests.swift; sourceTree = "<group>"; };
+		8D3E2A292176C1D300A4F1B2 /* Info.plist */ = {isa = PBXFileReference; lastKnownFileType = text.plist.xml; path = Info.plist; sourceTree = "<group>"; };
+		8D3E2A3E2176C1D300A4F1B2 /* Base.xcconfig */ = {isa = PBXFileReference; lastKnownFileType = text.xcconfig; path = Base.xcconfig; sourceTree = "<group>"; };
+/* End PBXFileReference section */
+
+/* Begin PBXFrameworksBuildPhase section */
+		8D3E2A062176C1D300A4F1B2 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8D3E2A182176C1D300A4F1B2 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8D3E2A232176C1D300A4F1B2 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXFrameworksBuildPhase section */
+
+/* Begin PBXGroup section */
+		8D3E2A002176C1D300A4F1B2 = {
+			isa = PBXGroup;
+			children = (
+				8D3E2A3E2176C1D300A4F1B2 /* Base.xcconfig */,
+				8D3E2A082176C1D300A4F1B2 /* SampleApp */,
+				8D3E2A1B2176C1D300A4F1B2 /* SampleAppTests */,
+				8D3E2A262176C1D300A4F1B2 /* SampleAppUITests */,
+				8D3E2A032176C1D300A4F1B2 /* Products */,
+			);
+			sourceTree = "<group>";
+		};
+		8D3E2A032176C1D300A4F1B2 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				8D3E2A022176C1D300A4F1B2 /* SampleApp.app */,
+				8D3E2A1A2176C1D300A4F1B2 /* SampleAppTests.xctest */,
+				8D3E2A252176C1D300A4F1B2 /* SampleAppUITests.xctest */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+		8D3E2A082176C1D300A4F1B2 /* SampleApp */ = {
+			isa = PBXGroup;
+			children = (
+				8D3E2A092176C1D300A4F1B2 /* AppDelegate.swift */,
+				8D3E2A0B2176C1D300A4F1B2 /* ViewController.swift */,
+				8D3E2A0D2176C1D300A4F1B2 /* Main.storyboard */,
+				8D3E2A102176C1D300A4F1B2 /* Assets.xcassets */,
+				8D3E2A122176C1D300A4F1B2 /* LaunchScreen.storyboard */,
+				8D3E2A152176C1D300A4F1B2 /* Info.plist */,
+			);
+			path = SampleApp;
+			sourceTree = "<group>";
+		};
+		8D3E2A1B2176C1D300A4F1B2 /* SampleAppTests */ = {
+			isa = PBXGroup;
+			children = (
+				8D3E2A1C2176C1D300A4F1B2 /* SampleAppTests.swift */,
+				8D3E2A1E2176C1D300A4F1B2 /* Info.plist */,
+			);
+			path = SampleAppTests;
+			sourceTree = "<group>";
+		};
+		8D3E2A262176C1D300A4F1B2 /* SampleAppUITests */ = {
+			isa = PBXGroup;
+			children = (
+				8D3E2A272176C1D300A4F1B2 /* SampleAppUITests.swift */,
+				8D3E2A292176C1D300A4F1B2 /* Info.plist */,
+			);
+			path = SampleAppUITests;
+			sourceTree = "<group>";
+		};
+/* End PBXGroup section */
+
+/* Begin PBXNativeTarget section */
+		8D3E2A042176C1D300A4F1B2 /* SampleApp */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = 8D3E2A2F2176C1D300A4F1B2 /* Build configuration list for PBXNativeTarget "SampleApp" */;
+			buildPhases = (
+				8D3E2A3D2176C1D300A4F1B2 /* Generate Version */,
+				8D3E2A052176C1D300A4F1B2 /* Sources */,
+				8D3E2A062176C1D300A4F1B2 /* Frameworks */,
+				8D3E2A072176C1D300A4F1B2 /* Resources */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = SampleApp;
+			productName = SampleApp;
+			productReference = 8D3E2A022176C1D300A4F1B2 /* SampleApp.app */;
+			productType = "com.apple.product-type.application";
+		};
+		8D3E2A162176C1D300A4F1B2 /* SampleAppTests */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = 8D3E2A322176C1D300A4F1B2 /* Build configuration list for PBXNativeTarget "SampleAppTests" */;
+			buildPhases = (
+				8D3E2A172176C1D300A4F1B2 /* Sources */,
+				8D3E2A182176C1D300A4F1B2 /* Frameworks */,
+				8D3E2A192176C1D300A4F1B2 /* Resources */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+				8D3E2A202176C1D300A4F1B2 /* PBXTargetDependency */,
+			);
+			name = SampleAppTests;
+			productName = SampleAppTests;
+			productReference = 8D3E2A1A2176C1D300A4F1B2 /* SampleAppTests.xctest */;
+			productType = "com.apple.product-type.bundle.unit-test";
+		};
+		8D3E2A212176C1D300A4F1B2 /* SampleAppUITests */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = 8D3E2A352176C1D300A4F1B2 /* Build configuration list for PBXNativeTarget "SampleAppUITests" */;
+			buildPhases = (
+				8D3E2A222176C1D300A4F1B2 /* Sources */,
+				8D3E2A232176C1D300A4F1B2 /* Frameworks */,
+				8D3E2A242176C1D300A4F1B2 /* Resources */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+				8D3E2A2B2176C1D300A4F1B2 /* PBXTargetDependency */,
+			);
+			name = SampleAppUITests;
+			productName = SampleAppUITests;
+			productReference = 8D3E2A252176C1D300A4F1B2 /* SampleAppUITests.xctest */;
+			productType = "com.apple.product-type.bundle.ui-testing";
+		};
+/* End PBXNativeTarget section */
+
+/* Begin PBXProject section */
+		8D3E2A012176C1D300A4F1B2 /* Project object */ = {
+			isa = PBXProject;
+			attributes = {
+				LastSwiftUpdateCheck = 1000;
+				LastUpgradeCheck = 1000;
+				ORGANIZATIONNAME = Bitrise;
+				TargetAttributes = {
+					8D3E2A042176C1D300A4F1B2 = {
+						CreatedOnToolsVersion = 10.0;
+					};
+					8D3E2A162176C1D300A4F1B2 = {
+						CreatedOnToolsVersion = 10.0;
+						TestTargetID = 8D3E2A042176C1D300A4F1B2;
+					};
+					8D3E2A212176C1D300A4F1B2 = {
+						CreatedOnToolsVersion = 10.0;
+						TestTargetID = 8D3E2A042176C1D300A4F1B2;
+					};
+					8D3E2A382176C1D300A4F1B2 = {
+						CreatedOnToolsVersion = 10.0;
+					};
+				};
+			};
+			buildConfigurationList = 8D3E2A2C2176C1D300A4F1B2 /* Build configuration list for PBXProject "SampleApp" */;
+			compatibilityVersion = "Xcode 9.3";
+			developmentRegion = en;
+			hasScannedForEncodings = 0;
+			knownRegions = (
+				en,
+				Base,
+			);
+			mainGroup = 8D3E2A002176C1D300A4F1B2;
+			productRefGroup = 8D3E2A032176C1D300A4F1B2 /* Products */;
+			projectDirPath = "";
+			projectRoot = "";
+			targets = (
+				8D3E2A042176C1D300A4F1B2 /* SampleApp */,
+				8D3E2A162176C1D300A4F1B2 /* SampleAppTests */,
+				8D3E2A212176C1D300A4F1B2 /* SampleAppUITests */,
+				8D3E2A382176C1D300A4F1B2 /* Lint */,
+			);
+		};
+/* End PBXProject section */
+
+/* Begin PBXResourcesBuildPhase section */
+		8D3E2A072176C1D300A4F1B2 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				8D3E2A142176C1D300A4F1B2 /* LaunchScreen.storyboard in Resources */,
+				8D3E2A112176C1D300A4F1B2 /* Assets.xcassets in Resources */,
+				8D3E2A0F2176C1D300A4F1B2 /* Main.storyboard in Resources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8D3E2A192176C1D300A4F1B2 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8D3E2A242176C1D300A4F1B2 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXResourcesBuildPhase section */
+
+/* Begin PBXShellScriptBuildPhase section */
+		8D3E2A3C2176C1D300A4F1B2 /* SwiftLint */ = {
+			isa = PBXShellScriptBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			inputFileListPaths = (
+			);
+			inputPaths = (
+			);
+			name = SwiftLint;
+			outputFileListPaths = (
+			);
+			outputPaths = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+			shellPath = /bin/sh;
+			shellScript = "if which swiftlint >/dev/null; then\n  swiftlint\nelse\n  echo \"warning: SwiftLint not installed\"\nfi\n";
+		};
+		8D3E2A3D2176C1D300A4F1B2 /* Generate Version */ = {
+			isa = PBXShellScriptBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			inputFileListPaths = (
+			);
+			inputPaths = (
+				"$(SRCROOT)/SampleApp/Info.plist",
+			);
+			name = "Generate Version";
+			outputFileListPaths = (
+			);
+			outputPaths = (
+				"$(DERIVED_FILE_DIR)/Version.swift",
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+			shellPath = /bin/bash;
+			shellScript = "set -e\n\"${SRCROOT}/scripts/generate_version.sh\" > \"${DERIVED_FILE_DIR}/Version.swift\"\n";
+		};
+/* End PBXShellScriptBuildPhase section */
+
+/* Begin PBXSourcesBuildPhase section */
+		8D3E2A052176C1D300A4F1B2 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				8D3E2A0C2176C1D300A4F1B2 /* ViewController.swift in Sources */,
+				8D3E2A0A2176C1D300A4F1B2 /* AppDelegate.swift in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8D3E2A172176C1D300A4F1B2 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				8D3E2A1D2176C1D300A4F1B2 /* SampleAppTests.swift in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8D3E2A222176C1D300A4F1B2 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				8D3E2A282176C1D300A4F1B2 /* SampleAppUITests.swift in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXSourcesBuildPhase section */
+
+/* Begin PBXTargetDependency section */
+		8D3E2A202176C1D300A4F1B2 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = 8D3E2A042176C1D300A4F1B2 /* SampleApp */;
+			targetProxy = 8D3E2A1F2176C1D300A4F1B2 /* PBXContainerItemProxy */;
+		};
+		8D3E2A2B2176C1D300A4F1B2 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = 8D3E2A042176C1D300A4F1B2 /* SampleApp */;
+			targetProxy = 8D3E2A2A2176C1D300A4F1B2 /* PBXContainerItemProxy */;
+		};
+/* End PBXTargetDependency section */
+
+/* Begin PBXVariantGroup section */
+		8D3E2A0D2176C1D300A4F1B2 /* Main.storyboard */ = {
+			isa = PBXVariantGroup;
+			children = (
+				8D3E2A0E2176C1D300A4F1B2 /* Base */,
+			);
+			name = Main.storyboard;
+			sourceTree = "<group>";
+		};
+		8D3E2A122176C1D300A4F1B2 /* LaunchScreen.storyboard */ = {
+			isa = PBXVariantGroup;
+			children = (
+				8D3E2A132176C1D300A4F1B2 /* Base */,
+			);
+			name = LaunchScreen.storyboard;
+			sourceTree = "<group>";
+		};
+/* End PBXVariantGroup section */
+
+/* Begin XCBuildConfiguration section */
+		8D3E2A2D2176C1D300A4F1B2 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			baseConfigurationReference = 8D3E2A3E2176C1D300A4F1B2 /* Base.xcconfig */;
+			buildSettings = {
+				ALWAYS_SEARCH_USER_PATHS = NO;
+				CLANG_ENABLE_MODULES = YES;
+				CODE_SIGN_IDENTITY = "iPhone Developer";
+				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = dwarf;
+				ENABLE_TESTABILITY = YES;
+				GCC_OPTIMIZATION_LEVEL = 0;
+				GCC_PREPROCESSOR_DEFINITIONS = (
+					"DEBUG=1",
+					"$(inherited)",
+				);
+				IPHONEOS_DEPLOYMENT_TARGET = 12.0;
+				MTL_ENABLE_DEBUG_INFO = INCLUDE_SOURCE;
+				ONLY_ACTIVE_ARCH = YES;
+				SDKROOT = iphoneos;
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = DEBUG;
+				SWIFT_OPTIMIZATION_LEVEL = "-Onone";
+			};
+			name = Debug;
+		};
+		8D3E2A2E2176C1D300A4F1B2 /* Release */ = {
+			isa = XCBuildConfiguration;
+			baseConfigurationReference = 8D3E2A3E2176C1D300A4F1B2 /* Base.xcconfig */;
+			buildSettings = {
+				ALWAYS_SEARCH_USER_PATHS = NO;
+				CLANG_ENABLE_MODULES = YES;
+				CODE_SIGN_IDENTITY = "iPhone Developer";
+				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = "dwarf-with-dsym";
+				ENABLE_NS_ASSERTIONS = NO;
+				IPHONEOS_DEPLOYMENT_TARGET = 12.0;
+				MTL_ENABLE_DEBUG_INFO = NO;
+				SDKROOT = iphoneos;
+				SWIFT_COMPILATION_MODE = wholemodule;
+				SWIFT_OPTIMIZATION_LEVEL = "-O";
+				VALIDATE_PRODUCT = YES;
+			};
+			name = Release;
+		};
+		8D3E2A302176C1D300A4F1B2 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_APPICON_NAME = AppIcon;
+				CODE_SIGN_STYLE = Automatic;
+				DEVELOPMENT_TEAM = 72SA8V3WYL;
+				INFOPLIST_FILE = SampleApp/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.SampleApp;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 4.2;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;
+		};
+		8D3E2A312176C1D300A4F1B2 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_APPICON_NAME = AppIcon;
+				"CODE_SIGN_IDENTITY[sdk=iphoneos*]" = "iPhone Distribution";
+				CODE_SIGN_STYLE = Manual;
+				DEVELOPMENT_TEAM = 72SA8V3WYL;
+				INFOPLIST_FILE = SampleApp/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.SampleApp;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 4.2;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;
+		};
+		8D3E2A332176C1D300A4F1B2 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_EMBED_SWIFT_STANDARD_LIBRARIES = YES;
+				BUNDLE_LOADER = "$(TEST_HOST)";
+				CODE_SIGN_STYLE = Automatic;
+				INFOPLIST_FILE = SampleAppTests/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+					"@loader_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.SampleAppTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 4.2;
+				TARGETED_DEVICE_FAMILY = "1,2";
+				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/SampleApp.app/SampleApp";
+			};
+			name = Debug;
+		};
+		8D3E2A342176C1D300A4F1B2 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_EMBED_SWIFT_STANDARD_LIBRARIES = YES;
+				BUNDLE_LOADER = "$(TEST_HOST)";
+				CODE_SIGN_STYLE = Automatic;
+				INFOPLIST_FILE = SampleAppTests/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+					"@loader_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.SampleAppTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 4.2;
+				TARGETED_DEVICE_FAMILY = "1,2";
+				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/SampleApp.app/SampleApp";
+			};
+			name = Release;
+		};
+		8D3E2A362176C1D300A4F1B2 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_EMBED_SWIFT_STANDARD_LIBRARIES = YES;
+				CODE_SIGN_STYLE = Automatic;
+				INFOPLIST_FILE = SampleAppUITests/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+					"@loader_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.SampleAppUITests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 4.2;
+				TARGETED_DEVICE_FAMILY = "1,2";
+				TEST_TARGET_NAME = SampleApp;
+			};
+			name = Debug;
+		};
+		8D3E2A372176C1D300A4F1B2 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_EMBED_SWIFT_STANDARD_LIBRARIES = YES;
+				CODE_SIGN_STYLE = Automatic;
+				INFOPLIST_FILE = SampleAppUITests/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+					"@loader_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.SampleAppUITests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 4.2;
+				TARGETED_DEVICE_FAMILY = "1,2";
+				TEST_TARGET_NAME = SampleApp;
+			};
+			name = Release;
+		};
+		8D3E2A3A2176C1D300A4F1B2 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				CODE_SIGN_STYLE = Automatic;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		8D3E2A3B2176C1D300A4F1B2 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				CODE_SIGN_STYLE = Automatic;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */
+
+/* Begin XCConfigurationList section */
+		8D3E2A2C2176C1D300A4F1B2 /* Build configuration list for PBXProject "SampleApp" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				8D3E2A2D2176C1D300A4F1B2 /* Debug */,
+				8D3E2A2E2176C1D300A4F1B2 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		8D3E2A2F2176C1D300A4F1B2 /* Build configuration list for PBXNativeTarget "SampleApp" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				8D3E2A302176C1D300A4F1B2 /* Debug */,
+				8D3E2A312176C1D300A4F1B2 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		8D3E2A322176C1D300A4F1B2 /* Build configuration list for PBXNativeTarget "SampleAppTests" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				8D3E2A332176C1D300A4F1B2 /* Debug */,
+				8D3E2A342176C1D300A4F1B2 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		8D3E2A352176C1D300A4F1B2 /* Build configuration list for PBXNativeTarget "SampleAppUITests" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				8D3E2A362176C1D300A4F1B2 /* Debug */,
+				8D3E2A372176C1D300A4F1B2 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		8D3E2A392176C1D300A4F1B2 /* Build configuration list for PBXAggregateTarget "Lint" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				8D3E2A3A2176C1D300A4F1B2 /* Debug */,
+				8D3E2A3B2176C1D300A4F1B2 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */
+	};
+	rootObject = 8D3E2A012176C1D300A4F1B2 /* Project object */;
+}
+`
+
+	legacyPbxprojContent = `// !$*UTF8*$!
+{
+	archiveVersion = 1;
+	classes = {
+	};
+	objectVersion = 46;
+	objects = {
+
+/* Begin PBXBuildFile section */
+		4A1F3C2E1D8E5B7200C1A2B3 /* main.m in Sources */ = {isa = PBXBuildFile; fileRef = 4A1F3C2D1D8E5B7200C1A2B3 /* main.m */; };
+		4A1F3C311D8E5B7200C1A2B3 /* AppDelegate.m in Sources */ = {isa = PBXBuildFile; fileRef = 4A1F3C301D8E5B7200C1A2B3 /* AppDelegate.m */; };
+		4A1F3C341D8E5B7200C1A2B3 /* ViewController.m in Sources */ = {isa = PBXBuildFile; fileRef = 4A1F3C331D8E5B7200C1A2B3 /* ViewController.m */; };
+		4A1F3C371D8E5B7200C1A2B3 /* Main.storyboard in Resources */ = {isa = PBXBuildFile; fileRef = 4A1F3C351D8E5B7200C1A2B3 /* Main.storyboard */; };
+		4A1F3C391D8E5B7200C1A2B3 /* Assets.xcassets in Resources */ = {isa = PBXBuildFile; fileRef = 4A1F3C381D8E5B7200C1A2B3 /* Assets.xcassets */; };
+		4A1F3C3C1D8E5B7200C1A2B3 /* LaunchScreen.storyboard in Resources */ = {isa = PBXBuildFile; fileRef = 4A1F3C3A1D8E5B7200C1A2B3 /* LaunchScreen.storyboard */; };
+		4A1F3C3F1D8E5B7200C1A2B3 /* Legacy.xcdatamodeld in Sources */ = {isa = PBXBuildFile; fileRef = 4A1F3C3D1D8E5B7200C1A2B3 /* Legacy.xcdatamodeld */; };
+		4A1F3C4A1D8E5B7200C1A2B3 /* LegacyTests.m in Sources */ = {isa = PBXBuildFile; fileRef = 4A1F3C491D8E5B7200C1A2B3 /* LegacyTests.m */; };
+		4A1F3C5C1D8E5C9100C1A2B3 /* Kit.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = 4A1F3C5A1D8E5C9100C1A2B3 /* Kit.framework */; };
+		4A1F3C5D1D8E5C9100C1A2B3 /* Kit.framework in Embed Frameworks */ = {isa = PBXBuildFile; fileRef = 4A1F3C5A1D8E5C9100C1A2B3 /* Kit.framework */; settings = {ATTRIBUTES = (CodeSignOnCopy, RemoveHeadersOnCopy, ); }; };
+/* End PBXBuildFile section */
+
+/* Begin PBXContainerItemProxy section */
+		4A1F3C451D8E5B7200C1A2B3 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = 4A1F3C211D8E5B7200C1A2B3 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = 4A1F3C281D8E5B7200C1A2B3;
+			remoteInfo = Legacy;
+		};
+		4A1F3C591D8E5C9100C1A2B3 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = 4A1F3C541D8E5C9100C1A2B3 /* Kit.xcodeproj */;
+			proxyType = 2;
+			remoteGlobalIDString = 7C3B0E911D8E4F1E0098A6D1;
+			remoteInfo = Kit;
+		};
+		4A1F3C5E1D8E5C9100C1A2B3 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = 4A1F3C541D8E5C9100C1A2B3 /* Kit.xcodeproj */;
+			proxyType = 1;
+			remoteGlobalIDString = 7C3B0E901D8E4F1E0098A6D1;
+			remoteInfo = Kit;
+		};
+/* End PBXContainerItemProxy section */
+
+/* Begin PBXCopyFilesBuildPhase section */
+		4A1F3C601D8E5C9100C1A2B3 /* Embed Frameworks */ = {
+			isa = PBXCopyFilesBuildPhase;
+			buildActionMask = 2147483647;
+			dstPath = "";
+			dstSubfolderSpec = 10;
+			files = (
+				4A1F3C5D1D8E5C9100C1A2B3 /* Kit.framework in Embed Frameworks */,
+			);
+			name = "Embed Frameworks";
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXCopyFilesBuildPhase section */
+
+/* Begin PBXFileReference section */
+		4A1F3C291D8E5B7200C1A2B3 /* Legacy.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = Legacy.app; sourceTree = BUILT_PRODUCTS_DIR; };
+		4A1F3C2D1D8E5B7200C1A2B3 /* main.m */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.objc; path = main.m; sourceTree = "<group>"; };
+		4A1F3C2F1D8E5B7200C1A2B3 /* AppDelegate.h */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.h; path = AppDelegate.h; sourceTree = "<group>"; };
+		4A1F3C301D8E5B7200C1A2B3 /* AppDelegate.m */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.objc; path = AppDelegate.m; sourceTree = "<group>"; };
+		4A1F3C321D8E5B7200C1A2B3 /* ViewController.h */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.h; path = ViewController.h; sourceTree = "<group>"; };
+		4A1F3C331D8E5B7200C1A2B3 /* ViewController.m */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.objc; path = ViewController.m; sourceTree = "<group>"; };
+		4A1F3C361D8E5B7200C1A2B3 /* Base */ = {isa = PBXFileReference; lastKnownFileType = file.storyboard; name = Base; path = Base.lproj/Main.storyboard; sourceTree = "<group>"; };
+		4A1F3C381D8E5B7200C1A2B3 /* Assets.xcassets */ = {isa = PBXFileReference; lastKnownFileType = folder.assetcatalog; path = Assets.xcassets; sourceTree = "<group>"; };
+		4A1F3C3B1D8E5B7200C1A2B3 /* Base */ = {isa = PBXFileReference; lastKnownFileType = file.storyboard; name = Base; path = Base.lproj/LaunchScreen.storyboard; sourceTree = "<group>"; };
+		4A1F3C3E1D8E5B7200C1A2B3 /* Legacy.xcdatamodel */ = {isa = PBXFileReference; lastKnownFileType = wrapper.xcdatamodel; path = Legacy.xcdatamodel; sourceTree = "<group>"; };
+		4A1F3C401D8E5B7200C1A2B3 /* Info.plist */ = {isa = PBXFileReference; lastKnownFileType = text.plist.xml; path = Info.plist; sourceTree = "<group>"; };
+		4A1F3C441D8E5B7200C1A2B3 /* LegacyTests.xctest */ = {isa = PBXFileReference; explicitFileType = wrapper.cfbundle; includeInIndex = 0; path = LegacyTests.xctest; sourceTree = BUILT_PRODUCTS_DIR; };
+		4A1F3C491D8E5B7200C1A2B3 /* LegacyTests.m */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.objc; path = LegacyTests.m; sourceTree = "<group>"; };
+		4A1F3C4B1D8E5B7200C1A2B3 /* Info.plist */ = {isa = PBXFileReference; lastKnownFileType = text.plist.xml; path = Info.plist; sourceTree = "<group>"; };
+		4A1F3C541D8E5C9100C1A2B3 /* Kit.xcodeproj */ = {isa = PBXFileReference; lastKnownFileType = "wrapper.pb-project"; name = Kit.xcodeproj; path = ../Kit/Kit.xcodeproj; sourceTree = "<group>"; };
+/* End PBXFileReference section */
+
+/* Begin PBXFrameworksBuildPhase section */
+		4A1F3C261D8E5B7200C1A2B3 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				4A1F3C5C1D8E5C9100C1A2B3 /* Kit.framework in Frameworks */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		4A1F3C411D8E5B7200C1A2B3 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXFrameworksBuildPhase section */
+
+/* Begin PBXGroup section */
+		4A1F3C201D8E5B7200C1A2B3 = {
+			isa = PBXGroup;
+			children = (
+				4A1F3C2B1D8E5B7200C1A2B3 /* Legacy */,
+				4A1F3C481D8E5B7200C1A2B3 /* LegacyTests */,
+				4A1F3C541D8E5C9100C1A2B3 /* Kit.xcodeproj */,
+				4A1F3C2A1D8E5B7200C1A2B3 /* Products */,
+			);
+			sourceTree = "<group>";
+		};
+		4A1F3C2A1D8E5B7200C1A2B3 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				4A1F3C291D8E5B7200C1A2B3 /* Legacy.app */,
+				4A1F3C441D8E5B7200C1A2B3 /* LegacyTests.xctest */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+		4A1F3C2B1D8E5B7200C1A2B3 /* Legacy */ = {
+			isa = PBXGroup;
+			children = (
+				4A1F3C2F1D8E5B7200C1A2B3 /* AppDelegate.h */,
+				4A1F3C301D8E5B7200C1A2B3 /* AppDelegate.m */,
+				4A1F3C321D8E5B7200C1A2B3 /* ViewController.h */,
+				4A1F3C331D8E5B7200C1A2B3 /* ViewController.m */,
+				4A1F3C351D8E5B7200C1A2B3 /* Main.storyboard */,
+				4A1F3C381D8E5B7200C1A2B3 /* Assets.xcassets */,
+				4A1F3C3A1D8E5B7200C1A2B3 /* LaunchScreen.storyboard */,
+				4A1F3C401D8E5B7200C1A2B3 /* Info.plist */,
+				4A1F3C3D1D8E5B7200C1A2B3 /* Legacy.xcdatamodeld */,
+				4A1F3C2C1D8E5B7200C1A2B3 /* Supporting Files */,
+			);
+			path = Legacy;
+			sourceTree = "<group>";
+		};
+		4A1F3C2C1D8E5B7200C1A2B3 /* Supporting Files */ = {
+			isa = PBXGroup;
+			children = (
+				4A1F3C2D1D8E5B7200C1A2B3 /* main.m */,
+			);
+			name = "Supporting Files";
+			sourceTree = "<group>";
+		};
+		4A1F3C481D8E5B7200C1A2B3 /* LegacyTests */ = {
+			isa = PBXGroup;
+			children = (
+				4A1F3C491D8E5B7200C1A2B3 /* LegacyTests.m */,
+				4A1F3C4B1D8E5B7200C1A2B3 /* Info.plist */,
+			);
+			path = LegacyTests;
+			sourceTree = "<group>";
+		};
+		4A1F3C551D8E5C9100C1A2B3 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				4A1F3C5A1D8E5C9100C1A2B3 /* Kit.framework */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+/* End PBXGroup section */
+
+/* Begin PBXNativeTarget section */
+		4A1F3C281D8E5B7200C1A2B3 /* Legacy */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = 4A1F3C4E1D8E5B7200C1A2B3 /* Build configuration list for PBXNativeTarget "Legacy" */;
+			buildPhases = (
+				4A1F3C251D8E5B7200C1A2B3 /* Sources */,
+				4A1F3C261D8E5B7200C1A2B3 /* Frameworks */,
+				4A1F3C271D8E5B7200C1A2B3 /* Resources */,
+				4A1F3C601D8E5C9100C1A2B3 /* Embed Frameworks */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+				4A1F3C5F1D8E5C9100C1A2B3 /* PBXTargetDependency */,
+			);
+			name = Legacy;
+			productName = Legacy;
+			productReference = 4A1F3C291D8E5B7200C1A2B3 /* Legacy.app */;
+			productType = "com.apple.product-type.application";
+		};
+		4A1F3C431D8E5B7200C1A2B3 /* LegacyTests */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = 4A1F3C511D8E5B7200C1A2B3 /* Build configuration list for PBXNativeTarget "LegacyTests" */;
+			buildPhases = (
+				4A1F3C421D8E5B7200C1A2B3 /* Sources */,
+				4A1F3C411D8E5B7200C1A2B3 /* Frameworks */,
+				4A1F3C471D8E5B7200C1A2B3 /* Resources */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+				4A1F3C461D8E5B7200C1A2B3 /* PBXTargetDependency */,
+			);
+			name = LegacyTests;
+			productName = LegacyTests;
+			productReference = 4A1F3C441D8E5B7200C1A2B3 /* LegacyTests.xctest */;
+			productType = "com.apple.product-type.bundle.unit-test";
+		};
+/* End PBXNativeTarget section */
+
+/* Begin PBXProject section */
+		4A1F3C211D8E5B7200C1A2B3 /* Project object */ = {
+			isa = PBXProject;
+			attributes = {
+				LastUpgradeCheck = 0800;
+				ORGANIZATIONNAME = "Bitrise Ltd.";
+				TargetAttributes = {
+					4A1F3C281D8E5B7200C1A2B3 = {
+						CreatedOnToolsVersion = 8.0;
+						DevelopmentTeam = 72SA8V3WYL;
+						ProvisioningStyle = Automatic;
+					};
+					4A1F3C431D8E5B7200C1A2B3 = {
+						CreatedOnToolsVersion = 8.0;
+						DevelopmentTeam = 72SA8V3WYL;
+						ProvisioningStyle = Automatic;
+						TestTargetID = 4A1F3C281D8E5B7200C1A2B3;
+					};
+				};
+			};
+			buildConfigurationList = 4A1F3C241D8E5B7200C1A2B3 /* Build configuration list for PBXProject "Legacy" */;
+			compatibilityVersion = "Xcode 3.2";
+			developmentRegion = English;
+			hasScannedForEncodings = 0;
+			knownRegions = (
+				en,
+				Base,
+			);
+			mainGroup = 4A1F3C201D8E5B7200C1A2B3;
+			productRefGroup = 4A1F3C2A1D8E5B7200C1A2B3 /* Products */;
+			projectDirPath = "";
+			projectReferences = (
+				{
+					ProductGroup = 4A1F3C551D8E5C9100C1A2B3 /* Products */;
+					ProjectRef = 4A1F3C541D8E5C9100C1A2B3 /* Kit.xcodeproj */;
+				},
+			);
+			projectRoot = "";
+			targets = (
+				4A1F3C281D8E5B7200C1A2B3 /* Legacy */,
+				4A1F3C431D8E5B7200C1A2B3 /* LegacyTests */,
+			);
+		};
+/* End PBXProject section */
+
+/* Begin PBXReferenceProxy section */
+		4A1F3C5A1D8E5C9100C1A2B3 /* Kit.framework */ = {
+			isa = PBXReferenceProxy;
+			fileType = wrapper.framework;
+			path = Kit.framework;
+			remoteRef = 4A1F3C591D8E5C9100C1A2B3 /* PBXContainerItemProxy */;
+			sourceTree = BUILT_PRODUCTS_DIR;
+		};
+/* End PBXReferenceProxy section */
+
+/* Begin PBXResourcesBuildPhase section */
+		4A1F3C271D8E5B7200C1A2B3 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				4A1F3C3C1D8E5B7200C1A2B3 /* LaunchScreen.storyboard in Resources */,
+				4A1F3C391D8E5B7200C1A2B3 /* Assets.xcassets in Resources */,
+				4A1F3C371D8E5B7200C1A2B3 /* Main.storyboard in Resources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		4A1F3C471D8E5B7200C1A2B3 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXResourcesBuildPhase section */
+
+/* Begin PBXSourcesBuildPhase section */
+		4A1F3C251D8E5B7200C1A2B3 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				4A1F3C341D8E5B7200C1A2B3 /* ViewController.m in Sources */,
+				4A1F3C3F1D8E5B7200C1A2B3 /* Legacy.xcdatamodeld in Sources */,
+				4A1F3C311D8E5B7200C1A2B3 /* AppDelegate.m in Sources */,
+				4A1F3C2E1D8E5B7200C1A2B3 /* main.m in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		4A1F3C421D8E5B7200C1A2B3 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				4A1F3C4A1D8E5B7200C1A2B3 /* LegacyTests.m in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXSourcesBuildPhase section */
+
+/* Begin PBXTargetDependency section */
+		4A1F3C461D8E5B7200C1A2B3 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = 4A1F3C281D8E5B7200C1A2B3 /* Legacy */;
+			targetProxy = 4A1F3C451D8E5B7200C1A2B3 /* PBXContainerItemProxy */;
+		};
+		4A1F3C5F1D8E5C9100C1A2B3 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			name = Kit;
+			targetProxy = 4A1F3C5E1D8E5C9100C1A2B3 /* PBXContainerItemProxy */;
+		};
+/* End PBXTargetDependency section */
+
+/* Begin PBXVariantGroup section */
+		4A1F3C351D8E5B7200C1A2B3 /* Main.storyboard */ = {
+			isa = PBXVariantGroup;
+			children = (
+				4A1F3C361D8E5B7200C1A2B3 /* Base */,
+			);
+			name = Main.storyboard;
+			sourceTree = "<group>";
+		};
+		4A1F3C3A1D8E5B7200C1A2B3 /* LaunchScreen.storyboard */ = {
+			isa = PBXVariantGroup;
+			children = (
+				4A1F3C3B1D8E5B7200C1A2B3 /* Base */,
+			);
+			name = LaunchScreen.storyboard;
+			sourceTree = "<group>";
+		};
+/* End PBXVariantGroup section */
+
+/* Begin XCBuildConfiguration section */
+		4A1F3C4C1D8E5B7200C1A2B3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_SEARCH_USER_PATHS = NO;
+				CLANG_ANALYZER_NONNULL = YES;
+				CLANG_CXX_LANGUAGE_STANDARD = "gnu++0x";
+				CLANG_CXX_LIBRARY = "libc++";
+				CLANG_ENABLE_MODULES = YES;
+				CLANG_ENABLE_OBJC_ARC = YES;
+				CLANG_WARN_BOOL_CONVERSION = YES;
+				CLANG_WARN_CONSTANT_CONVERSION = YES;
+				CLANG_WARN_DIRECT_OBJC_ISA_USAGE = YES_ERROR;
+				CLANG_WARN_DOCUMENTATION_COMMENTS = YES;
+				CLANG_WARN_EMPTY_BODY = YES;
+				CLANG_WARN_ENUM_CONVERSION = YES;
+				CLANG_WARN_INFINITE_RECURSION = YES;
+				CLANG_WARN_INT_CONVERSION = YES;
+				CLANG_WARN_OBJC_ROOT_CLASS = YES_ERROR;
+				CLANG_WARN_SUSPICIOUS_MOVE = YES;
+				CLANG_WARN_UNREACHABLE_CODE = YES;
+				CLANG_WARN__DUPLICATE_METHOD_MATCH = YES;
+				"CODE_SIGN_IDENTITY[sdk=iphoneos*]" = "iPhone Developer";
+				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = dwarf;
+				ENABLE_STRICT_OBJC_MSGSEND = YES;
+				ENABLE_TESTABILITY = YES;
+				GCC_C_LANGUAGE_STANDARD = gnu99;
+				GCC_DYNAMIC_NO_PIC = NO;
+				GCC_NO_COMMON_BLOCKS = YES;
+				GCC_OPTIMIZATION_LEVEL = 0;
+				GCC_PREPROCESSOR_DEFINITIONS = (
+					"DEBUG=1",
+					"$(inherited)",
+				);
+				GCC_WARN_64_TO_32_BIT_CONVERSION = YES;
+				GCC_WARN_ABOUT_RETURN_TYPE = YES_ERROR;
+				GCC_WARN_UNDECLARED_SELECTOR = YES;
+				GCC_WARN_UNINITIALIZED_AUTOS = YES_AGGRESSIVE;
+				GCC_WARN_UNUSED_FUNCTION = YES;
+				GCC_WARN_UNUSED_VARIABLE = YES;
+				IPHONEOS_DEPLOYMENT_TARGET = 10.0;
+				MTL_ENABLE_DEBUG_INFO = YES;
+				ONLY_ACTIVE_ARCH = YES;
+				SDKROOT = iphoneos;
+			};
+			name = Debug;
+		};
+		4A1F3C4D1D8E5B7200C1A2B3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_SEARCH_USER_PATHS = NO;
+				CLANG_ANALYZER_NONNULL = YES;
+				CLANG_CXX_LANGUAGE_STANDARD = "gnu++0x";
+				CLANG_CXX_LIBRARY = "libc++";
+				CLANG_ENABLE_MODULES = YES;
+				CLANG_ENABLE_OBJC_ARC = YES;
+				CLANG_WARN_BOOL_CONVERSION = YES;
+				CLANG_WARN_CONSTANT_CONVERSION = YES;
+				CLANG_WARN_DIRECT_OBJC_ISA_USAGE = YES_ERROR;
+				CLANG_WARN_DOCUMENTATION_COMMENTS = YES;
+				CLANG_WARN_EMPTY_BODY = YES;
+				CLANG_WARN_ENUM_CONVERSION = YES;
+				CLANG_WARN_INFINITE_RECURSION = YES;
+				CLANG_WARN_INT_CONVERSION = YES;
+				CLANG_WARN_OBJC_ROOT_CLASS = YES_ERROR;
+				CLANG_WARN_SUSPICIOUS_MOVE = YES;
+				CLANG_WARN_UNREACHABLE_CODE = YES;
+				CLANG_WARN__DUPLICATE_METHOD_MATCH = YES;
+				"CODE_SIGN_IDENTITY[sdk=iphoneos*]" = "iPhone Developer";
+				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = "dwarf-with-dsym";
+				ENABLE_NS_ASSERTIONS = NO;
+				ENABLE_STRICT_OBJC_MSGSEND = YES;
+				GCC_C_LANGUAGE_STANDARD = gnu99;
+				GCC_NO_COMMON_BLOCKS = YES;
+				GCC_WARN_64_TO_32_BIT_CONVERSION = YES;
+				GCC_WARN_ABOUT_RETURN_TYPE = YES_ERROR;
+				GCC_WARN_UNDECLARED_SELECTOR = YES;
+				GCC_WARN_UNINITIALIZED_AUTOS = YES_AGGRESSIVE;
+				GCC_WARN_UNUSED_FUNCTION = YES;
+				GCC_WARN_UNUSED_VARIABLE = YES;
+				IPHONEOS_DEPLOYMENT_TARGET = 10.0;
+				MTL_ENABLE_DEBUG_INFO = NO;
+				SDKROOT = iphoneos;
+				VALIDATE_PRODUCT = YES;
+			};
+			name = Release;
+		};
+		4A1F3C4F1D8E5B7200C1A2B3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_APPICON_NAME = AppIcon;
+				INFOPLIST_FILE = Legacy/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = "$(inherited) @executable_path/Frameworks";
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Legacy;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		4A1F3C501D8E5B7200C1A2B3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_APPICON_NAME = AppIcon;
+				INFOPLIST_FILE = Legacy/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = "$(inherited) @executable_path/Frameworks";
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Legacy;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Release;
+		};
+		4A1F3C521D8E5B7200C1A2B3 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				BUNDLE_LOADER = "$(TEST_HOST)";
+				INFOPLIST_FILE = LegacyTests/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = "$(inherited) @executable_path/Frameworks @loader_path/Frameworks";
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.LegacyTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/Legacy.app/Legacy";
+			};
+			name = Debug;
+		};
+		4A1F3C531D8E5B7200C1A2B3 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				BUNDLE_LOADER = "$(TEST_HOST)";
+				INFOPLIST_FILE = LegacyTests/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = "$(inherited) @executable_path/Frameworks @loader_path/Frameworks";
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.LegacyTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/Legacy.app/Legacy";
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */
+
+/* Begin XCConfigurationList section */
+		4A1F3C241D8E5B7200C1A2B3 /* Build configuration list for PBXProject "Legacy" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				4A1F3C4C1D8E5B7200C1A2B3 /* Debug */,
+				4A1F3C4D1D8E5B7200C1A2B3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		4A1F3C4E1D8E5B7200C1A2B3 /* Build configuration list for PBXNativeTarget "Legacy" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				4A1F3C4F1D8E5B7200C1A2B3 /* Debug */,
+				4A1F3C501D8E5B7200C1A2B3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		4A1F3C511D8E5B7200C1A2B3 /* Build configuration list for PBXNativeTarget "LegacyTests" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				4A1F3C521D8E5B7200C1A2B3 /* Debug */,
+				4A1F3C531D8E5B7200C1A2B3 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */
+
+/* Begin XCVersionGroup section */
+		4A1F3C3D1D8E5B7200C1A2B3 /* Legacy.xcdatamodeld */ = {
+			isa = XCVersionGroup;
+			children = (
+				4A1F3C3E1D8E5B7200C1A2B3 /* Legacy.xcdatamodel */,
+			);
+			currentVersion = 4A1F3C3E1D8E5B7200C1A2B3 /* Legacy.xcdatamodel */;
+			path = Legacy.xcdatamodeld;
+			sourceTree = "<group>";
+			versionGroupType = wrapper.xcdatamodel;
+		};
+/* End XCVersionGroup section */
+	};
+	rootObject = 4A1F3C211D8E5B7200C1A2B3 /* Project object */;
+}
+`
+
+	weatherPbxprojContent = `// !$*UTF8*$!
+{
+	archiveVersion = 1;
+	classes = {
+	};
+	objectVersion = 52;
+	objects = {
+
+/* Begin PBXBuildFile section */
+		8E2D41A5237C0E8A00F1B4C6 /* AppDelegate.swift in Sources */ = {isa = PBXBuildFile; fileRef = 8E2D41A4237C0E8A00F1B4C6 /* AppDelegate.swift */; };
+		8E2D41A7237C0E8A00F1B4C6 /* SceneDelegate.swift in Sources */ = {isa = PBXBuildFile; fileRef = 8E2D41A6237C0E8A00F1B4C6 /* SceneDelegate.swift */; };
+		8E2D41A9237C0E8A00F1B4C6 /* ContentView.swift in Sources */ = {isa = PBXBuildFile; fileRef = 8E2D41A8237C0E8A00F1B4C6 /* ContentView.swift */; };
+		8E2D41AB237C0E8B00F1B4C6 /* Assets.xcassets in Resources */ = {isa = PBXBuildFile; fileRef = 8E2D41AA237C0E8B00F1B4C6 /* Assets.xcassets */; };
+		8E2D41AE237C0E8B00F1B4C6 /* Preview Assets.xcassets in Resources */ = {isa = PBXBuildFile; fileRef = 8E2D41AD237C0E8B00F1B4C6 /* Preview Assets.xcassets */; };
+		8E2D41B1237C0E8B00F1B4C6 /* LaunchScreen.storyboard in Resources */ = {isa = PBXBuildFile; fileRef = 8E2D41AF237C0E8B00F1B4C6 /* LaunchScreen.storyboard */; };
+		8E2D41BC237C0E8B00F1B4C6 /* WeatherTests.swift in Sources */ = {isa = PBXBuildFile; fileRef = 8E2D41BB237C0E8B00F1B4C6 /* WeatherTests.swift */; };
+		8E2D41C9237C10F300F1B4C6 /* Alamofire in Frameworks */ = {isa = PBXBuildFile; productRef = 8E2D41C8237C10F300F1B4C6 /* Alamofire */; };
+/* End PBXBuildFile section */
+
+/* Begin PBXContainerItemProxy section */
+		8E2D41B8237C0E8B00F1B4C6 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = 8E2D4199237C0E8A00F1B4C6 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = 8E2D41A0237C0E8A00F1B4C6;
+			remoteInfo = Weather;
+		};
+/* End PBXContainerItemProxy section */
+
+/* Begin PBXFileReference section */
+		8E2D41A1237C0E8A00F1B4C6 /* Weather.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = Weather.app; sourceTree = BUILT_PRODUCTS_DIR; };
+		8E2D41A4237C0E8A00F1B4C6 /* AppDelegate.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = AppDelegate.swift; sourceTree = "<group>"; };
+		8E2D41A6237C0E8A00F1B4C6 /* SceneDelegate.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = SceneDelegate.swift; sourceTree = "<group>"; };
+		8E2D41A8237C0E8A00F1B4C6 /* ContentView.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = ContentView.swift; sourceTree = "<group>"; };
+		8E2D41AA237C0E8B00F1B4C6 /* Assets.xcassets */ = {isa = PBXFileReference; lastKnownFileType = folder.assetcatalog; path = Assets.xcassets; sourceTree = "<group>"; };
+		8E2D41AD237C0E8B00F1B4C6 /* Preview Assets.xcassets */ = {isa = PBXFileReference; lastKnownFileType = folder.assetcatalog; path = "Preview Assets.xcassets"; sourceTree = "<group>"; };
+		8E2D41B0237C0E8B00F1B4C6 /* Base */ = {isa = PBXFileReference; lastKnownFileType = file.storyboard; name = Base; path = Base.lproj/LaunchScreen.storyboard; sourceTree = "<group>"; };
+		8E2D41B2237C0E8B00F1B4C6 /* Info.plist */ = {isa = PBXFileReference; lastKnownFileType = text.plist.xml; path = Info.plist; sourceTree = "<group>"; };
+		8E2D41B7237C0E8B00F1B4C6 /* WeatherTests.xctest */ = {isa = PBXFileReference; explicitFileType = wrapper.cfbundle; includeInIndex = 0; path = WeatherTests.xctest; sourceTree = BUILT_PRODUCTS_DIR; };
+		8E2D41BB237C0E8B00F1B4C6 /* WeatherTests.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = WeatherTests.swift; sourceTree = "<group>"; };
+		8E2D41BD237C0E8B00F1B4C6 /* Info.plist */ = {isa = PBXFileReference; lastKnownFileType = text.plist.xml; path = Info.plist; sourceTree = "<group>"; };
+/* End PBXFileReference section */
+
+/* Begin PBXFrameworksBuildPhase section */
+		8E2D419E237C0E8A00F1B4C6 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				8E2D41C9237C10F300F1B4C6 /* Alamofire in Frameworks */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8E2D41B4237C0E8B00F1B4C6 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXFrameworksBuildPhase section */
+
+/* Begin PBXGroup section */
+		8E2D4198237C0E8A00F1B4C6 = {
+			isa = PBXGroup;
+			children = (
+				8E2D41A3237C0E8A00F1B4C6 /* Weather */,
+				8E2D41BA237C0E8B00F1B4C6 /* WeatherTests */,
+				8E2D41A2237C0E8A00F1B4C6 /* Products */,
+			);
+			sourceTree = "<group>";
+		};
+		8E2D41A2237C0E8A00F1B4C6 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				8E2D41A1237C0E8A00F1B4C6 /* Weather.app */,
+				8E2D41B7237C0E8B00F1B4C6 /* WeatherTests.xctest */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+		8E2D41A3237C0E8A00F1B4C6 /* Weather */ = {
+			isa = PBXGroup;
+			children = (
+				8E2D41A4237C0E8A00F1B4C6 /* AppDelegate.swift */,
+				8E2D41A6237C0E8A00F1B4C6 /* SceneDelegate.swift */,
+				8E2D41A8237C0E8A00F1B4C6 /* ContentView.swift */,
+				8E2D41AA237C0E8B00F1B4C6 /* Assets.xcassets */,
+				8E2D41AF237C0E8B00F1B4C6 /* LaunchScreen.storyboard */,
+				8E2D41B2237C0E8B00F1B4C6 /* Info.plist */,
+				8E2D41AC237C0E8B00F1B4C6 /* Preview Content */,
+			);
+			path = Weather;
+			sourceTree = "<group>";
+		};
+		8E2D41AC237C0E8B00F1B4C6 /* Preview Content */ = {
+			isa = PBXGroup;
+			children = (
+				8E2D41AD237C0E8B00F1B4C6 /* Preview Assets.xcassets */,
+			);
+			path = "Preview Content";
+			sourceTree = "<group>";
+		};
+		8E2D41BA237C0E8B00F1B4C6 /* WeatherTests */ = {
+			isa = PBXGroup;
+			children = (
+				8E2D41BB237C0E8B00F1B4C6 /* WeatherTests.swift */,
+				8E2D41BD237C0E8B00F1B4C6 /* Info.plist */,
+			);
+			path = WeatherTests;
+			sourceTree = "<group>";
+		};
+/* End PBXGroup section */
+
+/* Begin PBXNativeTarget section */
+		8E2D41A0237C0E8A00F1B4C6 /* Weather */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = 8E2D41C0237C0E8B00F1B4C6 /* Build configuration list for PBXNativeTarget "Weather" */;
+			buildPhases = (
+				8E2D419D237C0E8A00F1B4C6 /* Sources */,
+				8E2D419E237C0E8A00F1B4C6 /* Frameworks */,
+				8E2D419F237C0E8A00F1B4C6 /* Resources */,
+				8E2D41CA237C114500F1B4C6 /* SwiftLint */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = Weather;
+			packageProductDependencies = (
+				8E2D41C8237C10F300F1B4C6 /* Alamofire */,
+			);
+			productName = Weather;
+			productReference = 8E2D41A1237C0E8A00F1B4C6 /* Weather.app */;
+			productType = "com.apple.product-type.application";
+		};
+		8E2D41B6237C0E8B00F1B4C6 /* WeatherTests */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = 8E2D41C3237C0E8B00F1B4C6 /* Build configuration list for PBXNativeTarget "WeatherTests" */;
+			buildPhases = (
+				8E2D41B3237C0E8B00F1B4C6 /* Sources */,
+				8E2D41B4237C0E8B00F1B4C6 /* Frameworks */,
+				8E2D41B5237C0E8B00F1B4C6 /* Resources */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+				8E2D41B9237C0E8B00F1B4C6 /* PBXTargetDependency */,
+			);
+			name = WeatherTests;
+			productName = WeatherTests;
+			productReference = 8E2D41B7237C0E8B00F1B4C6 /* WeatherTests.xctest */;
+			productType = "com.apple.product-type.bundle.unit-test";
+		};
+/* End PBXNativeTarget section */
+
+/* Begin PBXProject section */
+		8E2D4199237C0E8A00F1B4C6 /* Project object */ = {
+			isa = PBXProject;
+			attributes = {
+				LastSwiftUpdateCheck = 1120;
+				LastUpgradeCheck = 1120;
+				ORGANIZATIONNAME = Bitrise;
+				TargetAttributes = {
+					8E2D41A0237C0E8A00F1B4C6 = {
+						CreatedOnToolsVersion = 11.2.1;
+					};
+					8E2D41B6237C0E8B00F1B4C6 = {
+						CreatedOnToolsVersion = 11.2.1;
+						TestTargetID = 8E2D41A0237C0E8A00F1B4C6;
+					};
+				};
+			};
+			buildConfigurationList = 8E2D419C237C0E8A00F1B4C6 /* Build configuration list for PBXProject "Weather" */;
+			compatibilityVersion = "Xcode 9.3";
+			developmentRegion = en;
+			hasScannedForEncodings = 0;
+			knownRegions = (
+				en,
+				Base,
+			);
+			mainGroup = 8E2D4198237C0E8A00F1B4C6;
+			packageReferences = (
+				8E2D41C7237C10F300F1B4C6 /* XCRemoteSwiftPackageReference "Alamofire" */,
+			);
+			productRefGroup = 8E2D41A2237C0E8A00F1B4C6 /* Products */;
+			projectDirPath = "";
+			projectRoot = "";
+			targets = (
+				8E2D41A0237C0E8A00F1B4C6 /* Weather */,
+				8E2D41B6237C0E8B00F1B4C6 /* WeatherTests */,
+			);
+		};
+/* End PBXProject section */
+
+/* Begin PBXResourcesBuildPhase section */
+		8E2D419F237C0E8A00F1B4C6 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				8E2D41B1237C0E8B00F1B4C6 /* LaunchScreen.storyboard in Resources */,
+				8E2D41AE237C0E8B00F1B4C6 /* Preview Assets.xcassets in Resources */,
+				8E2D41AB237C0E8B00F1B4C6 /* Assets.xcassets in Resources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8E2D41B5237C0E8B00F1B4C6 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXResourcesBuildPhase section */
+
+/* Begin PBXShellScriptBuildPhase section */
+		8E2D41CA237C114500F1B4C6 /* SwiftLint */ = {
+			isa = PBXShellScriptBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			inputFileListPaths = (
+			);
+			inputPaths = (
+			);
+			name = SwiftLint;
+			outputFileListPaths = (
+			);
+			outputPaths = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+			shellPath = /bin/sh;
+			shellScript = "if which swiftlint >/dev/null; then\n  swiftlint\nelse\n  echo \"warning: SwiftLint not installed, download from https://github.com/realm/SwiftLint\"\nfi\n";
+		};
+/* End PBXShellScriptBuildPhase section */
+
+/* Begin PBXSourcesBuildPhase section */
+		8E2D419D237C0E8A00F1B4C6 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				8E2D41A5237C0E8A00F1B4C6 /* AppDelegate.swift in Sources */,
+				8E2D41A7237C0E8A00F1B4C6 /* SceneDelegate.swift in Sources */,
+				8E2D41A9237C0E8A00F1B4C6 /* ContentView.swift in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		8E2D41B3237C0E8B00F1B4C6 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				8E2D41BC237C0E8B00F1B4C6 /* WeatherTests.swift in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXSourcesBuildPhase section */
+
+/* Begin PBXTargetDependency section */
+		8E2D41B9237C0E8B00F1B4C6 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = 8E2D41A0237C0E8A00F1B4C6 /* Weather */;
+			targetProxy = 8E2D41B8237C0E8B00F1B4C6 /* PBXContainerItemProxy */;
+		};
+/* End PBXTargetDependency section */
+
+/* Begin PBXVariantGroup section */
+		8E2D41AF237C0E8B00F1B4C6 /* LaunchScreen.storyboard */ = {
+			isa = PBXVariantGroup;
+			children = (
+				8E2D41B0237C0E8B00F1B4C6 /* Base */,
+			);
+			name = LaunchScreen.storyboard;
+			sourceTree = "<group>";
+		};
+/* End PBXVariantGroup section */
+
+/* Begin XCBuildConfiguration section */
+		8E2D41BE237C0E8B00F1B4C6 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_SEARCH_USER_PATHS = NO;
+				CLANG_ANALYZER_NONNULL = YES;
+				CLANG_ANALYZER_NUMBER_OBJECT_CONVERSION = YES_AGGRESSIVE;
+				CLANG_CXX_LANGUAGE_STANDARD = "gnu++14";
+				CLANG_CXX_LIBRARY = "libc++";
+				CLANG_ENABLE_MODULES = YES;
+				CLANG_ENABLE_OBJC_ARC = YES;
+				CLANG_ENABLE_OBJC_WEAK = YES;
+				CLANG_WARN_BLOCK_CAPTURE_AUTORELEASING = YES;
+				CLANG_WARN_BOOL_CONVERSION = YES;
+				CLANG_WARN_COMMA = YES;
+				CLANG_WARN_CONSTANT_CONVERSION = YES;
+				CLANG_WARN_DEPRECATED_OBJC_IMPLEMENTATIONS = YES;
+				CLANG_WARN_DIRECT_OBJC_ISA_USAGE = YES_ERROR;
+				CLANG_WARN_DOCUMENTATION_COMMENTS = YES;
+				CLANG_WARN_EMPTY_BODY = YES;
+				CLANG_WARN_ENUM_CONVERSION = YES;
+				CLANG_WARN_INFINITE_RECURSION = YES;
+				CLANG_WARN_INT_CONVERSION = YES;
+				CLANG_WARN_NON_LITERAL_NULL_CONVERSION = YES;
+				CLANG_WARN_OBJC_IMPLICIT_RETAIN_SELF = YES;
+				CLANG_WARN_OBJC_LITERAL_CONVERSION = YES;
+				CLANG_WARN_OBJC_ROOT_CLASS = YES_ERROR;
+				CLANG_WARN_RANGE_LOOP_ANALYSIS = YES;
+				CLANG_WARN_STRICT_PROTOTYPES = YES;
+				CLANG_WARN_SUSPICIOUS_MOVE = YES;
+				CLANG_WARN_UNGUARDED_AVAILABILITY = YES_AGGRESSIVE;
+				CLANG_WARN_UNREACHABLE_CODE = YES;
+				CLANG_WARN__DUPLICATE_METHOD_MATCH = YES;
+				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = dwarf;
+				ENABLE_STRICT_OBJC_MSGSEND = YES;
+				ENABLE_TESTABILITY = YES;
+				GCC_C_LANGUAGE_STANDARD = gnu11;
+				GCC_DYNAMIC_NO_PIC = NO;
+				GCC_NO_COMMON_BLOCKS = YES;
+				GCC_OPTIMIZATION_LEVEL = 0;
+				GCC_PREPROCESSOR_DEFINITIONS = (
+					"DEBUG=1",
+					"$(inherited)",
+				);
+				GCC_WARN_64_TO_32_BIT_CONVERSION = YES;
+				GCC_WARN_ABOUT_RETURN_TYPE = YES_ERROR;
+				GCC_WARN_UNDECLARED_SELECTOR = YES;
+				GCC_WARN_UNINITIALIZED_AUTOS = YES_AGGRESSIVE;
+				GCC_WARN_UNUSED_FUNCTION = YES;
+				GCC_WARN_UNUSED_VARIABLE = YES;
+				IPHONEOS_DEPLOYMENT_TARGET = 13.2;
+				MTL_ENABLE_DEBUG_INFO = INCLUDE_SOURCE;
+				MTL_FAST_MATH = YES;
+				ONLY_ACTIVE_ARCH = YES;
+				SDKROOT = iphoneos;
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = DEBUG;
+				SWIFT_OPTIMIZATION_LEVEL = "-Onone";
+			};
+			name = Debug;
+		};
+		8E2D41BF237C0E8B00F1B4C6 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_SEARCH_USER_PATHS = NO;
+				CLANG_ANALYZER_NONNULL = YES;
+				CLANG_ANALYZER_NUMBER_OBJECT_CONVERSION = YES_AGGRESSIVE;
+				CLANG_CXX_LANGUAGE_STANDARD = "gnu++14";
+				CLANG_CXX_LIBRARY = "libc++";
+				CLANG_ENABLE_MODULES = YES;
+				CLANG_ENABLE_OBJC_ARC = YES;
+				CLANG_ENABLE_OBJC_WEAK = YES;
+				CLANG_WARN_BLOCK_CAPTURE_AUTORELEASING = YES;
+				CLANG_WARN_BOOL_CONVERSION = YES;
+				CLANG_WARN_COMMA = YES;
+				CLANG_WARN_CONSTANT_CONVERSION = YES;
+				CLANG_WARN_DEPRECATED_OBJC_IMPLEMENTATIONS = YES;
+				CLANG_WARN_DIRECT_OBJC_ISA_USAGE = YES_ERROR;
+				CLANG_WARN_DOCUMENTATION_COMMENTS = YES;
+				CLANG_WARN_EMPTY_BODY = YES;
+				CLANG_WARN_ENUM_CONVERSION = YES;
+				CLANG_WARN_INFINITE_RECURSION = YES;
+				CLANG_WARN_INT_CONVERSION = YES;
+				CLANG_WARN_NON_LITERAL_NULL_CONVERSION = YES;
+				CLANG_WARN_OBJC_IMPLICIT_RETAIN_SELF = YES;
+				CLANG_WARN_OBJC_LITERAL_CONVERSION = YES;
+				CLANG_WARN_OBJC_ROOT_CLASS = YES_ERROR;
+				CLANG_WARN_RANGE_LOOP_ANALYSIS = YES;
+				CLANG_WARN_STRICT_PROTOTYPES = YES;
+				CLANG_WARN_SUSPICIOUS_MOVE = YES;
+				CLANG_WARN_UNGUARDED_AVAILABILITY = YES_AGGRESSIVE;
+				CLANG_WARN_UNREACHABLE_CODE = YES;
+				CLANG_WARN__DUPLICATE_METHOD_MATCH = YES;
+				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = "dwarf-with-dsym";
+				ENABLE_NS_ASSERTIONS = NO;
+				ENABLE_STRICT_OBJC_MSGSEND = YES;
+				GCC_C_LANGUAGE_STANDARD = gnu11;
+				GCC_NO_COMMON_BLOCKS = YES;
+				GCC_WARN_64_TO_32_BIT_CONVERSION = YES;
+				GCC_WARN_ABOUT_RETURN_TYPE = YES_ERROR;
+				GCC_WARN_UNDECLARED_SELECTOR = YES;
+				GCC_WARN_UNINITIALIZED_AUTOS = YES_AGGRESSIVE;
+				GCC_WARN_UNUSED_FUNCTION = YES;
+				GCC_WARN_UNUSED_VARIABLE = YES;
+				IPHONEOS_DEPLOYMENT_TARGET = 13.2;
+				MTL_ENABLE_DEBUG_INFO = NO;
+				MTL_FAST_MATH = YES;
+				SDKROOT = iphoneos;
+				SWIFT_COMPILATION_MODE = wholemodule;
+				SWIFT_OPTIMIZATION_LEVEL = "-O";
+				VALIDATE_PRODUCT = YES;
+			};
+			name = Release;
+		};
+		8E2D41C1237C0E8B00F1B4C6 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_APPICON_NAME = AppIcon;
+				CODE_SIGN_STYLE = Automatic;
+				DEVELOPMENT_ASSET_PATHS = "\"Weather/Preview Content\"";
+				ENABLE_PREVIEWS = YES;
+				INFOPLIST_FILE = Weather/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Weather;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;
+		};
+		8E2D41C2237C0E8B00F1B4C6 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_APPICON_NAME = AppIcon;
+				CODE_SIGN_STYLE = Automatic;
+				DEVELOPMENT_ASSET_PATHS = "\"Weather/Preview Content\"";
+				ENABLE_PREVIEWS = YES;
+				INFOPLIST_FILE = Weather/Info.plist;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Weather;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;
+		};
+		8E2D41C4237C0E8B00F1B4C6 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_EMBED_SWIFT_STANDARD_LIBRARIES = YES;
+				BUNDLE_LOADER = "$(TEST_HOST)";
+				CODE_SIGN_STYLE = Automatic;
+				INFOPLIST_FILE = WeatherTests/Info.plist;
+				IPHONEOS_DEPLOYMENT_TARGET = 13.2;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+					"@loader_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.WeatherTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/Weather.app/Weather";
+			};
+			name = Debug;
+		};
+		8E2D41C5237C0E8B00F1B4C6 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_EMBED_SWIFT_STANDARD_LIBRARIES = YES;
+				BUNDLE_LOADER = "$(TEST_HOST)";
+				CODE_SIGN_STYLE = Automatic;
+				INFOPLIST_FILE = WeatherTests/Info.plist;
+				IPHONEOS_DEPLOYMENT_TARGET = 13.2;
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+					"@loader_path/Frameworks",
+				);
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.WeatherTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+				TEST_HOST = "$(BUILT_PRODUCTS_DIR)/Weather.app/Weather";
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */
+
+/* Begin XCConfigurationList section */
+		8E2D419C237C0E8A00F1B4C6 /* Build configuration list for PBXProject "Weather" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				8E2D41BE237C0E8B00F1B4C6 /* Debug */,
+				8E2D41BF237C0E8B00F1B4C6 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		8E2D41C0237C0E8B00F1B4C6 /* Build configuration list for PBXNativeTarget "Weather" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				8E2D41C1237C0E8B00F1B4C6 /* Debug */,
+				8E2D41C2237C0E8B00F1B4C6 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		8E2D41C3237C0E8B00F1B4C6 /* Build configuration list for PBXNativeTarget "WeatherTests" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				8E2D41C4237C0E8B00F1B4C6 /* Debug */,
+				8E2D41C5237C0E8B00F1B4C6 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */
+
+/* Begin XCRemoteSwiftPackageReference section */
+		8E2D41C7237C10F300F1B4C6 /* XCRemoteSwiftPackageReference "Alamofire" */ = {
+			isa = XCRemoteSwiftPackageReference;
+			repositoryURL = "https://github.com/Alamofire/Alamofire.git";
+			requirement = {
+				kind = upToNextMajorVersion;
+				minimumVersion = 5.0.0;
+			};
+		};
+/* End XCRemoteSwiftPackageReference section */
+
+/* Begin XCSwiftPackageProductDependency section */
+		8E2D41C8237C10F300F1B4C6 /* Alamofire */ = {
+			isa = XCSwiftPackageProductDependency;
+			package = 8E2D41C7237C10F300F1B4C6 /* XCRemoteSwiftPackageReference "Alamofire" */;
+			productName = Alamofire;
+		};
+/* End XCSwiftPackageProductDependency section */
+	};
+	rootObject = 8E2D4199237C0E8A00F1B4C6 /* Project object */;
+}
+`
+
+	notesPbxprojContent = `// !$*UTF8*$!
+{
+	archiveVersion = 1;
+	classes = {
+	};
+	objectVersion = 56;
+	objects = {
+
+/* Begin PBXAggregateTarget section */
+		D3A0C1F929B1E6C4007E2F10 /* Lint */ = {
+			isa = PBXAggregateTarget;
+			buildConfigurationList = D3A0C1FA29B1E6C4007E2F10 /* Build configuration list for PBXAggregateTarget "Lint" */;
+			buildPhases = (
+				D3A0C1FD29B1E6D9007E2F10 /* SwiftLint */,
+			);
+			dependencies = (
+			);
+			name = Lint;
+			productName = Lint;
+		};
+/* End PBXAggregateTarget section */
+
+/* Begin PBXBuildFile section */
+		D3A0C1B229B1E3A1007E2F10 /* NotesApp.swift in Sources */ = {isa = PBXBuildFile; fileRef = D3A0C1B129B1E3A1007E2F10 /* NotesApp.swift */; };
+		D3A0C1B429B1E3A1007E2F10 /* ContentView.swift in Sources */ = {isa = PBXBuildFile; fileRef = D3A0C1B329B1E3A1007E2F10 /* ContentView.swift */; };
+		D3A0C1B629B1E3A2007E2F10 /* Assets.xcassets in Resources */ = {isa = PBXBuildFile; fileRef = D3A0C1B529B1E3A2007E2F10 /* Assets.xcassets */; };
+		D3A0C1C429B1E4B0007E2F10 /* WidgetKit.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = D3A0C1C329B1E4B0007E2F10 /* WidgetKit.framework */; };
+		D3A0C1C929B1E4B0007E2F10 /* NotesWidget.swift in Sources */ = {isa = PBXBuildFile; fileRef = D3A0C1C829B1E4B0007E2F10 /* NotesWidget.swift */; };
+		D3A0C1CE29B1E4B1007E2F10 /* NotesWidgetExtension.appex in Embed Foundation Extensions */ = {isa = PBXBuildFile; fileRef = D3A0C1C229B1E4B0007E2F10 /* NotesWidgetExtension.appex */; settings = {ATTRIBUTES = (RemoveHeadersOnCopy, ); }; };
+		D3A0C1E229B1E5F0007E2F10 /* Collections in Frameworks */ = {isa = PBXBuildFile; productRef = D3A0C1E129B1E5F0007E2F10 /* Collections */; };
+		D3A0C1E529B1E60C007E2F10 /* Markdown in Frameworks */ = {isa = PBXBuildFile; productRef = D3A0C1E429B1E60C007E2F10 /* Markdown */; };
+		D3A0C1F029B1E67A007E2F10 /* Kit.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = D3A0C1EE29B1E66B007E2F10 /* Kit.framework */; };
+/* End PBXBuildFile section */
+
+/* Begin PBXContainerItemProxy section */
+		D3A0C1CC29B1E4B1007E2F10 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = D3A0C1A629B1E3A1007E2F10 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = D3A0C1C129B1E4B0007E2F10;
+			remoteInfo = NotesWidgetExtension;
+		};
+		D3A0C1ED29B1E66B007E2F10 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = D3A0C1E829B1E66B007E2F10 /* Kit.xcodeproj */;
+			proxyType = 2;
+			remoteGlobalIDString = 7C3B0E911D8E4F1E0098A6D1;
+			remoteInfo = Kit;
+		};
+/* End PBXContainerItemProxy section */
+
+/* Begin PBXCopyFilesBuildPhase section */
+		D3A0C1CF29B1E4B1007E2F10 /* Embed Foundation Extensions */ = {
+			isa = PBXCopyFilesBuildPhase;
+			buildActionMask = 2147483647;
+			dstPath = "";
+			dstSubfolderSpec = 13;
+			files = (
+				D3A0C1CE29B1E4B1007E2F10 /* NotesWidgetExtension.appex in Embed Foundation Extensions */,
+			);
+			name = "Embed Foundation Extensions";
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXCopyFilesBuildPhase section */
+
+/* Begin PBXFileReference section */
+		D3A0C1AE29B1E3A1007E2F10 /* Notes.app */ = {isa = PBXFileReference; explicitFileType = wrapper.application; includeInIndex = 0; path = Notes.app; sourceTree = BUILT_PRODUCTS_DIR; };
+		D3A0C1B129B1E3A1007E2F10 /* NotesApp.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = NotesApp.swift; sourceTree = "<group>"; };
+		D3A0C1B329B1E3A1007E2F10 /* ContentView.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = ContentView.swift; sourceTree = "<group>"; };
+		D3A0C1B529B1E3A2007E2F10 /* Assets.xcassets */ = {isa = PBXFileReference; lastKnownFileType = folder.assetcatalog; path = Assets.xcassets; sourceTree = "<group>"; };
+		D3A0C1C229B1E4B0007E2F10 /* NotesWidgetExtension.appex */ = {isa = PBXFileReference; explicitFileType = "wrapper.app-extension"; includeInIndex = 0; path = NotesWidgetExtension.appex; sourceTree = BUILT_PRODUCTS_DIR; };
+		D3A0C1C329B1E4B0007E2F10 /* WidgetKit.framework */ = {isa = PBXFileReference; lastKnownFileType = wrapper.framework; name = WidgetKit.framework; path = System/Library/Frameworks/WidgetKit.framework; sourceTree = SDKROOT; };
+		D3A0C1C829B1E4B0007E2F10 /* NotesWidget.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = NotesWidget.swift; sourceTree = "<group>"; };
+		D3A0C1D329B1E52E007E2F10 /* Notes.entitlements */ = {isa = PBXFileReference; lastKnownFileType = text.plist.entitlements; path = Notes.entitlements; sourceTree = "<group>"; };
+		D3A0C1E829B1E66B007E2F10 /* Kit.xcodeproj */ = {isa = PBXFileReference; lastKnownFileType = "wrapper.pb-project"; name = Kit.xcodeproj; path = ../Kit/Kit.xcodeproj; sourceTree = "<group>"; };
+/* End PBXFileReference section */
+
+/* Begin PBXFrameworksBuildPhase section */
+		D3A0C1AB29B1E3A1007E2F10 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				D3A0C1E229B1E5F0007E2F10 /* Collections in Frameworks */,
+				D3A0C1E529B1E60C007E2F10 /* Markdown in Frameworks */,
+				D3A0C1F029B1E67A007E2F10 /* Kit.framework in Frameworks */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		D3A0C1BF29B1E4B0007E2F10 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				D3A0C1C429B1E4B0007E2F10 /* WidgetKit.framework in Frameworks */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXFrameworksBuildPhase section */
+
+/* Begin PBXGroup section */
+		D3A0C1A529B1E3A1007E2F10 = {
+			isa = PBXGroup;
+			children = (
+				D3A0C1B029B1E3A1007E2F10 /* Notes */,
+				D3A0C1C529B1E4B0007E2F10 /* NotesWidget */,
+				D3A0C1E829B1E66B007E2F10 /* Kit.xcodeproj */,
+				D3A0C1C729B1E4B0007E2F10 /* Frameworks */,
+				D3A0C1AF29B1E3A1007E2F10 /* Products */,
+			);
+			sourceTree = "<group>";
+		};
+		D3A0C1AF29B1E3A1007E2F10 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				D3A0C1AE29B1E3A1007E2F10 /* Notes.app */,
+				D3A0C1C229B1E4B0007E2F10 /* NotesWidgetExtension.appex */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+		D3A0C1B029B1E3A1007E2F10 /* Notes */ = {
+			isa = PBXGroup;
+			children = (
+				D3A0C1D329B1E52E007E2F10 /* Notes.entitlements */,
+				D3A0C1B129B1E3A1007E2F10 /* NotesApp.swift */,
+				D3A0C1B329B1E3A1007E2F10 /* ContentView.swift */,
+				D3A0C1B529B1E3A2007E2F10 /* Assets.xcassets */,
+			);
+			path = Notes;
+			sourceTree = "<group>";
+		};
+		D3A0C1C529B1E4B0007E2F10 /* NotesWidget */ = {
+			isa = PBXGroup;
+			children = (
+				D3A0C1C829B1E4B0007E2F10 /* NotesWidget.swift */,
+			);
+			path = NotesWidget;
+			sourceTree = "<group>";
+		};
+		D3A0C1C729B1E4B0007E2F10 /* Frameworks */ = {
+			isa = PBXGroup;
+			children = (
+				D3A0C1C329B1E4B0007E2F10 /* WidgetKit.framework */,
+			);
+			name = Frameworks;
+			sourceTree = "<group>";
+		};
+		D3A0C1E929B1E66B007E2F10 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				D3A0C1EE29B1E66B007E2F10 /* Kit.framework */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+/* End PBXGroup section */
+
+/* Begin PBXNativeTarget section */
+		D3A0C1AD29B1E3A1007E2F10 /* Notes */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = D3A0C1BC29B1E3A2007E2F10 /* Build configuration list for PBXNativeTarget "Notes" */;
+			buildPhases = (
+				D3A0C1AA29B1E3A1007E2F10 /* Sources */,
+				D3A0C1AB29B1E3A1007E2F10 /* Frameworks */,
+				D3A0C1AC29B1E3A1007E2F10 /* Resources */,
+				D3A0C1CF29B1E4B1007E2F10 /* Embed Foundation Extensions */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+				D3A0C1CD29B1E4B1007E2F10 /* PBXTargetDependency */,
+			);
+			name = Notes;
+			packageProductDependencies = (
+				D3A0C1E129B1E5F0007E2F10 /* Collections */,
+				D3A0C1E429B1E60C007E2F10 /* Markdown */,
+			);
+			productName = Notes;
+			productReference = D3A0C1AE29B1E3A1007E2F10 /* Notes.app */;
+			productType = "com.apple.product-type.application";
+		};
+		D3A0C1C129B1E4B0007E2F10 /* NotesWidgetExtension */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = D3A0C1D029B1E4B1007E2F10 /* Build configuration list for PBXNativeTarget "NotesWidgetExtension" */;
+			buildPhases = (
+				D3A0C1BE29B1E4B0007E2F10 /* Sources */,
+				D3A0C1BF29B1E4B0007E2F10 /* Frameworks */,
+				D3A0C1C029B1E4B0007E2F10 /* Resources */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = NotesWidgetExtension;
+			productName = NotesWidgetExtension;
+			productReference = D3A0C1C229B1E4B0007E2F10 /* NotesWidgetExtension.appex */;
+			productType = "com.apple.product-type.app-extension";
+		};
+/* End PBXNativeTarget section */
+
+/* Begin PBXProject section */
+		D3A0C1A629B1E3A1007E2F10 /* Project object */ = {
+			isa = PBXProject;
+			attributes = {
+				BuildIndependentTargetsInParallel = 1;
+				LastSwiftUpdateCheck = 1420;
+				LastUpgradeCheck = 1420;
+				TargetAttributes = {
+					D3A0C1AD29B1E3A1007E2F10 = {
+						CreatedOnToolsVersion = 14.2;
+					};
+					D3A0C1C129B1E4B0007E2F10 = {
+						CreatedOnToolsVersion = 14.2;
+					};
+					D3A0C1F929B1E6C4007E2F10 = {
+						CreatedOnToolsVersion = 14.2;
+					};
+				};
+			};
+			buildConfigurationList = D3A0C1A929B1E3A1007E2F10 /* Build configuration list for PBXProject "Notes" */;
+			compatibilityVersion = "Xcode 14.0";
+			developmentRegion = en;
+			hasScannedForEncodings = 0;
+			knownRegions = (
+				en,
+				Base,
+			);
+			mainGroup = D3A0C1A529B1E3A1007E2F10;
+			packageReferences = (
+				D3A0C1E029B1E5F0007E2F10 /* XCRemoteSwiftPackageReference "swift-collections" */,
+				D3A0C1E329B1E60C007E2F10 /* XCRemoteSwiftPackageReference "swift-markdown" */,
+			);
+			productRefGroup = D3A0C1AF29B1E3A1007E2F10 /* Products */;
+			projectDirPath = "";
+			projectReferences = (
+				{
+					ProductGroup = D3A0C1E929B1E66B007E2F10 /* Products */;
+					ProjectRef = D3A0C1E829B1E66B007E2F10 /* Kit.xcodeproj */;
+				},
+			);
+			projectRoot = "";
+			targets = (
+				D3A0C1AD29B1E3A1007E2F10 /* Notes */,
+				D3A0C1C129B1E4B0007E2F10 /* NotesWidgetExtension */,
+				D3A0C1F929B1E6C4007E2F10 /* Lint */,
+			);
+		};
+/* End PBXProject section */
+
+/* Begin PBXReferenceProxy section */
+		D3A0C1EE29B1E66B007E2F10 /* Kit.framework */ = {
+			isa = PBXReferenceProxy;
+			fileType = wrapper.framework;
+			path = Kit.framework;
+			remoteRef = D3A0C1ED29B1E66B007E2F10 /* PBXContainerItemProxy */;
+			sourceTree = BUILT_PRODUCTS_DIR;
+		};
+/* End PBXReferenceProxy section */
+
+/* Begin PBXResourcesBuildPhase section */
+		D3A0C1AC29B1E3A1007E2F10 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				D3A0C1B629B1E3A2007E2F10 /* Assets.xcassets in Resources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		D3A0C1C029B1E4B0007E2F10 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXResourcesBuildPhase section */
+
+/* Begin PBXShellScriptBuildPhase section */
+		D3A0C1FD29B1E6D9007E2F10 /* SwiftLint */ = {
+			isa = PBXShellScriptBuildPhase;
+			alwaysOutOfDate = 1;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			inputFileListPaths = (
+			);
+			inputPaths = (
+			);
+			name = SwiftLint;
+			outputFileListPaths = (
+			);
+			outputPaths = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+			shellPath = /bin/sh;
+			shellScript = "export PATH=\"$PATH:/opt/homebrew/bin\"\nswiftlint lint --strict\n";
+		};
+/* End PBXShellScriptBuildPhase section */
+
+/* Begin PBXSourcesBuildPhase section */
+		D3A0C1AA29B1E3A1007E2F10 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				D3A0C1B429B1E3A1007E2F10 /* ContentView.swift in Sources */,
+				D3A0C1B229B1E3A1007E2F10 /* NotesApp.swift in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		D3A0C1BE29B1E4B0007E2F10 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				D3A0C1C929B1E4B0007E2F10 /* NotesWidget.swift in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXSourcesBuildPhase section */
+
+/* Begin PBXTargetDependency section */
+		D3A0C1CD29B1E4B1007E2F10 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = D3A0C1C129B1E4B0007E2F10 /* NotesWidgetExtension */;
+			targetProxy = D3A0C1CC29B1E4B1007E2F10 /* PBXContainerItemProxy */;
+		};
+/* End PBXTargetDependency section */
+
+/* Begin XCBuildConfiguration section */
+		D3A0C1BA29B1E3A2007E2F10 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_SEARCH_USER_PATHS = NO;
+				CLANG_ANALYZER_NONNULL = YES;
+				CLANG_CXX_LANGUAGE_STANDARD = "gnu++20";
+				CLANG_ENABLE_MODULES = YES;
+				CLANG_ENABLE_OBJC_ARC = YES;
+				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = dwarf;
+				ENABLE_STRICT_OBJC_MSGSEND = YES;
+				ENABLE_TESTABILITY = YES;
+				GCC_C_LANGUAGE_STANDARD = gnu11;
+				GCC_DYNAMIC_NO_PIC = NO;
+				GCC_NO_COMMON_BLOCKS = YES;
+				GCC_OPTIMIZATION_LEVEL = 0;
+				GCC_PREPROCESSOR_DEFINITIONS = (
+					"DEBUG=1",
+					"$(inherited)",
+				);
+				IPHONEOS_DEPLOYMENT_TARGET = 16.2;
+				MTL_ENABLE_DEBUG_INFO = INCLUDE_SOURCE;
+				MTL_FAST_MATH = YES;
+				ONLY_ACTIVE_ARCH = YES;
+				SDKROOT = iphoneos;
+				SWIFT_ACTIVE_COMPILATION_CONDITIONS = DEBUG;
+				SWIFT_OPTIMIZATION_LEVEL = "-Onone";
+			};
+			name = Debug;
+		};
+		D3A0C1BB29B1E3A2007E2F10 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ALWAYS_SEARCH_USER_PATHS = NO;
+				CLANG_ANALYZER_NONNULL = YES;
+				CLANG_CXX_LANGUAGE_STANDARD = "gnu++20";
+				CLANG_ENABLE_MODULES = YES;
+				CLANG_ENABLE_OBJC_ARC = YES;
+				COPY_PHASE_STRIP = NO;
+				DEBUG_INFORMATION_FORMAT = "dwarf-with-dsym";
+				ENABLE_NS_ASSERTIONS = NO;
+				ENABLE_STRICT_OBJC_MSGSEND = YES;
+				GCC_C_LANGUAGE_STANDARD = gnu11;
+				GCC_NO_COMMON_BLOCKS = YES;
+				IPHONEOS_DEPLOYMENT_TARGET = 16.2;
+				MTL_ENABLE_DEBUG_INFO = NO;
+				MTL_FAST_MATH = YES;
+				SDKROOT = iphoneos;
+				SWIFT_COMPILATION_MODE = wholemodule;
+				SWIFT_OPTIMIZATION_LEVEL = "-O";
+				VALIDATE_PRODUCT = YES;
+			};
+			name = Release;
+		};
+		D3A0C1BD29B1E3A2007E2F10 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_APPICON_NAME = AppIcon;
+				ASSETCATALOG_COMPILER_GLOBAL_ACCENT_COLOR_NAME = AccentColor;
+				CODE_SIGN_ENTITLEMENTS = Notes/Notes.entitlements;
+				CODE_SIGN_STYLE = Automatic;
+				CURRENT_PROJECT_VERSION = 1;
+				ENABLE_PREVIEWS = YES;
+				GENERATE_INFOPLIST_FILE = YES;
+				INFOPLIST_KEY_UIApplicationSceneManifest_Generation = YES;
+				INFOPLIST_KEY_UIApplicationSupportsIndirectInputEvents = YES;
+				INFOPLIST_KEY_UILaunchScreen_Generation = YES;
+				INFOPLIST_KEY_UISupportedInterfaceOrientations_iPad = "UIInterfaceOrientationPortrait UIInterfaceOrientationPortraitUpsideDown UIInterfaceOrientationLandscapeLeft UIInterfaceOrientationLandscapeRight";
+				INFOPLIST_KEY_UISupportedInterfaceOrientations_iPhone = "UIInterfaceOrientationPortrait UIInterfaceOrientationLandscapeLeft UIInterfaceOrientationLandscapeRight";
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+				);
+				MARKETING_VERSION = 1.0;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Notes;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_EMIT_LOC_STRINGS = YES;
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;
+		};
+		D3A0C1BE29B1E3A2007E2F10 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_APPICON_NAME = AppIcon;
+				ASSETCATALOG_COMPILER_GLOBAL_ACCENT_COLOR_NAME = AccentColor;
+				CODE_SIGN_ENTITLEMENTS = Notes/Notes.entitlements;
+				CODE_SIGN_STYLE = Automatic;
+				CURRENT_PROJECT_VERSION = 1;
+				ENABLE_PREVIEWS = YES;
+				GENERATE_INFOPLIST_FILE = YES;
+				INFOPLIST_KEY_UIApplicationSceneManifest_Generation = YES;
+				INFOPLIST_KEY_UIApplicationSupportsIndirectInputEvents = YES;
+				INFOPLIST_KEY_UILaunchScreen_Generation = YES;
+				INFOPLIST_KEY_UISupportedInterfaceOrientations_iPad = "UIInterfaceOrientationPortrait UIInterfaceOrientationPortraitUpsideDown UIInterfaceOrientationLandscapeLeft UIInterfaceOrientationLandscapeRight";
+				INFOPLIST_KEY_UISupportedInterfaceOrientations_iPhone = "UIInterfaceOrientationPortrait UIInterfaceOrientationLandscapeLeft UIInterfaceOrientationLandscapeRight";
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+				);
+				MARKETING_VERSION = 1.0;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Notes;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SWIFT_EMIT_LOC_STRINGS = YES;
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;
+		};
+		D3A0C1D129B1E4B1007E2F10 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_GLOBAL_ACCENT_COLOR_NAME = AccentColor;
+				ASSETCATALOG_COMPILER_WIDGET_BACKGROUND_COLOR_NAME = WidgetBackground;
+				CODE_SIGN_STYLE = Automatic;
+				CURRENT_PROJECT_VERSION = 1;
+				GENERATE_INFOPLIST_FILE = YES;
+				INFOPLIST_FILE = NotesWidget/Info.plist;
+				INFOPLIST_KEY_CFBundleDisplayName = NotesWidget;
+				INFOPLIST_KEY_NSHumanReadableCopyright = "";
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+					"@executable_path/../../Frameworks",
+				);
+				MARKETING_VERSION = 1.0;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Notes.NotesWidget;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SKIP_INSTALL = YES;
+				SWIFT_EMIT_LOC_STRINGS = YES;
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Debug;
+		};
+		D3A0C1D229B1E4B1007E2F10 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				ASSETCATALOG_COMPILER_GLOBAL_ACCENT_COLOR_NAME = AccentColor;
+				ASSETCATALOG_COMPILER_WIDGET_BACKGROUND_COLOR_NAME = WidgetBackground;
+				CODE_SIGN_STYLE = Automatic;
+				CURRENT_PROJECT_VERSION = 1;
+				GENERATE_INFOPLIST_FILE = YES;
+				INFOPLIST_FILE = NotesWidget/Info.plist;
+				INFOPLIST_KEY_CFBundleDisplayName = NotesWidget;
+				INFOPLIST_KEY_NSHumanReadableCopyright = "";
+				LD_RUNPATH_SEARCH_PATHS = (
+					"$(inherited)",
+					"@executable_path/Frameworks",
+					"@executable_path/../../Frameworks",
+				);
+				MARKETING_VERSION = 1.0;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Notes.NotesWidget;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+				SKIP_INSTALL = YES;
+				SWIFT_EMIT_LOC_STRINGS = YES;
+				SWIFT_VERSION = 5.0;
+				TARGETED_DEVICE_FAMILY = "1,2";
+			};
+			name = Release;
+		};
+		D3A0C1FB29B1E6C4007E2F10 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				CODE_SIGN_STYLE = Automatic;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		D3A0C1FC29B1E6C4007E2F10 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				CODE_SIGN_STYLE = Automatic;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */
+
+/* Begin XCConfigurationList section */
+		D3A0C1A929B1E3A1007E2F10 /* Build configuration list for PBXProject "Notes" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				D3A0C1BA29B1E3A2007E2F10 /* Debug */,
+				D3A0C1BB29B1E3A2007E2F10 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		D3A0C1BC29B1E3A2007E2F10 /* Build configuration list for PBXNativeTarget "Notes" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				D3A0C1BD29B1E3A2007E2F10 /* Debug */,
+				D3A0C1BE29B1E3A2007E2F10 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		D3A0C1D029B1E4B1007E2F10 /* Build configuration list for PBXNativeTarget "NotesWidgetExtension" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				D3A0C1D129B1E4B1007E2F10 /* Debug */,
+				D3A0C1D229B1E4B1007E2F10 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		D3A0C1FA29B1E6C4007E2F10 /* Build configuration list for PBXAggregateTarget "Lint" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				D3A0C1FB29B1E6C4007E2F10 /* Debug */,
+				D3A0C1FC29B1E6C4007E2F10 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */
+
+/* Begin XCRemoteSwiftPackageReference section */
+		D3A0C1E029B1E5F0007E2F10 /* XCRemoteSwiftPackageReference "swift-collections" */ = {
+			isa = XCRemoteSwiftPackageReference;
+			repositoryURL = "https://github.com/apple/swift-collections.git";
+			requirement = {
+				kind = exactVersion;
+				version = 1.0.4;
+			};
+		};
+		D3A0C1E329B1E60C007E2F10 /* XCRemoteSwiftPackageReference "swift-markdown" */ = {
+			isa = XCRemoteSwiftPackageReference;
+			repositoryURL = "https://github.com/apple/swift-markdown";
+			requirement = {
+				branch = main;
+				kind = branch;
+			};
+		};
+/* End XCRemoteSwiftPackageReference section */
+
+/* Begin XCSwiftPackageProductDependency section */
+		D3A0C1E129B1E5F0007E2F10 /* Collections */ = {
+			isa = XCSwiftPackageProductDependency;
+			package = D3A0C1E029B1E5F0007E2F10 /* XCRemoteSwiftPackageReference "swift-collections" */;
+			productName = Collections;
+		};
+		D3A0C1E429B1E60C007E2F10 /* Markdown */ = {
+			isa = XCSwiftPackageProductDependency;
+			package = D3A0C1E329B1E60C007E2F10 /* XCRemoteSwiftPackageReference "swift-markdown" */;
+			productName = Markdown;
+		};
+/* End XCSwiftPackageProductDependency section */
+	};
+	rootObject = D3A0C1A629B1E3A1007E2F10 /* Project object */;
+}
+`
+
+	kitPbxprojContent = `// !$*UTF8*$!
+{
+	archiveVersion = 1;
+	classes = {
+	};
+	objectVersion = 48;
+	objects = {
+
+/* Begin PBXBuildFile section */
+		C4F1B30B2245E0A700D2C8F1 /* Kit.h in Headers */ = {isa = PBXBuildFile; fileRef = C4F1B30A2245E0A700D2C8F1 /* Kit.h */; settings = {ATTRIBUTES = (Public, ); }; };
+		C4F1B30D2245E0A700D2C8F1 /* Kit.swift in Sources */ = {isa = PBXBuildFile; fileRef = C4F1B30C2245E0A700D2C8F1 /* Kit.swift */; };
+		C4F1B30F2245E0A700D2C8F1 /* Legacy.m in Sources */ = {isa = PBXBuildFile; fileRef = C4F1B30E2245E0A700D2C8F1 /* Legacy.m */; settings = {COMPILER_FLAGS = "-fno-objc-arc"; }; };
+		C4F1B3122245E0A700D2C8F1 /* Model.xcdatamodeld in Sources */ = {isa = PBXBuildFile; fileRef = C4F1B3102245E0A700D2C8F1 /* Model.xcdatamodeld */; };
+		C4F1B3182245E0A700D2C8F1 /* Sub.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = C4F1B3162245E0A700D2C8F1 /* Sub.framework */; };
+		C4F1B31A2245E0A700D2C8F1 /* Sub.framework in Embed Frameworks */ = {isa = PBXBuildFile; fileRef = C4F1B3162245E0A700D2C8F1 /* Sub.framework */; settings = {ATTRIBUTES = (CodeSignOnCopy, RemoveHeadersOnCopy, ); }; };
+		C4F1B3212245E0A700D2C8F1 /* KitTests.swift in Sources */ = {isa = PBXBuildFile; fileRef = C4F1B3202245E0A700D2C8F1 /* KitTests.swift */; };
+		C4F1B3222245E0A700D2C8F1 /* Kit.framework in Frameworks */ = {isa = PBXBuildFile; fileRef = C4F1B3022245E0A700D2C8F1 /* Kit.framework */; };
+/* End PBXBuildFile section */
+
+/* Begin PBXContainerItemProxy section */
+		C4F1B3172245E0A700D2C8F1 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = C4F1B3142245E0A700D2C8F1 /* Sub.xcodeproj */;
+			proxyType = 2;
+			remoteGlobalIDString = 0E57A1D11F0000AA00C1D2E3;
+			remoteInfo = Sub;
+		};
+		C4F1B3232245E0A700D2C8F1 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = C4F1B3012245E0A700D2C8F1 /* Project object */;
+			proxyType = 1;
+			remoteGlobalIDString = C4F1B3042245E0A700D2C8F1;
+			remoteInfo = Kit;
+		};
+		C4F1B3252245E0A700D2C8F1 /* PBXContainerItemProxy */ = {
+			isa = PBXContainerItemProxy;
+			containerPortal = C4F1B3142245E0A700D2C8F1 /* Sub.xcodeproj */;
+			proxyType = 1;
+			remoteGlobalIDString = 0E57A1D21F0000AA00C1D2E3;
+			remoteInfo = Sub;
+		};
+/* End PBXContainerItemProxy section */
+
+/* Begin PBXCopyFilesBuildPhase section */
+		C4F1B3192245E0A700D2C8F1 /* Embed Frameworks */ = {
+			isa = PBXCopyFilesBuildPhase;
+			buildActionMask = 2147483647;
+			dstPath = "";
+			dstSubfolderSpec = 10;
+			files = (
+				C4F1B31A2245E0A700D2C8F1 /* Sub.framework in Embed Frameworks */,
+			);
+			name = "Embed Frameworks";
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXCopyFilesBuildPhase section */
+
+/* Begin PBXFileReference section */
+		C4F1B3022245E0A700D2C8F1 /* Kit.framework */ = {isa = PBXFileReference; explicitFileType = wrapper.framework; includeInIndex = 0; path = Kit.framework; sourceTree = BUILT_PRODUCTS_DIR; };
+		C4F1B30A2245E0A700D2C8F1 /* Kit.h */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.c.h; path = Kit.h; sourceTree = "<group>"; };
+		C4F1B30C2245E0A700D2C8F1 /* Kit.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = Kit.swift; sourceTree = "<group>"; };
+		C4F1B30E2245E0A700D2C8F1 /* Legacy.m */ = {isa = PBXFileReference; fileEncoding = 4; lastKnownFileType = sourcecode.c.objc; path = Legacy.m; sourceTree = "<group>"; };
+		C4F1B3112245E0A700D2C8F1 /* Model.xcdatamodel */ = {isa = PBXFileReference; lastKnownFileType = wrapper.xcdatamodel; path = Model.xcdatamodel; sourceTree = "<group>"; };
+		C4F1B3132245E0A700D2C8F1 /* Info.plist */ = {isa = PBXFileReference; lastKnownFileType = text.plist.xml; path = Info.plist; sourceTree = "<group>"; };
+		C4F1B3142245E0A700D2C8F1 /* Sub.xcodeproj */ = {isa = PBXFileReference; lastKnownFileType = "wrapper.pb-project"; name = Sub.xcodeproj; path = ../Sub/Sub.xcodeproj; sourceTree = "<group>"; };
+		C4F1B31E2245E0A700D2C8F1 /* KitTests.xctest */ = {isa = PBXFileReference; explicitFileType = wrapper.cfbundle; includeInIndex = 0; path = KitTests.xctest; sourceTree = BUILT_PRODUCTS_DIR; };
+		C4F1B3202245E0A700D2C8F1 /* KitTests.swift */ = {isa = PBXFileReference; lastKnownFileType = sourcecode.swift; path = KitTests.swift; sourceTree = "<group>"; };
+/* End PBXFileReference section */
+
+/* Begin PBXFrameworksBuildPhase section */
+		C4F1B3072245E0A700D2C8F1 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				C4F1B3182245E0A700D2C8F1 /* Sub.framework in Frameworks */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		C4F1B31D2245E0A700D2C8F1 /* Frameworks */ = {
+			isa = PBXFrameworksBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				C4F1B3222245E0A700D2C8F1 /* Kit.framework in Frameworks */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXFrameworksBuildPhase section */
+
+/* Begin PBXGroup section */
+		C4F1B3002245E0A700D2C8F1 = {
+			isa = PBXGroup;
+			children = (
+				C4F1B3142245E0A700D2C8F1 /* Sub.xcodeproj */,
+				C4F1B3092245E0A700D2C8F1 /* Kit */,
+				C4F1B31F2245E0A700D2C8F1 /* KitTests */,
+				C4F1B3032245E0A700D2C8F1 /* Products */,
+			);
+			sourceTree = "<group>";
+		};
+		C4F1B3032245E0A700D2C8F1 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				C4F1B3022245E0A700D2C8F1 /* Kit.framework */,
+				C4F1B31E2245E0A700D2C8F1 /* KitTests.xctest */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+		C4F1B3092245E0A700D2C8F1 /* Kit */ = {
+			isa = PBXGroup;
+			children = (
+				C4F1B30A2245E0A700D2C8F1 /* Kit.h */,
+				C4F1B30C2245E0A700D2C8F1 /* Kit.swift */,
+				C4F1B30E2245E0A700D2C8F1 /* Legacy.m */,
+				C4F1B3102245E0A700D2C8F1 /* Model.xcdatamodeld */,
+				C4F1B3132245E0A700D2C8F1 /* Info.plist */,
+			);
+			path = Kit;
+			sourceTree = "<group>";
+		};
+		C4F1B3152245E0A700D2C8F1 /* Products */ = {
+			isa = PBXGroup;
+			children = (
+				C4F1B3162245E0A700D2C8F1 /* Sub.framework */,
+			);
+			name = Products;
+			sourceTree = "<group>";
+		};
+		C4F1B31F2245E0A700D2C8F1 /* KitTests */ = {
+			isa = PBXGroup;
+			children = (
+				C4F1B3202245E0A700D2C8F1 /* KitTests.swift */,
+			);
+			path = KitTests;
+			sourceTree = "<group>";
+		};
+/* End PBXGroup section */
+
+/* Begin PBXHeadersBuildPhase section */
+		C4F1B3052245E0A700D2C8F1 /* Headers */ = {
+			isa = PBXHeadersBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				C4F1B30B2245E0A700D2C8F1 /* Kit.h in Headers */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXHeadersBuildPhase section */
+
+/* Begin PBXLegacyTarget section */
+		C4F1B3272245E0A700D2C8F1 /* Docs */ = {
+			isa = PBXLegacyTarget;
+			buildArgumentsString = "$(ACTION)";
+			buildConfigurationList = C4F1B3312245E0A700D2C8F1 /* Build configuration list for PBXLegacyTarget "Docs" */;
+			buildPhases = (
+			);
+			buildToolPath = /usr/bin/make;
+			buildWorkingDirectory = "$(SRCROOT)/docs";
+			dependencies = (
+			);
+			name = Docs;
+			passBuildSettingsInEnvironment = 1;
+			productName = Docs;
+		};
+/* End PBXLegacyTarget section */
+
+/* Begin PBXNativeTarget section */
+		C4F1B3042245E0A700D2C8F1 /* Kit */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = C4F1B32B2245E0A700D2C8F1 /* Build configuration list for PBXNativeTarget "Kit" */;
+			buildPhases = (
+				C4F1B3052245E0A700D2C8F1 /* Headers */,
+				C4F1B3062245E0A700D2C8F1 /* Sources */,
+				C4F1B3072245E0A700D2C8F1 /* Frameworks */,
+				C4F1B3082245E0A700D2C8F1 /* Resources */,
+				C4F1B3192245E0A700D2C8F1 /* Embed Frameworks */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+			);
+			name = Kit;
+			productName = Kit;
+			productReference = C4F1B3022245E0A700D2C8F1 /* Kit.framework */;
+			productType = "com.apple.product-type.framework";
+		};
+		C4F1B31B2245E0A700D2C8F1 /* KitTests */ = {
+			isa = PBXNativeTarget;
+			buildConfigurationList = C4F1B32E2245E0A700D2C8F1 /* Build configuration list for PBXNativeTarget "KitTests" */;
+			buildPhases = (
+				C4F1B31C2245E0A700D2C8F1 /* Sources */,
+				C4F1B31D2245E0A700D2C8F1 /* Frameworks */,
+			);
+			buildRules = (
+			);
+			dependencies = (
+				C4F1B3242245E0A700D2C8F1 /* PBXTargetDependency */,
+				C4F1B3262245E0A700D2C8F1 /* PBXTargetDependency */,
+			);
+			name = KitTests;
+			productName = KitTests;
+			productReference = C4F1B31E2245E0A700D2C8F1 /* KitTests.xctest */;
+			productType = "com.apple.product-type.bundle.unit-test";
+		};
+/* End PBXNativeTarget section */
+
+/* Begin PBXProject section */
+		C4F1B3012245E0A700D2C8F1 /* Project object */ = {
+			isa = PBXProject;
+			attributes = {
+				LastSwiftUpdateCheck = 0930;
+				LastUpgradeCheck = 0930;
+				ORGANIZATIONNAME = "Bitrise Ltd.";
+				TargetAttributes = {
+					C4F1B3042245E0A700D2C8F1 = {
+						CreatedOnToolsVersion = 9.3;
+						LastSwiftMigration = 0930;
+					};
+					C4F1B31B2245E0A700D2C8F1 = {
+						CreatedOnToolsVersion = 9.3;
+					};
+				};
+			};
+			buildConfigurationList = C4F1B3282245E0A700D2C8F1 /* Build configuration list for PBXProject "Kit" */;
+			compatibilityVersion = "Xcode 8.0";
+			developmentRegion = English;
+			hasScannedForEncodings = 0;
+			knownRegions = (
+				en,
+			);
+			mainGroup = C4F1B3002245E0A700D2C8F1;
+			productRefGroup = C4F1B3032245E0A700D2C8F1 /* Products */;
+			projectDirPath = "";
+			projectReferences = (
+				{
+					ProductGroup = C4F1B3152245E0A700D2C8F1 /* Products */;
+					ProjectRef = C4F1B3142245E0A700D2C8F1 /* Sub.xcodeproj */;
+				},
+			);
+			projectRoot = "";
+			targets = (
+				C4F1B3042245E0A700D2C8F1 /* Kit */,
+				C4F1B31B2245E0A700D2C8F1 /* KitTests */,
+				C4F1B3272245E0A700D2C8F1 /* Docs */,
+			);
+		};
+/* End PBXProject section */
+
+/* Begin PBXReferenceProxy section */
+		C4F1B3162245E0A700D2C8F1 /* Sub.framework */ = {
+			isa = PBXReferenceProxy;
+			fileType = wrapper.framework;
+			path = Sub.framework;
+			remoteRef = C4F1B3172245E0A700D2C8F1 /* PBXContainerItemProxy */;
+			sourceTree = BUILT_PRODUCTS_DIR;
+		};
+/* End PBXReferenceProxy section */
+
+/* Begin PBXResourcesBuildPhase section */
+		C4F1B3082245E0A700D2C8F1 /* Resources */ = {
+			isa = PBXResourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXResourcesBuildPhase section */
+
+/* Begin PBXSourcesBuildPhase section */
+		C4F1B3062245E0A700D2C8F1 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				C4F1B30D2245E0A700D2C8F1 /* Kit.swift in Sources */,
+				C4F1B30F2245E0A700D2C8F1 /* Legacy.m in Sources */,
+				C4F1B3122245E0A700D2C8F1 /* Model.xcdatamodeld in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+		C4F1B31C2245E0A700D2C8F1 /* Sources */ = {
+			isa = PBXSourcesBuildPhase;
+			buildActionMask = 2147483647;
+			files = (
+				C4F1B3212245E0A700D2C8F1 /* KitTests.swift in Sources */,
+			);
+			runOnlyForDeploymentPostprocessing = 0;
+		};
+/* End PBXSourcesBuildPhase section */
+
+/* Begin PBXTargetDependency section */
+		C4F1B3242245E0A700D2C8F1 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			target = C4F1B3042245E0A700D2C8F1 /* Kit */;
+			targetProxy = C4F1B3232245E0A700D2C8F1 /* PBXContainerItemProxy */;
+		};
+		C4F1B3262245E0A700D2C8F1 /* PBXTargetDependency */ = {
+			isa = PBXTargetDependency;
+			name = Sub;
+			targetProxy = C4F1B3252245E0A700D2C8F1 /* PBXContainerItemProxy */;
+		};
+/* End PBXTargetDependency section */
+
+/* Begin XCBuildConfiguration section */
+		C4F1B3292245E0A700D2C8F1 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				CURRENT_PROJECT_VERSION = 1;
+				SDKROOT = iphoneos;
+				SWIFT_VERSION = 4.0;
+				VERSIONING_SYSTEM = "apple-generic";
+			};
+			name = Debug;
+		};
+		C4F1B32A2245E0A700D2C8F1 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				CURRENT_PROJECT_VERSION = 1;
+				SDKROOT = iphoneos;
+				SWIFT_VERSION = 4.0;
+				VALIDATE_PRODUCT = YES;
+				VERSIONING_SYSTEM = "apple-generic";
+			};
+			name = Release;
+		};
+		C4F1B32C2245E0A700D2C8F1 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				DEFINES_MODULE = YES;
+				DYLIB_INSTALL_NAME_BASE = "@rpath";
+				FRAMEWORK_SEARCH_PATHS = (
+					"$(inherited)",
+					"$(PROJECT_DIR)/Carthage/Build/iOS",
+				);
+				INFOPLIST_FILE = Kit/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Kit;
+				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+			};
+			name = Debug;
+		};
+		C4F1B32D2245E0A700D2C8F1 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				DEFINES_MODULE = YES;
+				DYLIB_INSTALL_NAME_BASE = "@rpath";
+				FRAMEWORK_SEARCH_PATHS = (
+					"$(inherited)",
+					"$(PROJECT_DIR)/Carthage/Build/iOS",
+				);
+				INFOPLIST_FILE = Kit/Info.plist;
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.Kit;
+				PRODUCT_NAME = "$(TARGET_NAME:c99extidentifier)";
+				SKIP_INSTALL = YES;
+			};
+			name = Release;
+		};
+		C4F1B32F2245E0A700D2C8F1 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.KitTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		C4F1B3302245E0A700D2C8F1 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_BUNDLE_IDENTIFIER = io.bitrise.KitTests;
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Release;
+		};
+		C4F1B3322245E0A700D2C8F1 /* Debug */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Debug;
+		};
+		C4F1B3332245E0A700D2C8F1 /* Release */ = {
+			isa = XCBuildConfiguration;
+			buildSettings = {
+				PRODUCT_NAME = "$(TARGET_NAME)";
+			};
+			name = Release;
+		};
+/* End XCBuildConfiguration section */
+
+/* Begin XCConfigurationList section */
+		C4F1B3282245E0A700D2C8F1 /* Build configuration list for PBXProject "Kit" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				C4F1B3292245E0A700D2C8F1 /* Debug */,
+				C4F1B32A2245E0A700D2C8F1 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		C4F1B32B2245E0A700D2C8F1 /* Build configuration list for PBXNativeTarget "Kit" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				C4F1B32C2245E0A700D2C8F1 /* Debug */,
+				C4F1B32D2245E0A700D2C8F1 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		C4F1B32E2245E0A700D2C8F1 /* Build configuration list for PBXNativeTarget "KitTests" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				C4F1B32F2245E0A700D2C8F1 /* Debug */,
+				C4F1B3302245E0A700D2C8F1 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+		C4F1B3312245E0A700D2C8F1 /* Build configuration list for PBXLegacyTarget "Docs" */ = {
+			isa = XCConfigurationList;
+			buildConfigurations = (
+				C4F1B3322245E0A700D2C8F1 /* Debug */,
+				C4F1B3332245E0A700D2C8F1 /* Release */,
+			);
+			defaultConfigurationIsVisible = 0;
+			defaultConfigurationName = Release;
+		};
+/* End XCConfigurationList section */
+
+/* Begin XCVersionGroup section */
+		C4F1B3102245E0A700D2C8F1 /* Model.xcdatamodeld */ = {
+			isa = XCVersionGroup;
+			children = (
+				C4F1B3112245E0A700D2C8F1 /* Model.xcdatamodel */,
+			);
+			currentVersion = C4F1B3112245E0A700D2C8F1 /* Model.xcdatamodel */;
+			path = Model.xcdatamodeld;
+			sourceTree = "<group>";
+			versionGroupType = wrapper.xcdatamodel;
+		};
+/* End XCVersionGroup section */
+	};
+	rootObject = C4F1B3012245E0A700D2C8F1 /* Project object */;
+}
+`
+
+	sampleAppSchemeContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1000"
+   version = "1.3">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES">
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+               BuildableName = "SampleApp.app"
+               BlueprintName = "SampleApp"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+   <TestAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      shouldUseLaunchSchemeArgsEnv = "YES">
+      <Testables>
+         <TestableReference
+            skipped = "NO">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A162176C1D300A4F1B2"
+               BuildableName = "SampleAppTests.xctest"
+               BlueprintName = "SampleAppTests"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </TestableReference>
+         <TestableReference
+            skipped = "NO">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A212176C1D300A4F1B2"
+               BuildableName = "SampleAppUITests.xctest"
+               BlueprintName = "SampleAppUITests"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </TestableReference>
+      </Testables>
+      <MacroExpansion>
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+            BuildableName = "SampleApp.app"
+            BlueprintName = "SampleApp"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </MacroExpansion>
+      <AdditionalOptions>
+      </AdditionalOptions>
+   </TestAction>
+   <LaunchAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      launchStyle = "0"
+      useCustomWorkingDirectory = "NO"
+      ignoresPersistentStateOnLaunch = "NO"
+      debugDocumentVersioning = "YES"
+      debugServiceExtension = "internal"
+      allowLocationSimulation = "YES">
+      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+            BuildableName = "SampleApp.app"
+            BlueprintName = "SampleApp"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </BuildableProductRunnable>
+      <AdditionalOptions>
+      </AdditionalOptions>
+   </LaunchAction>
+   <ProfileAction
+      buildConfiguration = "Release"
+      shouldUseLaunchSchemeArgsEnv = "YES"
+      savedToolIdentifier = ""
+      useCustomWorkingDirectory = "NO"
+      debugDocumentVersioning = "YES">
+      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+            BuildableName = "SampleApp.app"
+            BlueprintName = "SampleApp"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </BuildableProductRunnable>
+   </ProfileAction>
+   <AnalyzeAction
+      buildConfiguration = "Debug">
+   </AnalyzeAction>
+   <ArchiveAction
+      buildConfiguration = "Release"
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>
+</Scheme>
+`
+
+	schemeContentWithTestPlans = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1100"
+   version = "1.3">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES">
+      <PreActions>
+         <ExecutionAction
+            ActionType = "Xcode.IDEStandardExecutionActionsCore.ExecutionActionType.ShellScriptAction">
+            <ActionContent
+               title = "Run Script"
+               scriptText = "echo &quot;Building $PRODUCT_NAME&quot;&#10;"
+               shellToRunIn = "/bin/sh">
+               <EnvironmentBuildable>
+                  <BuildableReference
+                     BuildableIdentifier = "primary"
+                     BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+                     BuildableName = "SampleApp.app"
+                     BlueprintName = "SampleApp"
+                     ReferencedContainer = "container:SampleApp.xcodeproj">
+                  </BuildableReference>
+               </EnvironmentBuildable>
+            </ActionContent>
+         </ExecutionAction>
+      </PreActions>
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+               BuildableName = "SampleApp.app"
+               BlueprintName = "SampleApp"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+   <TestAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      shouldUseLaunchSchemeArgsEnv = "NO"
+      codeCoverageEnabled = "YES">
+      <TestPlans>
+         <TestPlanReference
+            reference = "container:SampleApp.xctestplan"
+            default = "YES">
+         </TestPlanReference>
+         <TestPlanReference
+            reference = "container:SampleAppUI.xctestplan">
+         </TestPlanReference>
+      </TestPlans>
+      <Testables>
+         <TestableReference
+            skipped = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A212176C1D300A4F1B2"
+               BuildableName = "SampleAppUITests.xctest"
+               BlueprintName = "SampleAppUITests"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </TestableReference>
+         <TestableReference
+            skipped = "NO"
+            parallelizable = "YES"
+            testExecutionOrdering = "random">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A162176C1D300A4F1B2"
+               BuildableName = "SampleAppTests.xctest"
+               BlueprintName = "SampleAppTests"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+            <SkippedTests>
+               <Test
+                  Identifier = "SampleAppTests/testPerformanceExample()">
+               </Test>
+            </SkippedTests>
+         </TestableReference>
+      </Testables>
+      <MacroExpansion>
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+            BuildableName = "SampleApp.app"
+            BlueprintName = "SampleApp"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </MacroExpansion>
+      <CommandLineArguments>
+         <CommandLineArgument
+            argument = "-UITesting"
+            isEnabled = "YES">
+         </CommandLineArgument>
+      </CommandLineArguments>
+      <EnvironmentVariables>
+         <EnvironmentVariable
+            key = "API_URL"
+            value = "http://localhost:8080"
+            isEnabled = "YES">
+         </EnvironmentVariable>
+         <EnvironmentVariable
+            key = "LOG_LEVEL"
+            value = "debug"
+            isEnabled = "NO">
+         </EnvironmentVariable>
+      </EnvironmentVariables>
+   </TestAction>
+   <LaunchAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      launchStyle = "0"
+      useCustomWorkingDirectory = "NO"
+      ignoresPersistentStateOnLaunch = "NO"
+      debugDocumentVersioning = "YES"
+      debugServiceExtension = "internal"
+      allowLocationSimulation = "YES">
+      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+            BuildableName = "SampleApp.app"
+            BlueprintName = "SampleApp"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </BuildableProductRunnable>
+      <EnvironmentVariables>
+         <EnvironmentVariable
+            key = "OS_ACTIVITY_MODE"
+            value = "disable"
+            isEnabled = "YES">
+         </EnvironmentVariable>
+      </EnvironmentVariables>
+   </LaunchAction>
+   <ProfileAction
+      buildConfiguration = "Release"
+      shouldUseLaunchSchemeArgsEnv = "YES"
+      savedToolIdentifier = ""
+      useCustomWorkingDirectory = "NO"
+      debugDocumentVersioning = "YES">
+      <BuildableProductRunnable
+         runnableDebuggingMode = "0">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+            BuildableName = "SampleApp.app"
+            BlueprintName = "SampleApp"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </BuildableProductRunnable>
+   </ProfileAction>
+   <AnalyzeAction
+      buildConfiguration = "Debug">
+   </AnalyzeAction>
+   <ArchiveAction
+      buildConfiguration = "Release"
+      customArchiveName = "SampleApp Release"
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>
+</Scheme>
+`
+
+	watchAppSchemeContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1320"
+   wasCreatedForAppExtension = "YES"
+   version = "2.0">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES"
+      runPostActionsOnFailure = "NO">
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A4B2176C1D400A4F1B2"
+               BuildableName = "SampleApp WatchKit App.app"
+               BlueprintName = "SampleApp WatchKit App"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "YES"
+            buildForArchiving = "YES"
+            buildForAnalyzing = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+               BuildableName = "SampleApp.app"
+               BlueprintName = "SampleApp"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+   <TestAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      shouldUseLaunchSchemeArgsEnv = "YES"
+      codeCoverageEnabled = "YES"
+      onlyGenerateCoverageForSpecifiedTargets = "YES">
+      <CodeCoverageTargets>
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A572176C1D400A4F1B2"
+            BuildableName = "SampleApp WatchKit Extension.appex"
+            BlueprintName = "SampleApp WatchKit Extension"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+            BuildableName = "SampleApp.app"
+            BlueprintName = "SampleApp"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </CodeCoverageTargets>
+      <Testables>
+         <TestableReference
+            skipped = "NO">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A162176C1D300A4F1B2"
+               BuildableName = "SampleAppTests.xctest"
+               BlueprintName = "SampleAppTests"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </TestableReference>
+      </Testables>
+   </TestAction>
+   <LaunchAction
+      buildConfiguration = "Debug"
+      selectedDebuggerIdentifier = "Xcode.DebuggerFoundation.Debugger.LLDB"
+      selectedLauncherIdentifier = "Xcode.DebuggerFoundation.Launcher.LLDB"
+      launchStyle = "0"
+      useCustomWorkingDirectory = "NO"
+      ignoresPersistentStateOnLaunch = "NO"
+      debugDocumentVersioning = "YES"
+      debugServiceExtension = "internal"
+      allowLocationSimulation = "YES"
+      launchAutomaticallySubstyle = "32">
+      <RemoteRunnable
+         runnableDebuggingMode = "2"
+         BundleIdentifier = "com.apple.Carousel"
+         RemotePath = "/SampleApp WatchKit App">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A4B2176C1D400A4F1B2"
+            BuildableName = "SampleApp WatchKit App.app"
+            BlueprintName = "SampleApp WatchKit App"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </RemoteRunnable>
+      <MacroExpansion>
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A4B2176C1D400A4F1B2"
+            BuildableName = "SampleApp WatchKit App.app"
+            BlueprintName = "SampleApp WatchKit App"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </MacroExpansion>
+   </LaunchAction>
+   <ProfileAction
+      buildConfiguration = "Release"
+      shouldUseLaunchSchemeArgsEnv = "YES"
+      savedToolIdentifier = ""
+      useCustomWorkingDirectory = "NO"
+      debugDocumentVersioning = "YES"
+      launchAutomaticallySubstyle = "32">
+      <RemoteRunnable
+         runnableDebuggingMode = "2"
+         BundleIdentifier = "com.apple.Carousel"
+         RemotePath = "/SampleApp WatchKit App">
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A4B2176C1D400A4F1B2"
+            BuildableName = "SampleApp WatchKit App.app"
+            BlueprintName = "SampleApp WatchKit App"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </RemoteRunnable>
+      <MacroExpansion>
+         <BuildableReference
+            BuildableIdentifier = "primary"
+            BlueprintIdentifier = "8D3E2A4B2176C1D400A4F1B2"
+            BuildableName = "SampleApp WatchKit App.app"
+            BlueprintName = "SampleApp WatchKit App"
+            ReferencedContainer = "container:SampleApp.xcodeproj">
+         </BuildableReference>
+      </MacroExpansion>
+   </ProfileAction>
+   <AnalyzeAction
+      buildConfiguration = "Debug">
+   </AnalyzeAction>
+   <ArchiveAction
+      buildConfiguration = "Release"
+      revealArchiveInOrganizer = "YES">
+   </ArchiveAction>
+</Scheme>
+`
+
+	compactSchemeContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme LastUpgradeVersion="1000" version="1.3">
+  <BuildAction parallelizeBuildables="YES" buildImplicitDependencies="YES">
+    <BuildActionEntries>
+      <BuildActionEntry buildForTesting="YES" buildForRunning="YES" buildForProfiling="NO" buildForArchiving="NO" buildForAnalyzing="NO">
+        <BuildableReference BuildableIdentifier="primary" BlueprintIdentifier="8D3E2A042176C1D300A4F1B2" BuildableName="SampleApp.app" BlueprintName="SampleApp" ReferencedContainer="container:SampleApp.xcodeproj"/>
+      </BuildActionEntry>
+    </BuildActionEntries>
+  </BuildAction>
+  <TestAction buildConfiguration="Debug" shouldUseLaunchSchemeArgsEnv="YES">
+    <Testables>
+      <TestableReference skipped="YES">
+        <BuildableReference BuildableIdentifier="primary" BlueprintIdentifier="8D3E2A212176C1D300A4F1B2" BuildableName="SampleAppUITests.xctest" BlueprintName="SampleAppUITests" ReferencedContainer="container:SampleApp.xcodeproj"/>
+      </TestableReference>
+      <TestableReference skipped="NO">
+        <BuildableReference BuildableIdentifier="primary" BlueprintIdentifier="8D3E2A162176C1D300A4F1B2" BuildableName="SampleAppTests.xctest" BlueprintName="SampleAppTests" ReferencedContainer="container:SampleApp.xcodeproj"/>
+      </TestableReference>
+    </Testables>
+  </TestAction>
+</Scheme>
+`
+
+	workspaceContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "group:SampleApp.xcodeproj">
+   </FileRef>
+   <Group
+      location = "group:Libraries"
+      name = "Libraries">
+      <FileRef
+         location = "group:Kit/Kit.xcodeproj">
+      </FileRef>
+      <Group
+         location = "group:Vendor"
+         name = "Vendor">
+         <FileRef
+            location = "group:Networking/Networking.xcodeproj">
+         </FileRef>
+         <FileRef
+            location = "container:Shared/Shared.xcodeproj">
+         </FileRef>
+      </Group>
+      <FileRef
+         location = "group:Packages/Logger">
+      </FileRef>
+   </Group>
+   <Group
+      location = "container:"
+      name = "Docs">
+      <FileRef
+         location = "group:README.md">
+      </FileRef>
+   </Group>
+   <FileRef
+      location = "absolute:/Users/bitrise/Frameworks/Analytics.xcodeproj">
+   </FileRef>
+   <FileRef
+      location = "developer:Platforms/iPhoneOS.platform/Developer/Library/Frameworks/XCTest.framework">
+   </FileRef>
+   <FileRef
+      location = "group:Pods/Pods.xcodeproj">
+   </FileRef>
+</Workspace>
+`
+
+	embeddedWorkspaceContent = `<?xml version="1.0" encoding="UTF-8"?>
+<Workspace
+   version = "1.0">
+   <FileRef
+      location = "self:">
+   </FileRef>
+</Workspace>
+`
+
+	packageResolvedV1Content = `{
+  "object": {
+    "pins": [
+      {
+        "package": "Alamofire",
+        "repositoryURL": "https://github.com/Alamofire/Alamofire.git",
+        "state": {
+          "branch": null,
+          "revision": "354dda32d89fc8cd4f5c46487f64957d355f53d8",
+          "version": "5.6.1"
+        }
+      },
+      {
+        "package": "swift-collections",
+        "repositoryURL": "https://github.com/apple/swift-collections",
+        "state": {
+          "branch": "main",
+          "revision": "94cf62b3ba8d4bed62680a282d4c25f9c63c2efb",
+          "version": null
+        }
+      }
+    ]
+  },
+  "version": 1
+}
+`
+
+	packageResolvedV2Content = `{
+  "pins" : [
+    {
+      "identity" : "alamofire",
+      "kind" : "remoteSourceControl",
+      "location" : "https://github.com/Alamofire/Alamofire.git",
+      "state" : {
+        "revision" : "354dda32d89fc8cd4f5c46487f64957d355f53d8",
+        "version" : "5.6.1"
+      }
+    },
+    {
+      "identity" : "swift-collections",
+      "kind" : "remoteSourceControl",
+      "location" : "https://github.com/apple/swift-collections",
+      "state" : {
+        "branch" : "main",
+        "revision" : "94cf62b3ba8d4bed62680a282d4c25f9c63c2efb"
+      }
+    }
+  ],
+  "version" : 2
+}
+`
+
+	packageResolvedV3Content = `{
+  "originHash" : "a8f3b0d2c6e9fb58bd5b1c1b8e0bdfa7e2d0bd8f2c8a6f4f4e1e4d1c8a5f7e3b",
+  "pins" : [
+    {
+      "identity" : "alamofire",
+      "kind" : "remoteSourceControl",
+      "location" : "https://github.com/Alamofire/Alamofire.git",
+      "state" : {
+        "revision" : "e16d3481f5ed35f0472cb93350085853d754913f",
+        "version" : "6.0.0"
+      }
+    }
+  ],
+  "version" : 3
+}
+`
+
+	networkingPackageSwiftContent = `// swift-tools-version:5.7
+// The swift-tools-version declares the minimum version of Swift required to build this package.
+
+import PackageDescription
+
+let package = Package(
+    name: "Networking",
+    platforms: [.iOS(.v13), .macOS(.v10_15)],
+    products: [
+        .library(name: "Networking", targets: ["Networking"]),
+        .library(name: "NetworkingMocks", type: .static, targets: ["NetworkingMocks"]),
+        .executable(name: "networking-cli", targets: ["CLI"]),
+        .plugin(name: "GenerateEndpoints", targets: ["GenerateEndpoints"]),
+    ],
+    dependencies: [
+        .package(url: "https://github.com/Alamofire/Alamofire.git", from: "5.6.0"),
+        .package(url: "https://github.com/apple/swift-argument-parser", .upToNextMinor(from: "1.2.0")),
+        .package(url: "https://github.com/apple/swift-collections", branch: "main"),
+        .package(url: "https://github.com/Quick/Nimble.git", "12.0.0"..<"13.0.0"),
+        // .package(url: "https://github.com/Quick/Quick.git", from: "7.0.0"),
+        .package(name: "Logger", path: "../Logger"),
+    ],
+    targets: [
+        .target(
+            name: "Networking",
+            dependencies: [
+                .product(name: "Alamofire", package: "Alamofire"),
+                "Logger", /* local package */
+            ]
+        ),
+        .target(name: "NetworkingMocks", dependencies: [.target(name: "Networking")]),
+        .executableTarget(
+            name: "CLI",
+            dependencies: [
+                "Networking",
+                .product(name: "ArgumentParser", package: "swift-argument-parser"),
+            ]
+        ),
+        .plugin(name: "GenerateEndpoints", capability: .buildTool()),
+        .testTarget(
+            name: "NetworkingTests",
+            dependencies: ["Networking", "NetworkingMocks", .product(name: "Nimble", package: "Nimble")],
+            resources: [.copy("Fixtures/response(1).json")]
+        ),
+    ]
+)
+`
+
+	podfileLockContent = `PODS:
+  - Alamofire (5.6.1)
+  - Firebase/CoreOnly (10.0.0):
+    - FirebaseCore (= 10.0.0)
+  - FirebaseCore (10.0.0):
+    - "GoogleUtilities/Environment (~> 7.8)"
+  - "GoogleUtilities/Environment (7.10.0)"
+  - Kit (1.0.0)
+
+DEPENDENCIES:
+  - Alamofire (~> 5.6)
+  - Firebase/CoreOnly
+  - Kit (from ` + "`../Kit`" + `)
+
+SPEC REPOS:
+  trunk:
+    - Alamofire
+    - Firebase
+    - FirebaseCore
+    - GoogleUtilities
+
+EXTERNAL SOURCES:
+  Kit:
+    :path: "../Kit"
+
+CHECKSUMS:
+  Alamofire: f3b09a368f1582ab751b3fff5460276e0d2cf5c9
+  Firebase: 1b810f3d0c0532e27a48f1961f8c0400a668a2cf
+  FirebaseCore: 97f48a3a567a72b8d4daa0f03c3aadb78df4e995
+  GoogleUtilities: bad72cb363809015b1f7f19beb1f1cd23c589f95
+  Kit: 0e1f7a6b7bd1e3ebc1e5c5c7b3c8f3a5d2e1b0a9
+
+PODFILE CHECKSUM: 8b4d5a1c2e5b8f1e2c7f5f5a3e3b1d2c9a4f6e7d
+
+COCOAPODS: 1.11.3
 `
 )
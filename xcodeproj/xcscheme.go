@@ -0,0 +1,739 @@
+package xcodeproj
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+var buildableReferenceNameAttributeRegexp = regexp.MustCompile(`\b(BlueprintName|BuildableName)(\s*=\s*)"[^"]*"`)
+
+// Unmodeled keeps the attributes and child elements of a scheme element, which the scheme types do not model
+// (like wasCreatedForAppExtension or RemoteRunnable), so that Encode writes them back.
+type Unmodeled struct {
+	UnmodeledAttributes []xml.Attr         `xml:",any,attr"`
+	UnmodeledElements   []UnmodeledElement `xml:",any"`
+}
+
+// UnmodeledElement is an XML element of a scheme, kept as it is.
+type UnmodeledElement struct {
+	XMLName    xml.Name
+	Attributes []xml.Attr         `xml:",any,attr"`
+	Children   []UnmodeledElement `xml:",any"`
+}
+
+// BuildableReference is a reference to a target, which product is built by a scheme action.
+type BuildableReference struct {
+	BuildableIdentifier string `xml:"BuildableIdentifier,attr"`
+	BlueprintIdentifier string `xml:"BlueprintIdentifier,attr"`
+	BuildableName       string `xml:"BuildableName,attr"`
+	BlueprintName       string `xml:"BlueprintName,attr"`
+	ReferencedContainer string `xml:"ReferencedContainer,attr"`
+
+	Unmodeled
+}
+
+// IsXCTest reports whether the referenced product is an .xctest bundle.
+func (r BuildableReference) IsXCTest() bool {
+	return strings.HasSuffix(r.BuildableName, ".xctest")
+}
+
+// EnvironmentBuildable ...
+type EnvironmentBuildable struct {
+	BuildableReference BuildableReference
+
+	Unmodeled
+}
+
+// ActionContent is the content of a pre- or post-action, like a shell script.
+type ActionContent struct {
+	Title                string                `xml:"title,attr"`
+	ScriptText           string                `xml:"scriptText,attr"`
+	ShellToRunIn         string                `xml:"shellToRunIn,attr"`
+	EnvironmentBuildable *EnvironmentBuildable `xml:"EnvironmentBuildable"`
+
+	Unmodeled
+}
+
+// ExecutionAction is a pre- or post-action of a scheme action.
+type ExecutionAction struct {
+	ActionType    string `xml:"ActionType,attr"`
+	ActionContent ActionContent
+
+	Unmodeled
+}
+
+// CommandLineArgument ...
+type CommandLineArgument struct {
+	Argument  string `xml:"argument,attr"`
+	IsEnabled string `xml:"isEnabled,attr"`
+
+	Unmodeled
+}
+
+// EnvironmentVariable ...
+type EnvironmentVariable struct {
+	Key       string `xml:"key,attr"`
+	Value     string `xml:"value,attr"`
+	IsEnabled string `xml:"isEnabled,attr"`
+
+	Unmodeled
+}
+
+// AdditionalOption ...
+type AdditionalOption struct {
+	Key       string `xml:"key,attr"`
+	Value     string `xml:"value,attr"`
+	IsEnabled string `xml:"isEnabled,attr"`
+
+	Unmodeled
+}
+
+// AdditionalOptions ...
+type AdditionalOptions struct {
+	Options []AdditionalOption `xml:"AdditionalOption"`
+
+	Unmodeled
+}
+
+// MacroExpansion ...
+type MacroExpansion struct {
+	BuildableReference BuildableReference
+
+	Unmodeled
+}
+
+// BuildableProductRunnable is the product, which a launch or profile action runs.
+type BuildableProductRunnable struct {
+	RunnableDebuggingMode string `xml:"runnableDebuggingMode,attr"`
+	BuildableReference    BuildableReference
+
+	Unmodeled
+}
+
+// BuildActionEntry ...
+type BuildActionEntry struct {
+	BuildForTesting    string `xml:"buildForTesting,attr"`
+	BuildForRunning    string `xml:"buildForRunning,attr"`
+	BuildForProfiling  string `xml:"buildForProfiling,attr"`
+	BuildForArchiving  string `xml:"buildForArchiving,attr"`
+	BuildForAnalyzing  string `xml:"buildForAnalyzing,attr"`
+	BuildableReference BuildableReference
+
+	Unmodeled
+}
+
+// BuildAction ...
+type BuildAction struct {
+	ParallelizeBuildables     string             `xml:"parallelizeBuildables,attr"`
+	BuildImplicitDependencies string             `xml:"buildImplicitDependencies,attr"`
+	PreActions                []ExecutionAction  `xml:"PreActions>ExecutionAction"`
+	PostActions               []ExecutionAction  `xml:"PostActions>ExecutionAction"`
+	BuildActionEntries        []BuildActionEntry `xml:"BuildActionEntries>BuildActionEntry"`
+
+	Unmodeled
+}
+
+// SkippedTest identifies a test class or test method, like `SampleAppTests/testExample()`.
+type SkippedTest struct {
+	Identifier string `xml:"Identifier,attr"`
+
+	Unmodeled
+}
+
+// TestableReference ...
+type TestableReference struct {
+	Skipped               string `xml:"skipped,attr"`
+	Parallelizable        string `xml:"parallelizable,attr"`
+	TestExecutionOrdering string `xml:"testExecutionOrdering,attr"`
+	BuildableReference    BuildableReference
+	SkippedTests          []SkippedTest `xml:"SkippedTests>Test"`
+
+	Unmodeled
+}
+
+// IsSkipped ...
+func (r TestableReference) IsSkipped() bool {
+	return r.Skipped == "YES"
+}
+
+// TestPlanReference ...
+type TestPlanReference struct {
+	Reference string `xml:"reference,attr"`
+	Default   string `xml:"default,attr"`
+
+	Unmodeled
+}
+
+// TestAction ...
+type TestAction struct {
+	BuildConfiguration           string                `xml:"buildConfiguration,attr"`
+	SelectedDebuggerIdentifier   string                `xml:"selectedDebuggerIdentifier,attr"`
+	SelectedLauncherIdentifier   string                `xml:"selectedLauncherIdentifier,attr"`
+	ShouldUseLaunchSchemeArgsEnv string                `xml:"shouldUseLaunchSchemeArgsEnv,attr"`
+	CodeCoverageEnabled          string                `xml:"codeCoverageEnabled,attr"`
+	PreActions                   []ExecutionAction     `xml:"PreActions>ExecutionAction"`
+	PostActions                  []ExecutionAction     `xml:"PostActions>ExecutionAction"`
+	TestPlans                    []TestPlanReference   `xml:"TestPlans>TestPlanReference"`
+	Testables                    []TestableReference   `xml:"Testables>TestableReference"`
+	MacroExpansion               *MacroExpansion       `xml:"MacroExpansion"`
+	CommandLineArguments         []CommandLineArgument `xml:"CommandLineArguments>CommandLineArgument"`
+	EnvironmentVariables         []EnvironmentVariable `xml:"EnvironmentVariables>EnvironmentVariable"`
+	AdditionalOptions            *AdditionalOptions    `xml:"AdditionalOptions"`
+
+	Unmodeled
+}
+
+// LaunchAction ...
+type LaunchAction struct {
+	BuildConfiguration             string                    `xml:"buildConfiguration,attr"`
+	SelectedDebuggerIdentifier     string                    `xml:"selectedDebuggerIdentifier,attr"`
+	SelectedLauncherIdentifier     string                    `xml:"selectedLauncherIdentifier,attr"`
+	LaunchStyle                    string                    `xml:"launchStyle,attr"`
+	UseCustomWorkingDirectory      string                    `xml:"useCustomWorkingDirectory,attr"`
+	CustomWorkingDirectory         string                    `xml:"customWorkingDirectory,attr"`
+	IgnoresPersistentStateOnLaunch string                    `xml:"ignoresPersistentStateOnLaunch,attr"`
+	DebugDocumentVersioning        string                    `xml:"debugDocumentVersioning,attr"`
+	DebugServiceExtension          string                    `xml:"debugServiceExtension,attr"`
+	AllowLocationSimulation        string                    `xml:"allowLocationSimulation,attr"`
+	PreActions                     []ExecutionAction         `xml:"PreActions>ExecutionAction"`
+	PostActions                    []ExecutionAction         `xml:"PostActions>ExecutionAction"`
+	BuildableProductRunnable       *BuildableProductRunnable `xml:"BuildableProductRunnable"`
+	MacroExpansion                 *MacroExpansion           `xml:"MacroExpansion"`
+	CommandLineArguments           []CommandLineArgument     `xml:"CommandLineArguments>CommandLineArgument"`
+	EnvironmentVariables           []EnvironmentVariable     `xml:"EnvironmentVariables>EnvironmentVariable"`
+	AdditionalOptions              *AdditionalOptions        `xml:"AdditionalOptions"`
+
+	Unmodeled
+}
+
+// ProfileAction ...
+type ProfileAction struct {
+	BuildConfiguration           string                    `xml:"buildConfiguration,attr"`
+	ShouldUseLaunchSchemeArgsEnv string                    `xml:"shouldUseLaunchSchemeArgsEnv,attr"`
+	SavedToolIdentifier          string                    `xml:"savedToolIdentifier,attr"`
+	UseCustomWorkingDirectory    string                    `xml:"useCustomWorkingDirectory,attr"`
+	DebugDocumentVersioning      string                    `xml:"debugDocumentVersioning,attr"`
+	PreActions                   []ExecutionAction         `xml:"PreActions>ExecutionAction"`
+	PostActions                  []ExecutionAction         `xml:"PostActions>ExecutionAction"`
+	BuildableProductRunnable     *BuildableProductRunnable `xml:"BuildableProductRunnable"`
+	MacroExpansion               *MacroExpansion           `xml:"MacroExpansion"`
+
+	Unmodeled
+}
+
+// AnalyzeAction ...
+type AnalyzeAction struct {
+	BuildConfiguration string            `xml:"buildConfiguration,attr"`
+	PreActions         []ExecutionAction `xml:"PreActions>ExecutionAction"`
+	PostActions        []ExecutionAction `xml:"PostActions>ExecutionAction"`
+
+	Unmodeled
+}
+
+// ArchiveAction ...
+type ArchiveAction struct {
+	BuildConfiguration       string            `xml:"buildConfiguration,attr"`
+	CustomArchiveName        string            `xml:"customArchiveName,attr"`
+	RevealArchiveInOrganizer string            `xml:"revealArchiveInOrganizer,attr"`
+	PreActions               []ExecutionAction `xml:"PreActions>ExecutionAction"`
+	PostActions              []ExecutionAction `xml:"PostActions>ExecutionAction"`
+
+	Unmodeled
+}
+
+// XCScheme is the content of an .xcscheme file.
+// Boolean attributes keep Xcode's "YES" / "NO" values, empty string means the attribute is not set.
+type XCScheme struct {
+	XMLName            xml.Name `xml:"Scheme"`
+	LastUpgradeVersion string   `xml:"LastUpgradeVersion,attr"`
+	Version            string   `xml:"version,attr"`
+	BuildAction        *BuildAction
+	TestAction         *TestAction
+	LaunchAction       *LaunchAction
+	ProfileAction      *ProfileAction
+	AnalyzeAction      *AnalyzeAction
+	ArchiveAction      *ArchiveAction
+
+	Unmodeled
+
+	// Path is the path of the .xcscheme file, set by OpenScheme.
+	Path string `xml:"-"`
+
+	// parsed is the element tree of the parsed content, Encode keeps its attribute and element order.
+	parsed *xmlElement
+}
+
+// ParseScheme parses .xcscheme content.
+func ParseScheme(content string) (*XCScheme, error) {
+	scheme := XCScheme{}
+	if err := xml.Unmarshal([]byte(content), &scheme); err != nil {
+		return nil, fmt.Errorf("failed to parse scheme: %s", err)
+	}
+
+	var document UnmodeledElement
+	if err := xml.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("failed to parse scheme: %s", err)
+	}
+	scheme.parsed = document.element()
+
+	return &scheme, nil
+}
+
+// OpenScheme parses the .xcscheme file at the given path.
+func OpenScheme(pth string) (*XCScheme, error) {
+	if exist, err := pathutil.IsPathExists(pth); err != nil {
+		return nil, err
+	} else if !exist {
+		return nil, fmt.Errorf("scheme does not exist at: %s", pth)
+	}
+
+	content, err := fileutil.ReadStringFromFile(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, err := ParseScheme(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", pth, err)
+	}
+	scheme.Path = pth
+
+	return scheme, nil
+}
+
+// Name returns the name of the scheme, based on its file path.
+func (s *XCScheme) Name() string {
+	return SchemeNameFromPath(s.Path)
+}
+
+// ContainsXCTestBuildAction reports whether the scheme's TestAction runs any .xctest bundle.
+func (s *XCScheme) ContainsXCTestBuildAction() bool {
+	if s.TestAction == nil {
+		return false
+	}
+
+	for _, testable := range s.TestAction.Testables {
+		if !testable.IsSkipped() && testable.BuildableReference.IsXCTest() {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildableReferences returns every buildable reference of the scheme: the build entries, testables, runnables,
+// macro expansions and pre- and post-action environment buildables. Modifying a returned reference modifies the scheme.
+func (s *XCScheme) BuildableReferences() []*BuildableReference {
+	references := []*BuildableReference{}
+	addExecutionActions := func(actions []ExecutionAction) {
+		for i := range actions {
+			if environmentBuildable := actions[i].ActionContent.EnvironmentBuildable; environmentBuildable != nil {
+				references = append(references, &environmentBuildable.BuildableReference)
+			}
+		}
+	}
+	addMacroExpansion := func(macroExpansion *MacroExpansion) {
+		if macroExpansion != nil {
+			references = append(references, &macroExpansion.BuildableReference)
+		}
+	}
+	addRunnable := func(runnable *BuildableProductRunnable) {
+		if runnable != nil {
+			references = append(references, &runnable.BuildableReference)
+		}
+	}
+
+	if action := s.BuildAction; action != nil {
+		addExecutionActions(action.PreActions)
+		addExecutionActions(action.PostActions)
+		for i := range action.BuildActionEntries {
+			references = append(references, &action.BuildActionEntries[i].BuildableReference)
+		}
+	}
+	if action := s.TestAction; action != nil {
+		addExecutionActions(action.PreActions)
+		addExecutionActions(action.PostActions)
+		for i := range action.Testables {
+			references = append(references, &action.Testables[i].BuildableReference)
+		}
+		addMacroExpansion(action.MacroExpansion)
+	}
+	if action := s.LaunchAction; action != nil {
+		addExecutionActions(action.PreActions)
+		addExecutionActions(action.PostActions)
+		addRunnable(action.BuildableProductRunnable)
+		addMacroExpansion(action.MacroExpansion)
+	}
+	if action := s.ProfileAction; action != nil {
+		addExecutionActions(action.PreActions)
+		addExecutionActions(action.PostActions)
+		addRunnable(action.BuildableProductRunnable)
+		addMacroExpansion(action.MacroExpansion)
+	}
+	if action := s.AnalyzeAction; action != nil {
+		addExecutionActions(action.PreActions)
+		addExecutionActions(action.PostActions)
+	}
+	if action := s.ArchiveAction; action != nil {
+		addExecutionActions(action.PreActions)
+		addExecutionActions(action.PostActions)
+	}
+
+	return references
+}
+
+// Encode returns the .xcscheme content in the layout Xcode writes it:
+// one attribute per line, three space indentation and separate closing tags.
+// The attributes and elements of a parsed scheme keep their order, unmodeled ones included.
+func (s *XCScheme) Encode() string {
+	scheme := newXMLElement("Scheme")
+	scheme.addOptionalAttribute("LastUpgradeVersion", s.LastUpgradeVersion)
+	scheme.addOptionalAttribute("version", s.Version)
+
+	if s.BuildAction != nil {
+		scheme.addChild(s.BuildAction.element())
+	}
+	if s.TestAction != nil {
+		scheme.addChild(s.TestAction.element())
+	}
+	if s.LaunchAction != nil {
+		scheme.addChild(s.LaunchAction.element())
+	}
+	if s.ProfileAction != nil {
+		scheme.addChild(s.ProfileAction.element())
+	}
+	if s.AnalyzeAction != nil {
+		scheme.addChild(s.AnalyzeAction.element())
+	}
+	if s.ArchiveAction != nil {
+		scheme.addChild(s.ArchiveAction.element())
+	}
+	scheme.addUnmodeled(s.Unmodeled)
+
+	if s.parsed != nil {
+		scheme.alignTo(s.parsed)
+	}
+
+	return xcschemeXMLContent(scheme)
+}
+
+// Save writes the scheme into its .xcscheme file.
+func (s *XCScheme) Save() error {
+	if s.Path == "" {
+		return errors.New("failed to save scheme: scheme path not set")
+	}
+	return fileutil.WriteStringToFile(s.Path, s.Encode())
+}
+
+func (r BuildableReference) element() *xmlElement {
+	e := newXMLElement("BuildableReference")
+	e.addOptionalAttribute("BuildableIdentifier", r.BuildableIdentifier)
+	e.addOptionalAttribute("BlueprintIdentifier", r.BlueprintIdentifier)
+	e.addOptionalAttribute("BuildableName", r.BuildableName)
+	e.addOptionalAttribute("BlueprintName", r.BlueprintName)
+	e.addOptionalAttribute("ReferencedContainer", r.ReferencedContainer)
+	e.addUnmodeled(r.Unmodeled)
+	return e
+}
+
+func (a ExecutionAction) element() *xmlElement {
+	e := newXMLElement("ExecutionAction")
+	e.addOptionalAttribute("ActionType", a.ActionType)
+
+	content := e.addChild(newXMLElement("ActionContent"))
+	content.addOptionalAttribute("title", a.ActionContent.Title)
+	content.addOptionalAttribute("scriptText", a.ActionContent.ScriptText)
+	content.addOptionalAttribute("shellToRunIn", a.ActionContent.ShellToRunIn)
+	if a.ActionContent.EnvironmentBuildable != nil {
+		environmentBuildable := content.addChild(newXMLElement("EnvironmentBuildable"))
+		environmentBuildable.addChild(a.ActionContent.EnvironmentBuildable.BuildableReference.element())
+		environmentBuildable.addUnmodeled(a.ActionContent.EnvironmentBuildable.Unmodeled)
+	}
+	content.addUnmodeled(a.ActionContent.Unmodeled)
+	e.addUnmodeled(a.Unmodeled)
+
+	return e
+}
+
+func addExecutionActions(parent *xmlElement, name string, actions []ExecutionAction) {
+	if len(actions) == 0 {
+		return
+	}
+
+	e := parent.addChild(newXMLElement(name))
+	for _, action := range actions {
+		e.addChild(action.element())
+	}
+}
+
+func addMacroExpansion(parent *xmlElement, macroExpansion *MacroExpansion) {
+	if macroExpansion == nil {
+		return
+	}
+
+	e := parent.addChild(newXMLElement("MacroExpansion"))
+	e.addChild(macroExpansion.BuildableReference.element())
+	e.addUnmodeled(macroExpansion.Unmodeled)
+}
+
+func addBuildableProductRunnable(parent *xmlElement, runnable *BuildableProductRunnable) {
+	if runnable == nil {
+		return
+	}
+
+	e := parent.addChild(newXMLElement("BuildableProductRunnable"))
+	e.addOptionalAttribute("runnableDebuggingMode", runnable.RunnableDebuggingMode)
+	e.addChild(runnable.BuildableReference.element())
+	e.addUnmodeled(runnable.Unmodeled)
+}
+
+func addCommandLineArguments(parent *xmlElement, arguments []CommandLineArgument) {
+	if len(arguments) == 0 {
+		return
+	}
+
+	e := parent.addChild(newXMLElement("CommandLineArguments"))
+	for _, argument := range arguments {
+		child := e.addChild(newXMLElement("CommandLineArgument"))
+		child.addAttribute("argument", argument.Argument)
+		child.addOptionalAttribute("isEnabled", argument.IsEnabled)
+		child.addUnmodeled(argument.Unmodeled)
+	}
+}
+
+func addEnvironmentVariables(parent *xmlElement, variables []EnvironmentVariable) {
+	if len(variables) == 0 {
+		return
+	}
+
+	e := parent.addChild(newXMLElement("EnvironmentVariables"))
+	for _, variable := range variables {
+		child := e.addChild(newXMLElement("EnvironmentVariable"))
+		child.addAttribute("key", variable.Key)
+		child.addAttribute("value", variable.Value)
+		child.addOptionalAttribute("isEnabled", variable.IsEnabled)
+		child.addUnmodeled(variable.Unmodeled)
+	}
+}
+
+func addAdditionalOptions(parent *xmlElement, options *AdditionalOptions) {
+	if options == nil {
+		return
+	}
+
+	e := parent.addChild(newXMLElement("AdditionalOptions"))
+	for _, option := range options.Options {
+		child := e.addChild(newXMLElement("AdditionalOption"))
+		child.addAttribute("key", option.Key)
+		child.addAttribute("value", option.Value)
+		child.addOptionalAttribute("isEnabled", option.IsEnabled)
+		child.addUnmodeled(option.Unmodeled)
+	}
+	e.addUnmodeled(options.Unmodeled)
+}
+
+func (a BuildAction) element() *xmlElement {
+	e := newXMLElement("BuildAction")
+	e.addOptionalAttribute("parallelizeBuildables", a.ParallelizeBuildables)
+	e.addOptionalAttribute("buildImplicitDependencies", a.BuildImplicitDependencies)
+
+	addExecutionActions(e, "PreActions", a.PreActions)
+	addExecutionActions(e, "PostActions", a.PostActions)
+
+	entries := e.addChild(newXMLElement("BuildActionEntries"))
+	for _, entry := range a.BuildActionEntries {
+		child := entries.addChild(newXMLElement("BuildActionEntry"))
+		child.addOptionalAttribute("buildForTesting", entry.BuildForTesting)
+		child.addOptionalAttribute("buildForRunning", entry.BuildForRunning)
+		child.addOptionalAttribute("buildForProfiling", entry.BuildForProfiling)
+		child.addOptionalAttribute("buildForArchiving", entry.BuildForArchiving)
+		child.addOptionalAttribute("buildForAnalyzing", entry.BuildForAnalyzing)
+		child.addChild(entry.BuildableReference.element())
+		child.addUnmodeled(entry.Unmodeled)
+	}
+	e.addUnmodeled(a.Unmodeled)
+
+	return e
+}
+
+func (a TestAction) element() *xmlElement {
+	e := newXMLElement("TestAction")
+	e.addOptionalAttribute("buildConfiguration", a.BuildConfiguration)
+	e.addOptionalAttribute("selectedDebuggerIdentifier", a.SelectedDebuggerIdentifier)
+	e.addOptionalAttribute("selectedLauncherIdentifier", a.SelectedLauncherIdentifier)
+	e.addOptionalAttribute("shouldUseLaunchSchemeArgsEnv", a.ShouldUseLaunchSchemeArgsEnv)
+	e.addOptionalAttribute("codeCoverageEnabled", a.CodeCoverageEnabled)
+
+	addExecutionActions(e, "PreActions", a.PreActions)
+	addExecutionActions(e, "PostActions", a.PostActions)
+
+	if len(a.TestPlans) > 0 {
+		testPlans := e.addChild(newXMLElement("TestPlans"))
+		for _, testPlan := range a.TestPlans {
+			child := testPlans.addChild(newXMLElement("TestPlanReference"))
+			child.addAttribute("reference", testPlan.Reference)
+			child.addOptionalAttribute("default", testPlan.Default)
+			child.addUnmodeled(testPlan.Unmodeled)
+		}
+	}
+
+	testables := e.addChild(newXMLElement("Testables"))
+	for _, testable := range a.Testables {
+		child := testables.addChild(newXMLElement("TestableReference"))
+		child.addOptionalAttribute("skipped", testable.Skipped)
+		child.addOptionalAttribute("parallelizable", testable.Parallelizable)
+		child.addOptionalAttribute("testExecutionOrdering", testable.TestExecutionOrdering)
+		child.addChild(testable.BuildableReference.element())
+
+		if len(testable.SkippedTests) > 0 {
+			skippedTests := child.addChild(newXMLElement("SkippedTests"))
+			for _, test := range testable.SkippedTests {
+				skippedTests.addChild(newXMLElement("Test", xmlAttribute{"Identifier", test.Identifier})).addUnmodeled(test.Unmodeled)
+			}
+		}
+		child.addUnmodeled(testable.Unmodeled)
+	}
+
+	addMacroExpansion(e, a.MacroExpansion)
+	addCommandLineArguments(e, a.CommandLineArguments)
+	addEnvironmentVariables(e, a.EnvironmentVariables)
+	addAdditionalOptions(e, a.AdditionalOptions)
+	e.addUnmodeled(a.Unmodeled)
+
+	return e
+}
+
+func (a LaunchAction) element() *xmlElement {
+	e := newXMLElement("LaunchAction")
+	e.addOptionalAttribute("buildConfiguration", a.BuildConfiguration)
+	e.addOptionalAttribute("selectedDebuggerIdentifier", a.SelectedDebuggerIdentifier)
+	e.addOptionalAttribute("selectedLauncherIdentifier", a.SelectedLauncherIdentifier)
+	e.addOptionalAttribute("launchStyle", a.LaunchStyle)
+	e.addOptionalAttribute("useCustomWorkingDirectory", a.UseCustomWorkingDirectory)
+	e.addOptionalAttribute("customWorkingDirectory", a.CustomWorkingDirectory)
+	e.addOptionalAttribute("ignoresPersistentStateOnLaunch", a.IgnoresPersistentStateOnLaunch)
+	e.addOptionalAttribute("debugDocumentVersioning", a.DebugDocumentVersioning)
+	e.addOptionalAttribute("debugServiceExtension", a.DebugServiceExtension)
+	e.addOptionalAttribute("allowLocationSimulation", a.AllowLocationSimulation)
+
+	addExecutionActions(e, "PreActions", a.PreActions)
+	addExecutionActions(e, "PostActions", a.PostActions)
+	addBuildableProductRunnable(e, a.BuildableProductRunnable)
+	addMacroExpansion(e, a.MacroExpansion)
+	addCommandLineArguments(e, a.CommandLineArguments)
+	addEnvironmentVariables(e, a.EnvironmentVariables)
+	addAdditionalOptions(e, a.AdditionalOptions)
+	e.addUnmodeled(a.Unmodeled)
+
+	return e
+}
+
+func (a ProfileAction) element() *xmlElement {
+	e := newXMLElement("ProfileAction")
+	e.addOptionalAttribute("buildConfiguration", a.BuildConfiguration)
+	e.addOptionalAttribute("shouldUseLaunchSchemeArgsEnv", a.ShouldUseLaunchSchemeArgsEnv)
+	// Xcode writes savedToolIdentifier even if it is empty
+	e.addAttribute("savedToolIdentifier", a.SavedToolIdentifier)
+	e.addOptionalAttribute("useCustomWorkingDirectory", a.UseCustomWorkingDirectory)
+	e.addOptionalAttribute("debugDocumentVersioning", a.DebugDocumentVersioning)
+
+	addExecutionActions(e, "PreActions", a.PreActions)
+	addExecutionActions(e, "PostActions", a.PostActions)
+	addBuildableProductRunnable(e, a.BuildableProductRunnable)
+	addMacroExpansion(e, a.MacroExpansion)
+	e.addUnmodeled(a.Unmodeled)
+
+	return e
+}
+
+func (a AnalyzeAction) element() *xmlElement {
+	e := newXMLElement("AnalyzeAction")
+	e.addOptionalAttribute("buildConfiguration", a.BuildConfiguration)
+
+	addExecutionActions(e, "PreActions", a.PreActions)
+	addExecutionActions(e, "PostActions", a.PostActions)
+	e.addUnmodeled(a.Unmodeled)
+
+	return e
+}
+
+func (a ArchiveAction) element() *xmlElement {
+	e := newXMLElement("ArchiveAction")
+	e.addOptionalAttribute("buildConfiguration", a.BuildConfiguration)
+	e.addOptionalAttribute("customArchiveName", a.CustomArchiveName)
+	e.addOptionalAttribute("revealArchiveInOrganizer", a.RevealArchiveInOrganizer)
+
+	addExecutionActions(e, "PreActions", a.PreActions)
+	addExecutionActions(e, "PostActions", a.PostActions)
+	e.addUnmodeled(a.Unmodeled)
+
+	return e
+}
+
+// updateBuildableReferenceNames sets the BlueprintName and (if not empty) the BuildableName attributes
+// of the BuildableReference elements, which match, in the .xcscheme content.
+// Only these attribute values are replaced, the rest of the content is kept as it is.
+func updateBuildableReferenceNames(content string, match func(BuildableReference) bool, blueprintName, buildableName string) (string, bool, error) {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	updated := ""
+	last := 0
+	changed := false
+	for {
+		start := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", false, fmt.Errorf("failed to parse scheme: %s", err)
+		}
+
+		element, ok := token.(xml.StartElement)
+		if !ok || element.Name.Local != "BuildableReference" {
+			continue
+		}
+
+		reference := BuildableReference{}
+		for _, attribute := range element.Attr {
+			switch attribute.Name.Local {
+			case "BuildableIdentifier":
+				reference.BuildableIdentifier = attribute.Value
+			case "BlueprintIdentifier":
+				reference.BlueprintIdentifier = attribute.Value
+			case "BuildableName":
+				reference.BuildableName = attribute.Value
+			case "BlueprintName":
+				reference.BlueprintName = attribute.Value
+			case "ReferencedContainer":
+				reference.ReferencedContainer = attribute.Value
+			}
+		}
+		if !match(reference) {
+			continue
+		}
+
+		end := int(decoder.InputOffset())
+		tag := buildableReferenceNameAttributeRegexp.ReplaceAllStringFunc(content[start:end], func(attribute string) string {
+			submatch := buildableReferenceNameAttributeRegexp.FindStringSubmatch(attribute)
+			value := blueprintName
+			if submatch[1] == "BuildableName" {
+				if buildableName == "" {
+					return attribute
+				}
+				value = buildableName
+			}
+			return submatch[1] + submatch[2] + `"` + escapeXMLAttributeValue(value) + `"`
+		})
+
+		updated += content[last:start] + tag
+		last = end
+		changed = true
+	}
+
+	return updated + content[last:], changed, nil
+}
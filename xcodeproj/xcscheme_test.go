@@ -0,0 +1,260 @@
+package xcodeproj
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheme(t *testing.T) {
+	scheme, err := ParseScheme(schemeContentWithTestPlans)
+	require.NoError(t, err)
+
+	require.Equal(t, "1100", scheme.LastUpgradeVersion)
+	require.Equal(t, "1.3", scheme.Version)
+
+	t.Log("BuildAction")
+	{
+		buildAction := scheme.BuildAction
+		require.NotNil(t, buildAction)
+		require.Equal(t, "YES", buildAction.ParallelizeBuildables)
+		require.Equal(t, 1, len(buildAction.BuildActionEntries))
+		require.Equal(t, "SampleApp.app", buildAction.BuildActionEntries[0].BuildableReference.BuildableName)
+
+		require.Equal(t, 1, len(buildAction.PreActions))
+		content := buildAction.PreActions[0].ActionContent
+		require.Equal(t, "Run Script", content.Title)
+		require.Equal(t, "echo \"Building $PRODUCT_NAME\"\n", content.ScriptText)
+		require.NotNil(t, content.EnvironmentBuildable)
+		require.Equal(t, "SampleApp", content.EnvironmentBuildable.BuildableReference.BlueprintName)
+	}
+
+	t.Log("TestAction")
+	{
+		testAction := scheme.TestAction
+		require.NotNil(t, testAction)
+		require.Equal(t, "YES", testAction.CodeCoverageEnabled)
+
+		require.Equal(t, []TestPlanReference{
+			{Reference: "container:SampleApp.xctestplan", Default: "YES"},
+			{Reference: "container:SampleAppUI.xctestplan"},
+		}, testAction.TestPlans)
+
+		require.Equal(t, 2, len(testAction.Testables))
+		require.Equal(t, true, testAction.Testables[0].IsSkipped())
+		require.Equal(t, false, testAction.Testables[1].IsSkipped())
+		require.Equal(t, "random", testAction.Testables[1].TestExecutionOrdering)
+		require.Equal(t, []SkippedTest{{Identifier: "SampleAppTests/testPerformanceExample()"}}, testAction.Testables[1].SkippedTests)
+
+		require.NotNil(t, testAction.MacroExpansion)
+		require.Equal(t, "8D3E2A042176C1D300A4F1B2", testAction.MacroExpansion.BuildableReference.BlueprintIdentifier)
+
+		require.Equal(t, []CommandLineArgument{{Argument: "-UITesting", IsEnabled: "YES"}}, testAction.CommandLineArguments)
+		require.Equal(t, []EnvironmentVariable{
+			{Key: "API_URL", Value: "http://localhost:8080", IsEnabled: "YES"},
+			{Key: "LOG_LEVEL", Value: "debug", IsEnabled: "NO"},
+		}, testAction.EnvironmentVariables)
+		require.Nil(t, testAction.AdditionalOptions)
+	}
+
+	t.Log("LaunchAction, ProfileAction, AnalyzeAction, ArchiveAction")
+	{
+		require.NotNil(t, scheme.LaunchAction)
+		require.NotNil(t, scheme.LaunchAction.BuildableProductRunnable)
+		require.Equal(t, "SampleApp.app", scheme.LaunchAction.BuildableProductRunnable.BuildableReference.BuildableName)
+		require.Equal(t, 1, len(scheme.LaunchAction.EnvironmentVariables))
+
+		require.NotNil(t, scheme.ProfileAction)
+		require.Equal(t, "Release", scheme.ProfileAction.BuildConfiguration)
+
+		require.NotNil(t, scheme.AnalyzeAction)
+		require.Equal(t, "Debug", scheme.AnalyzeAction.BuildConfiguration)
+
+		require.NotNil(t, scheme.ArchiveAction)
+		require.Equal(t, "SampleApp Release", scheme.ArchiveAction.CustomArchiveName)
+	}
+
+	t.Log("invalid content")
+	{
+		_, err := ParseScheme(`<Scheme version = "1.3">`)
+		require.EqualError(t, err, "failed to parse scheme: XML syntax error on line 1: unexpected EOF")
+	}
+}
+
+func TestSchemeEncodeRoundTrip(t *testing.T) {
+	for _, content := range []string{schemeContentWithXCTestBuildAction, schemeContentWithoutXCTestBuildAction, schemeContentWithTestPlans, sampleAppSchemeContent} {
+		scheme, err := ParseScheme(content)
+		require.NoError(t, err)
+		require.Equal(t, content, scheme.Encode())
+	}
+}
+
+func TestSchemeEncodeKeepsUnmodeledContent(t *testing.T) {
+	scheme, err := ParseScheme(watchAppSchemeContent)
+	require.NoError(t, err)
+	require.Equal(t, []xml.Attr{{Name: xml.Name{Local: "wasCreatedForAppExtension"}, Value: "YES"}}, scheme.UnmodeledAttributes)
+	require.Equal(t, "RemoteRunnable", scheme.LaunchAction.UnmodeledElements[0].XMLName.Local)
+	require.Equal(t, "CodeCoverageTargets", scheme.TestAction.UnmodeledElements[0].XMLName.Local)
+	require.Equal(t, watchAppSchemeContent, scheme.Encode())
+
+	t.Log("modified scheme")
+	{
+		scheme.BuildAction.BuildActionEntries = scheme.BuildAction.BuildActionEntries[1:]
+		scheme.LaunchAction.CustomWorkingDirectory = "/tmp"
+
+		content := scheme.Encode()
+		require.Equal(t, 1, strings.Count(content, "<BuildActionEntry\n"))
+		require.Contains(t, content, `      useCustomWorkingDirectory = "NO"
+      customWorkingDirectory = "/tmp"
+      ignoresPersistentStateOnLaunch = "NO"`)
+		require.Contains(t, content, `      launchAutomaticallySubstyle = "32">
+      <RemoteRunnable`)
+	}
+}
+
+func TestSchemeEncodeXcodeLayout(t *testing.T) {
+	scheme, err := ParseScheme(compactSchemeContent)
+	require.NoError(t, err)
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<Scheme
+   LastUpgradeVersion = "1000"
+   version = "1.3">
+   <BuildAction
+      parallelizeBuildables = "YES"
+      buildImplicitDependencies = "YES">
+      <BuildActionEntries>
+         <BuildActionEntry
+            buildForTesting = "YES"
+            buildForRunning = "YES"
+            buildForProfiling = "NO"
+            buildForArchiving = "NO"
+            buildForAnalyzing = "NO">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A042176C1D300A4F1B2"
+               BuildableName = "SampleApp.app"
+               BlueprintName = "SampleApp"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </BuildActionEntry>
+      </BuildActionEntries>
+   </BuildAction>
+   <TestAction
+      buildConfiguration = "Debug"
+      shouldUseLaunchSchemeArgsEnv = "YES">
+      <Testables>
+         <TestableReference
+            skipped = "YES">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A212176C1D300A4F1B2"
+               BuildableName = "SampleAppUITests.xctest"
+               BlueprintName = "SampleAppUITests"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </TestableReference>
+         <TestableReference
+            skipped = "NO">
+            <BuildableReference
+               BuildableIdentifier = "primary"
+               BlueprintIdentifier = "8D3E2A162176C1D300A4F1B2"
+               BuildableName = "SampleAppTests.xctest"
+               BlueprintName = "SampleAppTests"
+               ReferencedContainer = "container:SampleApp.xcodeproj">
+            </BuildableReference>
+         </TestableReference>
+      </Testables>
+   </TestAction>
+</Scheme>
+`
+	require.Equal(t, expected, scheme.Encode())
+}
+
+func TestSchemeContainsXCTestBuildAction(t *testing.T) {
+	t.Log("non skipped xctest testable")
+	{
+		scheme, err := ParseScheme(schemeContentWithXCTestBuildAction)
+		require.NoError(t, err)
+		require.Equal(t, true, scheme.ContainsXCTestBuildAction())
+	}
+
+	t.Log("no testable")
+	{
+		scheme, err := ParseScheme(schemeContentWithoutXCTestBuildAction)
+		require.NoError(t, err)
+		require.Equal(t, false, scheme.ContainsXCTestBuildAction())
+	}
+
+	t.Log("all testables skipped")
+	{
+		scheme, err := ParseScheme(schemeContentWithTestPlans)
+		require.NoError(t, err)
+		scheme.TestAction.Testables[1].Skipped = "YES"
+		require.Equal(t, false, scheme.ContainsXCTestBuildAction())
+	}
+
+	t.Log("no TestAction")
+	{
+		scheme := XCScheme{}
+		require.Equal(t, false, scheme.ContainsXCTestBuildAction())
+	}
+}
+
+func TestSchemeBuildableReferences(t *testing.T) {
+	scheme, err := ParseScheme(schemeContentWithXCTestBuildAction)
+	require.NoError(t, err)
+
+	references := scheme.BuildableReferences()
+	blueprintNames := []string{}
+	for _, reference := range references {
+		blueprintNames = append(blueprintNames, reference.BlueprintName)
+	}
+	require.Equal(t, []string{
+		"BitriseXcode7Sample",
+		"BitriseXcode7SampleTests",
+		"BitriseXcode7SampleUITests",
+		"BitriseXcode7Sample",
+		"BitriseXcode7Sample",
+		"BitriseXcode7Sample",
+	}, blueprintNames)
+
+	references[0].BlueprintName = "Sample"
+	require.Equal(t, "Sample", scheme.BuildAction.BuildActionEntries[0].BuildableReference.BlueprintName)
+
+	require.Equal(t, 0, len((&XCScheme{}).BuildableReferences()))
+}
+
+func TestOpenAndSaveScheme(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "xcodeproj")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	schemePth := filepath.Join(tmpDir, "SampleApp.xcscheme")
+
+	_, err = OpenScheme(schemePth)
+	require.EqualError(t, err, "scheme does not exist at: "+schemePth)
+
+	require.NoError(t, ioutil.WriteFile(schemePth, []byte(compactSchemeContent), 0644))
+
+	scheme, err := OpenScheme(schemePth)
+	require.NoError(t, err)
+	require.Equal(t, "SampleApp", scheme.Name())
+
+	scheme.ArchiveAction = &ArchiveAction{BuildConfiguration: "Release", RevealArchiveInOrganizer: "YES"}
+	require.NoError(t, scheme.Save())
+
+	reopened, err := OpenScheme(schemePth)
+	require.NoError(t, err)
+	require.NotNil(t, reopened.ArchiveAction)
+	require.Equal(t, scheme.Encode(), reopened.Encode())
+
+	require.EqualError(t, (&XCScheme{}).Save(), "failed to save scheme: scheme path not set")
+}
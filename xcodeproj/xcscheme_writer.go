@@ -0,0 +1,205 @@
+package xcodeproj
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// xmlAttribute ...
+type xmlAttribute struct {
+	name  string
+	value string
+}
+
+// xmlElement is an XML element, which can be written in the layout Xcode uses for .xcscheme files.
+type xmlElement struct {
+	name       string
+	attributes []xmlAttribute
+	children   []*xmlElement
+}
+
+func newXMLElement(name string, attributes ...xmlAttribute) *xmlElement {
+	return &xmlElement{
+		name:       name,
+		attributes: attributes,
+		children:   []*xmlElement{},
+	}
+}
+
+func (e *xmlElement) addChild(child *xmlElement) *xmlElement {
+	e.children = append(e.children, child)
+	return child
+}
+
+func (e *xmlElement) addAttribute(name, value string) {
+	e.attributes = append(e.attributes, xmlAttribute{name: name, value: value})
+}
+
+// addOptionalAttribute adds the attribute, only if its value is not empty.
+func (e *xmlElement) addOptionalAttribute(name, value string) {
+	if value != "" {
+		e.addAttribute(name, value)
+	}
+}
+
+// xcschemeXMLContent returns the XML document of the root element, like:
+//
+//	<?xml version="1.0" encoding="UTF-8"?>
+//	<Scheme
+//	   LastUpgradeVersion = "0700"
+//	   version = "1.3">
+//	   <BuildAction
+//	      parallelizeBuildables = "YES">
+//	   </BuildAction>
+//	</Scheme>
+func xcschemeXMLContent(root *xmlElement) string {
+	var buffer bytes.Buffer
+	buffer.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	root.write(&buffer, 0)
+	return buffer.String()
+}
+
+func (e *xmlElement) write(buffer *bytes.Buffer, level int) {
+	indent := strings.Repeat("   ", level)
+
+	buffer.WriteString(indent + "<" + e.name)
+	for _, attribute := range e.attributes {
+		buffer.WriteString("\n" + indent + "   " + attribute.name + ` = "` + escapeXMLAttributeValue(attribute.value) + `"`)
+	}
+	buffer.WriteString(">\n")
+
+	for _, child := range e.children {
+		child.write(buffer, level+1)
+	}
+
+	buffer.WriteString(indent + "</" + e.name + ">\n")
+}
+
+func escapeXMLAttributeValue(value string) string {
+	var buffer bytes.Buffer
+	for _, r := range value {
+		switch r {
+		case '&':
+			buffer.WriteString("&amp;")
+		case '<':
+			buffer.WriteString("&lt;")
+		case '>':
+			buffer.WriteString("&gt;")
+		case '"':
+			buffer.WriteString("&quot;")
+		case '\'':
+			buffer.WriteString("&apos;")
+		case '\n':
+			buffer.WriteString("&#10;")
+		case '\t':
+			buffer.WriteString("&#9;")
+		default:
+			buffer.WriteRune(r)
+		}
+	}
+	return buffer.String()
+}
+
+// addUnmodeled adds the unmodeled attributes and child elements, kept by parsing.
+func (e *xmlElement) addUnmodeled(unmodeled Unmodeled) {
+	for _, attribute := range unmodeled.UnmodeledAttributes {
+		e.addAttribute(attribute.Name.Local, attribute.Value)
+	}
+	for _, element := range unmodeled.UnmodeledElements {
+		e.addChild(element.element())
+	}
+}
+
+func (u UnmodeledElement) element() *xmlElement {
+	e := newXMLElement(u.XMLName.Local)
+	for _, attribute := range u.Attributes {
+		e.addAttribute(attribute.Name.Local, attribute.Value)
+	}
+	for _, child := range u.Children {
+		e.addChild(child.element())
+	}
+	return e
+}
+
+// alignTo orders the attributes and the children of the element (recursively) as they are in the original element,
+// the ones missing from the original element follow the attribute or child, which they follow in the element.
+// Children are matched by name and position among the children of the same name.
+func (e *xmlElement) alignTo(original *xmlElement) {
+	names := make([]string, len(e.attributes))
+	for i, attribute := range e.attributes {
+		names[i] = attribute.name
+	}
+	originalNames := make([]string, len(original.attributes))
+	for i, attribute := range original.attributes {
+		originalNames[i] = attribute.name
+	}
+
+	attributes := make([]xmlAttribute, 0, len(e.attributes))
+	for _, i := range alignedOrder(names, originalNames) {
+		attributes = append(attributes, e.attributes[i])
+	}
+	e.attributes = attributes
+
+	keys := childKeys(e.children)
+	originalChildren := map[string]*xmlElement{}
+	for i, key := range childKeys(original.children) {
+		originalChildren[key] = original.children[i]
+	}
+
+	children := make([]*xmlElement, 0, len(e.children))
+	for _, i := range alignedOrder(keys, childKeys(original.children)) {
+		if originalChild, ok := originalChildren[keys[i]]; ok {
+			e.children[i].alignTo(originalChild)
+		}
+		children = append(children, e.children[i])
+	}
+	e.children = children
+}
+
+// childKeys identifies the children by their name and position among the children of the same name, like `Test#1`.
+func childKeys(children []*xmlElement) []string {
+	keys := make([]string, len(children))
+	counts := map[string]int{}
+	for i, child := range children {
+		keys[i] = fmt.Sprintf("%s#%d", child.name, counts[child.name])
+		counts[child.name]++
+	}
+	return keys
+}
+
+// alignedOrder returns the indexes of the keys: the keys found in the original keys come in the original order,
+// the others right after the key preceding them in keys.
+func alignedOrder(keys, originalKeys []string) []int {
+	indexes := map[string]int{}
+	for i, key := range keys {
+		indexes[key] = i
+	}
+
+	order := []int{}
+	placed := map[int]bool{}
+	for _, key := range originalKeys {
+		if i, ok := indexes[key]; ok {
+			order = append(order, i)
+			placed[i] = true
+		}
+	}
+
+	for i := range keys {
+		if placed[i] {
+			continue
+		}
+
+		position := 0
+		for j, index := range order {
+			if index == i-1 {
+				position = j + 1
+				break
+			}
+		}
+		order = append(order[:position], append([]int{i}, order[position:]...)...)
+		placed[i] = true
+	}
+
+	return order
+}